@@ -1,7 +1,6 @@
 package main
 
 import (
-	"errors"
 	"flag"
 
 	"fmt"
@@ -24,26 +23,11 @@ func main() {
 
 	statelist := s.DBStates
 	fmt.Println(statelist.State.FactomNodeName, "Loading from", filename)
-	b, err := state.LoadFromFile(s, *filename)
+	b, err := state.LoadFastBootFile(s, *filename)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "LoadDBStateList error:", err)
 		panic(err)
 	}
-	if b == nil {
-		fmt.Fprintln(os.Stderr, "LoadDBStateList LoadFromFile returned nil")
-		panic(errors.New("failed to load from file"))
-	}
-	h := primitives.NewZeroHash()
-	b, err = h.UnmarshalBinaryData(b)
-	if err != nil {
-		panic(err)
-	}
-	h2 := primitives.Sha(b)
-	if h.IsSameAs(h2) == false {
-		fmt.Fprintf(os.Stderr, "LoadDBStateList - Integrity hashes do not match!")
-		panic(errors.New("fastboot file does not match its hash"))
-		//return fmt.Errorf("Integrity hashes do not match")
-	}
 
 	statelist.UnmarshalBinary(b)
 	var i int
@@ -59,7 +43,7 @@ func main() {
 	//state.PrintState(s)
 
 	h1 := state.GetMapHash(s.FactoidBalancesP)
-	h2 = state.GetMapHash(s.ECBalancesP)
+	h2 := state.GetMapHash(s.ECBalancesP)
 
 	var d []byte
 	d = append(d, h1.Bytes()...)
@@ -0,0 +1,381 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+var sections = []string{"height", "balances", "servers", "authorities", "identities", "replay", "fer", "holding", "acks", "xreview", "consensus", "entrysync"}
+
+func main() {
+	var (
+		filename = flag.String("f", "", "Fastboot file to inspect")
+		diffFile = flag.String("diff", "", "Second fastboot file to diff against")
+		host     = flag.String("s", "", "Factomd location (e.g. localhost:8088) to compare against")
+		asJSON   = flag.Bool("json", false, "Output JSON instead of text")
+		only     = flag.String("sections", "", "Comma separated sections to print: "+strings.Join(sections, ","))
+	)
+
+	flag.Parse()
+
+	if *filename == "" {
+		fmt.Fprintln(os.Stderr, "A fastboot file must be given with -f")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	a, err := loadReport(*filename)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load", *filename, err)
+		os.Exit(1)
+	}
+
+	var b *state.SaveStateReport
+	switch {
+	case *diffFile != "":
+		b, err = loadReport(*diffFile)
+	case *host != "":
+		b, err = nodeReport(*host, a)
+		if err == nil {
+			normalizeForNode(a)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load the comparison state:", err)
+		os.Exit(1)
+	}
+
+	wanted := make(map[string]bool)
+	for _, s := range strings.Split(*only, ",") {
+		if s != "" {
+			wanted[s] = true
+		}
+	}
+
+	if b == nil {
+		printReport(a, wanted, *asJSON)
+		return
+	}
+
+	var diffs []state.SaveStateDifference
+	for _, d := range state.DiffSaveStateReports(a, b) {
+		if len(wanted) == 0 || wanted[d.Section] || (d.Section == "fct" || d.Section == "ec") && wanted["balances"] {
+			diffs = append(diffs, d)
+		}
+	}
+	if *asJSON {
+		out, _ := json.MarshalIndent(diffs, "", "  ")
+		fmt.Println(string(out))
+	} else {
+		fmt.Printf("%d differences (a = %s at %d, b at %d)\n", len(diffs), *filename, a.DBHeight, b.DBHeight)
+		for _, d := range diffs {
+			fmt.Println(d.String())
+		}
+	}
+	if len(diffs) > 0 {
+		os.Exit(2)
+	}
+}
+
+func loadReport(filename string) (*state.SaveStateReport, error) {
+	s := testHelper.CreateEmptyTestState()
+	b, err := state.LoadFastBootFile(s, filename)
+	if err != nil {
+		return nil, err
+	}
+	err = s.DBStates.UnmarshalBinary(b)
+	if err != nil {
+		return nil, err
+	}
+	ss := state.LatestSaveState(s.DBStates)
+	if ss == nil {
+		return nil, fmt.Errorf("%s holds no save state", filename)
+	}
+	return state.NewSaveStateReport(ss), nil
+}
+
+func printReport(r *state.SaveStateReport, wanted map[string]bool, asJSON bool) {
+	show := func(section string) bool { return len(wanted) == 0 || wanted[section] }
+
+	if asJSON {
+		// Marshal through a map so unwanted sections can be dropped
+		full := make(map[string]interface{})
+		data, _ := json.Marshal(r)
+		json.Unmarshal(data, &full)
+		out := make(map[string]interface{})
+		out["dbheight"] = full["dbheight"]
+		out["leaderheight"] = full["leaderheight"]
+		for k, v := range full {
+			if show(jsonSection(k)) {
+				out[k] = v
+			}
+		}
+		data, _ = json.MarshalIndent(out, "", "  ")
+		fmt.Println(string(data))
+		return
+	}
+
+	fmt.Printf("DBHeight %d LeaderHeight %d\n", r.DBHeight, r.LLeaderHeight)
+	if show("balances") {
+		fmt.Printf("\n-- Balances --\nFCT Address Count: %d Hash: %s\nEC Address Count: %d Hash: %s\n",
+			len(r.Balances.FCT), r.Balances.FCTHash, len(r.Balances.EC), r.Balances.ECHash)
+		printBalances(r.Balances.FCT)
+		printBalances(r.Balances.EC)
+	}
+	if show("servers") {
+		fmt.Printf("\n-- Servers -- (authority server count %d)\n", r.Servers.AuthorityServerCount)
+		for _, id := range r.Servers.Federated {
+			fmt.Println("Fed  ", id)
+		}
+		for _, id := range r.Servers.Audit {
+			fmt.Println("Audit", id)
+		}
+	}
+	if show("authorities") {
+		fmt.Printf("\n-- Authorities (%d) --\n", len(r.Authorities))
+		printIdentities(r.Authorities)
+	}
+	if show("identities") {
+		fmt.Printf("\n-- Identities (%d) --\n", len(r.Identities))
+		printIdentities(r.Identities)
+	}
+	if show("replay") {
+		fmt.Printf("\n-- Replay Filter --\nCommits: %d\nInvalid Messages: %d\nNote: %s\n",
+			r.ReplayFilter.Commits, r.ReplayFilter.InvalidMessages, r.ReplayFilter.Note)
+	}
+	if show("fer") {
+		fmt.Printf("\n-- FER --\n%+v\n", r.FER)
+	}
+	if show("holding") {
+		printMessages("Holding", r.Holding)
+	}
+	if show("acks") {
+		printMessages("Acks", r.Acks)
+	}
+	if show("xreview") {
+		printMessages("XReview", r.XReview)
+	}
+	if show("consensus") {
+		printValues("Consensus", r.Consensus)
+	}
+	if show("entrysync") {
+		printValues("Entry Sync", r.EntrySync)
+	}
+}
+
+func jsonSection(key string) string {
+	switch key {
+	case "replayfilter":
+		return "replay"
+	case "dbheight", "leaderheight":
+		return "height"
+	}
+	return key
+}
+
+func printBalances(m map[string]int64) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Printf("%s: %d\n", k, m[k])
+	}
+}
+
+func printIdentities(ids []state.SaveStateIdentity) {
+	for _, id := range ids {
+		fmt.Printf("%s %-20s key %s eff %5d coinbase %s anchors %d\n",
+			id.ChainID, id.Status, id.SigningKey, id.Efficiency, id.CoinbaseAddress, id.AnchorKeys)
+	}
+}
+
+func printMessages(title string, msgs []state.SaveStateMessage) {
+	fmt.Printf("\n-- %s (%d) --\n", title, len(msgs))
+	for _, m := range msgs {
+		fmt.Printf("%s %-20s %s\n", m.Hash, m.Type, strings.TrimSpace(m.Msg))
+	}
+}
+
+func printValues(title string, m map[string]interface{}) {
+	fmt.Printf("\n-- %s --\n", title)
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Printf("%-30s %v\n", k, m[k])
+	}
+}
+
+// normalizeForNode drops the parts of a fastboot report that a running node
+// does not expose over its API so they do not show up as differences
+func normalizeForNode(r *state.SaveStateReport) {
+	r.LLeaderHeight = r.DBHeight
+	r.Balances.FCTHash, r.Balances.ECHash = "", ""
+	r.Servers.AuthorityServerCount = 0
+	for i := range r.Authorities {
+		r.Authorities[i].Status = ""
+		r.Authorities[i].AnchorKeys = 0
+	}
+	r.Identities = nil
+	r.ReplayFilter = state.SaveStateReplayFilter{}
+	r.FER = state.SaveStateFER{FactoshisPerEC: r.FER.FactoshisPerEC}
+	r.Holding, r.Acks, r.XReview = nil, nil, nil
+	r.Consensus, r.EntrySync = nil, nil
+}
+
+// nodeReport builds the parts of a report a running node exposes over its API.
+// Balances are only requested for the addresses found in the fastboot file, and
+// are only comparable if the node is at the height of the fastboot file.
+func nodeReport(host string, file *state.SaveStateReport) (*state.SaveStateReport, error) {
+	r := new(state.SaveStateReport)
+
+	heights := struct {
+		DirectoryBlockHeight uint32 `json:"directoryblockheight"`
+	}{}
+	if err := call(host, "v2", "heights", nil, &heights); err != nil {
+		return nil, err
+	}
+	r.DBHeight = heights.DirectoryBlockHeight
+	r.LLeaderHeight = r.DBHeight
+	if r.DBHeight != file.DBHeight {
+		fmt.Fprintf(os.Stderr, "Warning: node is at %d and the fastboot file at %d, balances may differ\n", r.DBHeight, file.DBHeight)
+	}
+
+	var err error
+	r.Balances.FCT, err = nodeBalances(host, "multiple-fct-balances", file.Balances.FCT)
+	if err != nil {
+		return nil, err
+	}
+	r.Balances.EC, err = nodeBalances(host, "multiple-ec-balances", file.Balances.EC)
+	if err != nil {
+		return nil, err
+	}
+
+	type server struct {
+		ChainID string
+	}
+	fed := struct{ FederatedServers []server }{}
+	if err := call(host, "debug", "federated-servers", nil, &fed); err != nil {
+		return nil, err
+	}
+	for _, s := range fed.FederatedServers {
+		r.Servers.Federated = append(r.Servers.Federated, s.ChainID)
+	}
+	audit := struct{ AuditServers []server }{}
+	if err := call(host, "debug", "audit-servers", nil, &audit); err != nil {
+		return nil, err
+	}
+	for _, s := range audit.AuditServers {
+		r.Servers.Audit = append(r.Servers.Audit, s.ChainID)
+	}
+
+	auths := struct {
+		Authorities []struct {
+			ChainID         string `json:"chainid"`
+			SigningKey      string `json:"signingkey"`
+			Efficiency      uint16 `json:"efficiency"`
+			CoinbaseAddress string `json:"coinbaseaddress"`
+		}
+	}{}
+	if err := call(host, "debug", "authorities", nil, &auths); err != nil {
+		return nil, err
+	}
+	for _, a := range auths.Authorities {
+		r.Authorities = append(r.Authorities, state.SaveStateIdentity{
+			ChainID:         a.ChainID,
+			SigningKey:      a.SigningKey,
+			Efficiency:      a.Efficiency,
+			CoinbaseAddress: a.CoinbaseAddress,
+		})
+	}
+	sort.Slice(r.Authorities, func(i, j int) bool { return r.Authorities[i].ChainID < r.Authorities[j].ChainID })
+
+	rate := struct {
+		Rate uint64 `json:"rate"`
+	}{}
+	if err := call(host, "v2", "entry-credit-rate", nil, &rate); err != nil {
+		return nil, err
+	}
+	r.FER.FactoshisPerEC = rate.Rate
+	return r, nil
+}
+
+func nodeBalances(host string, method string, file map[string]int64) (map[string]int64, error) {
+	addresses := make([]string, 0, len(file))
+	for k := range file {
+		addresses = append(addresses, k)
+	}
+	sort.Strings(addresses)
+
+	balances := make(map[string]int64, len(addresses))
+	const batch = 500
+	for i := 0; i < len(addresses); i += batch {
+		end := i + batch
+		if end > len(addresses) {
+			end = len(addresses)
+		}
+		resp := struct {
+			Balances []struct {
+				Saved int64  `json:"saved"`
+				Err   string `json:"err"`
+			} `json:"balances"`
+		}{}
+		err := call(host, "v2", method, map[string]interface{}{"addresses": addresses[i:end]}, &resp)
+		if err != nil {
+			return nil, err
+		}
+		for j, bal := range resp.Balances {
+			if bal.Err == "" && i+j < end {
+				balances[addresses[i+j]] = bal.Saved
+			}
+		}
+	}
+	return balances, nil
+}
+
+func call(host string, path string, method string, params interface{}, result interface{}) error {
+	req := map[string]interface{}{"jsonrpc": "2.0", "id": 0, "method": method}
+	if params != nil {
+		req["params"] = params
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := http.Post(fmt.Sprintf("http://%s/%s", host, path), "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	r := struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+	if err := json.Unmarshal(body, &r); err != nil {
+		return err
+	}
+	if r.Error != nil {
+		return fmt.Errorf("%s: %s", method, r.Error.Message)
+	}
+	return json.Unmarshal(r.Result, result)
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryBlock/specialEntries"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+const usage = `IdentityTool [options] <command> <identity chainid> [arguments]
+
+Commands:
+  show                            Print the identity as the node has parsed it
+  register                        Register the identity in the identity registration chain
+  signing-key <pubkey>            Set the block signing key (32 byte hex public key)
+  btc-key <level> <type> <key>    Set a bitcoin anchor key (level 0-3, type 0 P2PKH or 1 P2SH, 20 byte hex key)
+  coinbase <FA address>           Set the coinbase address
+  efficiency <0-10000>            Set the efficiency, in hundredths of a percent
+  vote <target> <change> <height> Vote for an authority set change at a future height
+                                  (target root chainid, change 0 add federated, 1 add audit, 2 remove)
+  proposals                       Print the scheduled authority set changes and their votes
+
+Entries are signed here, and only the signed entry is sent to the node, which checks it
+against the identity rules before anything is submitted. Without -ec the entry is only
+printed, so it can be committed and revealed some other way.
+
+The identity's chains must already exist, this tool does not create them.
+
+Options:
+`
+
+func main() {
+	var (
+		host = flag.String("s", "localhost:8088", "Factomd API location")
+		key  = flag.String("key", os.Getenv("IDENTITY_KEY"), "Level 1 identity private key (sk1... or hex), or $IDENTITY_KEY")
+		ec   = flag.String("ec", os.Getenv("EC_PRIVATE_KEY"), "Entry credit private key (Es...) to pay for and submit the entry, or $EC_PRIVATE_KEY")
+	)
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 1 && args[0] == "proposals" {
+		var result json.RawMessage
+		if err := call(*host, "authority-proposals", nil, &result); err != nil {
+			fail(err)
+		}
+		printJSON(result)
+		return
+	}
+	if len(args) < 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	command, chainID, args := args[0], args[1], args[2:]
+
+	if command == "show" {
+		var result json.RawMessage
+		if err := call(*host, "identity", wsapi.IdentityRequest{ChainID: chainID}, &result); err != nil {
+			fail(err)
+		}
+		printJSON(result)
+		return
+	}
+
+	if *key == "" {
+		fail(fmt.Errorf("an identity key is needed, with -key or $IDENTITY_KEY"))
+	}
+	priv, err := identityEntries.IdentityPrivateKeyToPrivateKey(*key)
+	if err != nil {
+		fail(fmt.Errorf("invalid identity key: %v", err))
+	}
+
+	// The node tells us the identity's management chain
+	id := new(wsapi.IdentityResponse)
+	if err := call(*host, "identity", wsapi.IdentityRequest{ChainID: chainID}, id); err != nil {
+		fail(err)
+	}
+	root := hash(id.IdentityChainID)
+	management := hash(id.ManagementChainID)
+	ts := primitives.NewTimestampNow()
+
+	var chain interfaces.IHash
+	var extIDs [][]byte
+	switch {
+	case command == "register" && len(args) == 0:
+		// Registrations go in the network's identity registration chain
+		chain = hash(specialEntries.IdentityChainID)
+		extIDs = identityEntries.NewSignedRegisterFactomIdentity(root, priv).ToExternalIDs()
+	case command == "signing-key" && len(args) == 1:
+		pub, err := hex.DecodeString(args[0])
+		if err != nil || len(pub) != 32 {
+			fail(fmt.Errorf("%q should be a 32 byte hex public key", args[0]))
+		}
+		chain = management
+		extIDs = identityEntries.NewSignedBlockSigningKey(root, pub, ts, priv).ToExternalIDs()
+	case command == "btc-key" && len(args) == 3:
+		level := byte(number(args[0], 3))
+		keyType := byte(number(args[1], 1))
+		data, err := hex.DecodeString(args[2])
+		if err != nil || len(data) != 20 {
+			fail(fmt.Errorf("%q should be a 20 byte hex bitcoin key", args[2]))
+		}
+		var btc [20]byte
+		copy(btc[:], data)
+		chain = management
+		extIDs = identityEntries.NewSignedBitcoinKey(root, level, keyType, btc, ts, priv).ToExternalIDs()
+	case command == "coinbase" && len(args) == 1:
+		if !primitives.ValidateFUserStr(args[0]) {
+			fail(fmt.Errorf("%q is not a factoid address", args[0]))
+		}
+		// The coinbase address is set in the root identity chain, not the management chain
+		chain = root
+		address := primitives.NewHash(primitives.ConvertUserStrToAddress(args[0]))
+		extIDs = identityEntries.NewSignedCoinbaseAddress(root, address, ts, priv).ToExternalIDs()
+	case command == "efficiency" && len(args) == 1:
+		chain = management
+		extIDs = identityEntries.NewSignedServerEfficiency(root, uint16(number(args[0], 10000)), ts, priv).ToExternalIDs()
+	case command == "vote" && len(args) == 3:
+		target := hash(args[0])
+		change := byte(number(args[1], 2))
+		height := uint32(number(args[2], 1<<32-1))
+		heights := new(wsapi.HeightsResponse)
+		if err := call(*host, "heights", nil, heights); err != nil {
+			fail(err)
+		}
+		if int64(height) <= heights.LeaderHeight {
+			fail(fmt.Errorf("the height must be above the current height %d", heights.LeaderHeight))
+		}
+		chain = management
+		extIDs = identityEntries.NewSignedAuthorityChange(root, target, change, height, priv).ToExternalIDs()
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	entry := entryBlock.NewEntry()
+	entry.ChainID = chain
+	for _, ext := range extIDs {
+		entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: ext})
+	}
+	data, err := entry.MarshalBinary()
+	if err != nil {
+		fail(err)
+	}
+	entryHex := hex.EncodeToString(data)
+
+	result := new(wsapi.IdentityEntryResponse)
+	if err := call(*host, "identity-check-entry", wsapi.EntryRequest{Entry: entryHex}, result); err != nil {
+		fail(err)
+	}
+	if !result.Change {
+		fmt.Fprintln(os.Stderr, "Note: the entry is valid but does not change the identity")
+	}
+	if *ec == "" {
+		fmt.Printf("Entry %s for chain %s is valid. To submit it, commit and reveal:\n%s\n", result.EntryHash, result.ChainID, entryHex)
+		return
+	}
+
+	commit, err := commitEntry(entry, data, *ec)
+	if err != nil {
+		fail(err)
+	}
+	committed := new(wsapi.CommitEntryResponse)
+	if err := call(*host, "commit-entry", wsapi.MessageRequest{Message: commit}, committed); err != nil {
+		fail(err)
+	}
+	var revealed json.RawMessage
+	if err := call(*host, "reveal-entry", wsapi.EntryRequest{Entry: entryHex}, &revealed); err != nil {
+		fail(err)
+	}
+	fmt.Printf("Submitted entry %s to chain %s (commit txid %s)\n", result.EntryHash, result.ChainID, committed.TxID)
+}
+
+// commitEntry returns the hex commit of an entry, paid for and signed with an entry credit key
+func commitEntry(entry interfaces.IEBEntry, data []byte, ecPrivate string) (string, error) {
+	ecKey, err := primitives.HumanReadableECPrivateKeyToPrivateKey(ecPrivate)
+	if err != nil {
+		return "", fmt.Errorf("invalid entry credit key: %v", err)
+	}
+
+	commit := entryCreditBlock.NewCommitEntry()
+	commit.Credits, err = util.EntryCost(data)
+	if err != nil {
+		return "", err
+	}
+	commit.EntryHash = entry.GetHash()
+	milli := make([]byte, 8)
+	binary.BigEndian.PutUint64(milli, uint64(primitives.NewTimestampNow().GetTimeMilli()))
+	var b6 primitives.ByteSlice6
+	copy(b6[:], milli[2:])
+	commit.MilliTime = &b6
+	if err := commit.Sign(ecKey); err != nil {
+		return "", fmt.Errorf("invalid entry credit key: %v", err)
+	}
+
+	c, err := commit.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(c), nil
+}
+
+func hash(s string) interfaces.IHash {
+	h, err := primitives.HexToHash(s)
+	if err != nil {
+		fail(fmt.Errorf("%q should be a 32 byte hex chain id", s))
+	}
+	return h
+}
+
+func number(s string, max uint64) uint64 {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || n > max {
+		fail(fmt.Errorf("%q should be a number from 0 to %d", s, max))
+	}
+	return n
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "Error:", err)
+	os.Exit(1)
+}
+
+func printJSON(data []byte) {
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	fmt.Println(out.String())
+}
+
+func call(host string, method string, params interface{}, result interface{}) error {
+	req := map[string]interface{}{"jsonrpc": "2.0", "id": 0, "method": method, "params": params}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resp, err := http.Post(fmt.Sprintf("http://%s/v2", host), "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	r := struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string      `json:"message"`
+			Data    interface{} `json:"data"`
+		} `json:"error"`
+	}{}
+	if err := json.Unmarshal(body, &r); err != nil {
+		return err
+	}
+	if r.Error != nil {
+		if r.Error.Data != nil {
+			return fmt.Errorf("%s: %s %v", method, r.Error.Message, r.Error.Data)
+		}
+		return fmt.Errorf("%s: %s", method, r.Error.Message)
+	}
+	return json.Unmarshal(r.Result, result)
+}
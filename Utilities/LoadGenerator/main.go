@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+
+	"github.com/FactomProject/factomd/loadgen"
+	"gopkg.in/yaml.v2"
+)
+
+func main() {
+	var (
+		profilefile = flag.String("profile", "", "Load profile file location, the default profile if not set")
+		url         = flag.String("url", "http://localhost:8088/v2", "The V2 API of the node to load")
+		user        = flag.String("user", "", "RPC user of the node, if it has one")
+		password    = flag.String("password", "", "RPC password of the node")
+		genprofile  = flag.Bool("genprofile", false, "Does not run the load, but instead outputs the default profile")
+		jsonout     = flag.Bool("json", false, "Print the report as JSON")
+	)
+	flag.Parse()
+
+	if *genprofile {
+		data, err := yaml.Marshal(loadgen.NewDefaultProfile())
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	profile := loadgen.NewDefaultProfile()
+	if *profilefile != "" {
+		data, err := ioutil.ReadFile(*profilefile)
+		if err != nil {
+			panic(err)
+		}
+		profile, err = loadgen.ParseProfile(data)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", *profilefile, err)
+			os.Exit(1)
+		}
+	}
+
+	g, err := loadgen.NewGenerator(profile, loadgen.NewHTTPTarget(*url, *user, *password))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	g.Progress = os.Stderr
+
+	// Ctrl-C stops the load, and still reports what was sent
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		fmt.Fprintln(os.Stderr, "Stopping")
+		g.Stop()
+	}()
+
+	fmt.Fprintf(os.Stderr, "Running load profile %s against %s for %s\n", profile.Name, *url, profile.Duration())
+	report, err := g.Run()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *jsonout {
+		data, err := json.MarshalIndent(report, "", "\t")
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+	fmt.Print(report)
+}
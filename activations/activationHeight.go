@@ -15,6 +15,10 @@ type ActivationType int
 const (
 	_                       ActivationType = iota // 0 Don't use ZERO
 	TESTNET_COINBASE_PERIOD                = iota // 1 -- this is a passing activation and this ID may be reused once that height is passes and the references are removed
+	MULTISIG_RCD                           = iota // 2 -- allow spending from M of N multisig (RCD type 2) addresses
+	TIME_LOCKED_RCD                        = iota // 3 -- allow spending from height or time locked (RCD type 3) addresses
+	GOVERNANCE_GRANTS                      = iota // 4 -- pay grants from the signed governance chain instead of the hard coded list
+	AUTHORITY_CHANGE_VOTES                 = iota // 5 -- make authority set changes voted for by a majority of the federated servers
 	//
 	ACTIVATION_TYPE_COUNT = iota - 1 // Always Last
 )
@@ -44,6 +48,42 @@ func init() {
 				"CUSTOM:fct_community_test": 45335, //  Monday morning September 17
 			},
 		},
+		Activation{"MultisigRCD", MULTISIG_RCD,
+			"Allow factoid inputs from M of N multisig (RCD type 2) addresses",
+			math.MaxInt32, // inactive unless overridden below
+			map[string]int{
+				"MAIN":  math.MaxInt32,
+				"TEST":  math.MaxInt32,
+				"LOCAL": 0,
+			},
+		},
+		Activation{"TimeLockedRCD", TIME_LOCKED_RCD,
+			"Allow factoid inputs from height or time locked (RCD type 3) addresses",
+			math.MaxInt32, // inactive unless overridden below
+			map[string]int{
+				"MAIN":  math.MaxInt32,
+				"TEST":  math.MaxInt32,
+				"LOCAL": 0,
+			},
+		},
+		Activation{"GovernanceGrants", GOVERNANCE_GRANTS,
+			"Pay grants from descriptors in the governance chain instead of the hard coded grant list",
+			math.MaxInt32, // inactive unless overridden below
+			map[string]int{
+				"MAIN":  math.MaxInt32,
+				"TEST":  math.MaxInt32,
+				"LOCAL": 61, // after the hard coded LOCAL grants are paid
+			},
+		},
+		Activation{"AuthorityChangeVotes", AUTHORITY_CHANGE_VOTES,
+			"Make the authority set changes voted for in the management chains of a majority of the federated servers",
+			math.MaxInt32, // inactive unless overridden below
+			map[string]int{
+				"MAIN":  math.MaxInt32,
+				"TEST":  math.MaxInt32,
+				"LOCAL": 0,
+			},
+		},
 	}
 
 	if ACTIVATION_TYPE_COUNT != len(activations) {
@@ -104,7 +144,7 @@ func IsActive(id ActivationType, height int) bool {
 		} else {
 			fmt.Fprintf(os.Stderr, "Activation %s does not know network name \"%s\". Never activating.\n", id.String(), netName)
 		}
-		return true
+		return height >= a.DefaultHeight
 	}
 
 	return height >= h
@@ -0,0 +1,118 @@
+package activations
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ActivationStatus is an activation as it is on the running network
+type ActivationStatus struct {
+	Name        string         `json:"name"`
+	Id          ActivationType `json:"id"`
+	Description string         `json:"description"`
+	Height      int            `json:"height"` // math.MaxInt32 means never
+	Active      bool           `json:"active"`
+}
+
+// ParseActivationHeights parses "Name:height" pairs, first from the list and then from the
+// file, one pair per line.  Blank lines and lines starting with ';' or '#' in the file are
+// skipped.  A later pair for the same name replaces an earlier one.
+func ParseActivationHeights(list []string, filename string) (map[string]int, error) {
+	heights := make(map[string]int)
+	for _, v := range list {
+		err := parseActivationHeight(heights, v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if filename == "" {
+		return heights, nil
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		v := strings.TrimSpace(scanner.Text())
+		if v == "" || strings.HasPrefix(v, ";") || strings.HasPrefix(v, "#") {
+			continue
+		}
+		err = parseActivationHeight(heights, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %v", filename, line, err)
+		}
+	}
+	return heights, scanner.Err()
+}
+
+func parseActivationHeight(heights map[string]int, v string) error {
+	parts := strings.Split(v, ":")
+	if len(parts) != 2 {
+		return fmt.Errorf("Activation height \"%s\" is not Name:height", v)
+	}
+	name := strings.TrimSpace(parts[0])
+	h, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil || h < 0 {
+		return fmt.Errorf("Activation %s has a bad height \"%s\"", name, parts[1])
+	}
+	heights[name] = h
+	return nil
+}
+
+// OverrideActivationHeights replaces the heights of the named activations on the running
+// network.  Only LOCAL and CUSTOM networks can be overridden, and every name must be a
+// known activation.
+func OverrideActivationHeights(heights map[string]int) error {
+	netName := networkname()
+	if netName != "LOCAL" && !strings.HasPrefix(netName, "CUSTOM:") {
+		return fmt.Errorf("Activation heights cannot be overridden on network \"%s\"", netName)
+	}
+
+	ids := make(map[string]ActivationType)
+	for id, name := range ActivationNameMap {
+		ids[name] = id
+	}
+	for name := range heights {
+		if _, ok := ids[name]; !ok {
+			return fmt.Errorf("Unknown activation \"%s\"", name)
+		}
+	}
+
+	for name, h := range heights {
+		ActivationMap[ids[name]].ActivationHeight[netName] = h
+		fmt.Printf("Activation %s on network \"%s\" is set to height %d\n", name, netName, h)
+	}
+	return nil
+}
+
+// GetActivationStatus returns every activation, in id order, with its height on the running
+// network and whether it is active at the input height
+func GetActivationStatus(height int) []ActivationStatus {
+	netName := networkname()
+
+	var list []ActivationStatus
+	for _, a := range ActivationMap {
+		h, ok := a.ActivationHeight[netName]
+		if !ok {
+			h = a.DefaultHeight
+		}
+		list = append(list, ActivationStatus{
+			Name:        a.Name,
+			Id:          a.Id,
+			Description: a.Description,
+			Height:      h,
+			Active:      h != math.MaxInt32 && height >= h,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Id < list[j].Id })
+	return list
+}
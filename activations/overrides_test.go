@@ -0,0 +1,110 @@
+package activations
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/globals"
+)
+
+func setNetwork(name string, custom string) {
+	globals.Params.NetworkName = name
+	globals.Params.CustomNetName = custom
+	once = false
+}
+
+func TestParseActivationHeights(t *testing.T) {
+	f, err := ioutil.TempFile("", "activations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("; heights for the upgrade rehearsal\n\nTimeLockedRCD: 30\n# MultisigRCD:1\nMultisigRCD:40\n")
+	f.Close()
+
+	heights, err := ParseActivationHeights([]string{"MultisigRCD:20", "GovernanceGrants:10"}, f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]int{"MultisigRCD": 40, "TimeLockedRCD": 30, "GovernanceGrants": 10}
+	if len(heights) != len(expected) {
+		t.Errorf("Expected %v but found %v", expected, heights)
+	}
+	for name, h := range expected {
+		if heights[name] != h {
+			t.Errorf("Expected %s at %d but found %d", name, h, heights[name])
+		}
+	}
+
+	for _, bad := range []string{"MultisigRCD", "MultisigRCD:", "MultisigRCD:-1", "MultisigRCD:x", "a:b:c"} {
+		if _, err := ParseActivationHeights([]string{bad}, ""); err == nil {
+			t.Errorf("Expected an error for \"%s\"", bad)
+		}
+	}
+	if _, err := ParseActivationHeights(nil, f.Name()+".missing"); err == nil {
+		t.Errorf("Expected an error for a missing file")
+	}
+}
+
+func TestOverrideActivationHeights(t *testing.T) {
+	defer setNetwork("", "")
+
+	for _, net := range []string{"MAIN", "TEST"} {
+		setNetwork(net, "")
+		if err := OverrideActivationHeights(map[string]int{"MultisigRCD": 5}); err == nil {
+			t.Errorf("Expected %s to refuse overrides", net)
+		}
+	}
+
+	setNetwork("CUSTOM", "rehearsal")
+	if err := OverrideActivationHeights(map[string]int{"NoSuchFeature": 5}); err == nil {
+		t.Errorf("Expected an unknown activation to be refused")
+	}
+	if _, ok := ActivationMap[TIME_LOCKED_RCD].ActivationHeight["CUSTOM:rehearsal"]; ok {
+		t.Errorf("A refused override changed the heights")
+	}
+
+	err := OverrideActivationHeights(map[string]int{"MultisigRCD": 5, "TimeLockedRCD": 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if IsActive(MULTISIG_RCD, 4) || !IsActive(MULTISIG_RCD, 5) {
+		t.Errorf("MultisigRCD should activate at 5")
+	}
+
+	for _, a := range GetActivationStatus(6) {
+		switch a.Id {
+		case MULTISIG_RCD:
+			if a.Height != 5 || !a.Active {
+				t.Errorf("Wrong status %v", a)
+			}
+		case TIME_LOCKED_RCD:
+			if a.Height != 7 || a.Active {
+				t.Errorf("Wrong status %v", a)
+			}
+		case GOVERNANCE_GRANTS:
+			if a.Height != math.MaxInt32 || a.Active {
+				t.Errorf("Wrong status %v", a)
+			}
+		}
+	}
+	if len(GetActivationStatus(0)) != ACTIVATION_TYPE_COUNT {
+		t.Errorf("Expected every activation in the status")
+	}
+}
+
+func TestIsActiveOnOtherNetworks(t *testing.T) {
+	defer setNetwork("", "")
+
+	// The first lookup on a network an activation does not list must agree with the later ones
+	for _, net := range []string{"TEST", "CUSTOM"} {
+		setNetwork(net, "unlisted")
+		for i := 0; i < 2; i++ {
+			if IsActive(MULTISIG_RCD, 1000) || IsActive(AUTHORITY_CHANGE_VOTES, 1000) {
+				t.Errorf("Expected the activations to be inactive on %s", net)
+			}
+		}
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package alerts raises alerts from built-in detectors of trouble on a node, and sends
+// them to the log, the control panel, a webhook and the node's peers.
+//
+// A condition alert, such as the minute not advancing, is sent when the condition
+// starts, and again marked resolved when it ends.  An event alert, such as an election
+// starting, is sent each time it happens.  Alerts received from peers are logged and
+// shown, but not sent on.
+package alerts
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/log"
+	"github.com/sirupsen/logrus"
+)
+
+// The detectors
+const (
+	MinuteStalled    = "minute-stalled"       // the minute has not advanced
+	ElectionStarted  = "election-started"     // an election started to replace a leader
+	ElectionFinished = "election-finished"    // an election replaced a leader
+	Faulted          = "faulted"              // this node was replaced as a leader
+	DBSigMismatch    = "dbsig-mismatch"       // a majority of DBSigs do not match our block
+	SaveFailed       = "fastboot-save-failed" // the fastboot state could not be saved
+	EntrySyncStalled = "entry-sync-stalled"   // missing entries are not being found
+	LowPeers         = "low-peers"            // fewer peers than the health threshold
+)
+
+// Detectors are all the detectors, with the severity of their alerts
+var Detectors = map[string]string{
+	MinuteStalled:    Critical,
+	ElectionStarted:  Warning,
+	ElectionFinished: Info,
+	Faulted:          Critical,
+	DBSigMismatch:    Critical,
+	SaveFailed:       Critical,
+	EntrySyncStalled: Warning,
+	LowPeers:         Warning,
+}
+
+const (
+	Info     = "info"
+	Warning  = "warning"
+	Critical = "critical"
+)
+
+const (
+	maxRecent      = 200         // alerts kept for the control panel and API
+	maxMessage     = 500         // longest message accepted from a peer
+	peerInterval   = time.Second // shortest time between alerts accepted from a peer
+	webhookQueue   = 100
+	webhookTimeout = 10 * time.Second
+)
+
+type Alert struct {
+	Time     time.Time `json:"time"`
+	Node     string    `json:"node"`
+	Name     string    `json:"name"`
+	Severity string    `json:"severity"`
+	DBHeight uint32    `json:"dbheight"`
+	Message  string    `json:"message"`
+	Resolved bool      `json:"resolved,omitempty"` // a condition alert that ended
+	Peer     string    `json:"peer,omitempty"`     // the peer the alert came from, if not raised here
+}
+
+func (a Alert) String() string {
+	s := fmt.Sprintf("%s %-8s %-20s %s %d: %s", a.Time.Format("2006-01-02 15:04:05"), a.Severity, a.Name, a.Node, a.DBHeight, a.Message)
+	if a.Resolved {
+		s += " (resolved)"
+	}
+	if a.Peer != "" {
+		s += " from " + a.Peer
+	}
+	return s
+}
+
+type emitter struct {
+	mutex     sync.Mutex
+	recent    []Alert
+	active    map[string]map[string]Alert // [node][detector]
+	last      map[string]map[string]Alert // last event alert, [node][detector]
+	peers     map[string]time.Time        // last alert from each peer
+	webhook   *webhook
+	broadcast func(payload []byte)
+	listeners []func(Alert)
+	now       func() time.Time
+}
+
+var e = newEmitter()
+
+var logger = log.Subsystem("alerts")
+
+func newEmitter() *emitter {
+	em := new(emitter)
+	em.active = make(map[string]map[string]Alert)
+	em.last = make(map[string]map[string]Alert)
+	em.peers = make(map[string]time.Time)
+	em.now = time.Now
+	return em
+}
+
+// Raise sends an event alert.  The same alert twice in a row is only sent once.
+func Raise(node string, name string, dbheight uint32, format string, args ...interface{}) {
+	e.raise(node, name, dbheight, fmt.Sprintf(format, args...))
+}
+
+// Set sends a condition alert when the condition starts or ends
+func Set(node string, name string, active bool, dbheight uint32, format string, args ...interface{}) {
+	if !active {
+		e.clear(node, name, dbheight)
+		return
+	}
+	e.set(node, name, dbheight, fmt.Sprintf(format, args...))
+}
+
+func (em *emitter) raise(node string, name string, dbheight uint32, message string) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	if em.last[node] == nil {
+		em.last[node] = make(map[string]Alert)
+	}
+	if last, ok := em.last[node][name]; ok && last.DBHeight == dbheight && last.Message == message {
+		return
+	}
+	a := em.alert(node, name, dbheight, message)
+	em.last[node][name] = a
+	em.emit(a)
+}
+
+func (em *emitter) set(node string, name string, dbheight uint32, message string) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	if em.active[node] == nil {
+		em.active[node] = make(map[string]Alert)
+	}
+	if _, ok := em.active[node][name]; ok {
+		return
+	}
+	a := em.alert(node, name, dbheight, message)
+	em.active[node][name] = a
+	em.emit(a)
+}
+
+func (em *emitter) clear(node string, name string, dbheight uint32) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	a, ok := em.active[node][name]
+	if !ok {
+		return
+	}
+	delete(em.active[node], name)
+	a.Time = em.now()
+	a.DBHeight = dbheight
+	a.Resolved = true
+	em.emit(a)
+}
+
+func (em *emitter) alert(node string, name string, dbheight uint32, message string) Alert {
+	return Alert{Time: em.now(), Node: node, Name: name, Severity: Detectors[name], DBHeight: dbheight, Message: message}
+}
+
+// emit sends an alert raised on this node everywhere.  Assumes the mutex is locked already.
+func (em *emitter) emit(a Alert) {
+	em.record(a)
+	for _, listen := range em.listeners {
+		go listen(a)
+	}
+	if em.webhook != nil {
+		em.webhook.send(a)
+	}
+	if em.broadcast != nil {
+		payload, err := json.Marshal(a)
+		if err == nil {
+			em.broadcast(payload)
+		}
+	}
+}
+
+// record keeps and logs an alert.  Assumes the mutex is locked already.
+func (em *emitter) record(a Alert) {
+	em.recent = append(em.recent, a)
+	if len(em.recent) > maxRecent {
+		em.recent = em.recent[len(em.recent)-maxRecent:]
+	}
+
+	entry := logger.WithFields(logrus.Fields{"node": a.Node, "alert": a.Name, "dbheight": a.DBHeight})
+	if a.Peer != "" {
+		entry = entry.WithField("peer", a.Peer)
+	}
+	switch {
+	case a.Resolved:
+		entry.Info("Resolved: " + a.Message)
+	case a.Severity == Critical:
+		entry.Error(a.Message)
+	case a.Severity == Warning:
+		entry.Warn(a.Message)
+	default:
+		entry.Info(a.Message)
+	}
+}
+
+// Received takes an alert sent by a peer
+func Received(peer string, payload []byte) error {
+	return e.received(peer, payload)
+}
+
+func (em *emitter) received(peer string, payload []byte) error {
+	var a Alert
+	err := json.Unmarshal(payload, &a)
+	if err != nil {
+		return err
+	}
+	if _, ok := Detectors[a.Name]; !ok {
+		return fmt.Errorf("Unknown alert %q", a.Name)
+	}
+	if len(a.Message) > maxMessage || len(a.Node) > maxMessage {
+		return fmt.Errorf("Alert %s is too long", a.Name)
+	}
+	a.Severity = Detectors[a.Name]
+	a.Peer = peer
+
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	now := em.now()
+	if now.Sub(em.peers[peer]) < peerInterval {
+		return fmt.Errorf("Too many alerts from %s", peer)
+	}
+	em.peers[peer] = now
+	em.record(a)
+	return nil
+}
+
+// Recent returns the last alerts of the node, or of every node and peer if node is "",
+// oldest first
+func Recent(node string) []Alert {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	var alerts []Alert
+	for _, a := range e.recent {
+		if node == "" || (a.Node == node && a.Peer == "") {
+			alerts = append(alerts, a)
+		}
+	}
+	return alerts
+}
+
+// Active returns the node's conditions that have not ended, by detector
+func Active(node string) []Alert {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	var alerts []Alert
+	for _, a := range e.active[node] {
+		alerts = append(alerts, a)
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Name < alerts[j].Name })
+	return alerts
+}
+
+// SetBroadcaster sends the alerts raised on this node to its peers, through a function
+// that broadcasts the JSON encoded alert
+func SetBroadcaster(broadcast func(payload []byte)) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.broadcast = broadcast
+}
+
+// AddListener calls a function with each alert raised on this node, on its own goroutine
+func AddListener(listen func(Alert)) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.listeners = append(e.listeners, listen)
+}
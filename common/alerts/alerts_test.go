@@ -0,0 +1,148 @@
+package alerts
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testEmitter() (*emitter, *time.Time) {
+	em := newEmitter()
+	now := time.Unix(1000, 0)
+	em.now = func() time.Time { return now }
+	return em, &now
+}
+
+func TestConditionAlerts(t *testing.T) {
+	em, _ := testEmitter()
+	var sent []Alert
+	em.broadcast = func(payload []byte) {
+		var a Alert
+		if err := json.Unmarshal(payload, &a); err != nil {
+			t.Error(err)
+		}
+		sent = append(sent, a)
+	}
+
+	em.clear("FNode0", MinuteStalled, 5) // not active, nothing to resolve
+	em.set("FNode0", MinuteStalled, 5, "minute 3 started 200 seconds ago")
+	em.set("FNode0", MinuteStalled, 5, "minute 3 started 201 seconds ago")
+	em.set("FNode1", MinuteStalled, 5, "minute 3 started 200 seconds ago")
+	if len(em.recent) != 2 || len(em.active["FNode0"]) != 1 {
+		t.Fatalf("Expected an alert for each node, got %v", em.recent)
+	}
+	if em.recent[0].Severity != Critical || em.recent[0].Resolved {
+		t.Errorf("Wrong alert %v", em.recent[0])
+	}
+
+	em.clear("FNode0", MinuteStalled, 6)
+	if len(em.recent) != 3 || !em.recent[2].Resolved || em.recent[2].DBHeight != 6 {
+		t.Errorf("Expected the alert to be resolved, got %v", em.recent)
+	}
+	if len(em.active["FNode0"]) != 0 {
+		t.Errorf("Expected no active alerts")
+	}
+	if len(sent) != 3 || sent[2].Name != MinuteStalled {
+		t.Errorf("Expected the alerts to be broadcast, got %v", sent)
+	}
+}
+
+func TestListeners(t *testing.T) {
+	em, _ := testEmitter()
+	heard := make(chan Alert, 1)
+	em.listeners = append(em.listeners, func(a Alert) { heard <- a })
+	em.raise("FNode0", ElectionStarted, 11, "VM 1 minute 2")
+	select {
+	case a := <-heard:
+		if a.Name != ElectionStarted || a.Node != "FNode0" {
+			t.Errorf("Wrong alert %v", a)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("The listener was not called")
+	}
+
+	payload, _ := json.Marshal(Alert{Node: "Other", Name: Faulted})
+	em.received("peer1", payload)
+	select {
+	case a := <-heard:
+		t.Errorf("Expected alerts from peers not to be heard, got %v", a)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestEventAlerts(t *testing.T) {
+	em, _ := testEmitter()
+	em.raise("FNode0", DBSigMismatch, 10, "3 of 5 DBSigs do not match")
+	em.raise("FNode0", DBSigMismatch, 10, "3 of 5 DBSigs do not match")
+	em.raise("FNode0", DBSigMismatch, 11, "3 of 5 DBSigs do not match")
+	em.raise("FNode0", ElectionStarted, 11, "VM 1 minute 2")
+	if len(em.recent) != 3 {
+		t.Errorf("Expected a repeated alert to be sent once, got %v", em.recent)
+	}
+
+	for i := 0; i < maxRecent; i++ {
+		em.raise("FNode0", ElectionStarted, uint32(i), "VM 1 minute 2")
+	}
+	if len(em.recent) != maxRecent || em.recent[maxRecent-1].DBHeight != maxRecent-1 {
+		t.Errorf("Expected the last %d alerts to be kept", maxRecent)
+	}
+}
+
+func TestReceived(t *testing.T) {
+	em, now := testEmitter()
+	em.broadcast = func(payload []byte) {
+		t.Error("Expected alerts from peers not to be sent on")
+	}
+
+	payload, _ := json.Marshal(Alert{Node: "Other", Name: Faulted, Severity: Info, Message: "replaced"})
+	if err := em.received("peer1", payload); err != nil {
+		t.Fatal(err)
+	}
+	if err := em.received("peer1", payload); err == nil {
+		t.Error("Expected a second alert in the same second to be refused")
+	}
+	*now = now.Add(peerInterval)
+	if err := em.received("peer1", payload); err != nil {
+		t.Error(err)
+	}
+	if len(em.recent) != 2 || em.recent[0].Peer != "peer1" || em.recent[0].Severity != Critical {
+		t.Errorf("Wrong alerts %v", em.recent)
+	}
+
+	bad, _ := json.Marshal(Alert{Node: "Other", Name: "everything-is-on-fire"})
+	for _, p := range [][]byte{bad, []byte("{")} {
+		if err := em.received("peer2", p); err == nil {
+			t.Errorf("Expected %s to be refused", p)
+		}
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	received := make(chan Alert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var a Alert
+		if err := json.Unmarshal(body, &a); err != nil {
+			t.Error(err)
+		}
+		received <- a
+	}))
+	defer server.Close()
+
+	em, _ := testEmitter()
+	em.webhook = newWebhook(server.URL)
+	go em.webhook.run()
+	em.raise("FNode0", SaveFailed, 20, "disk full")
+
+	select {
+	case a := <-received:
+		if a.Name != SaveFailed || a.Message != "disk full" || a.DBHeight != 20 {
+			t.Errorf("Wrong alert %v", a)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("The webhook was not called")
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package alerts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// webhook posts each alert raised on this node as JSON to a URL.  Alerts are queued so a
+// slow receiver does not hold up the node, and dropped if the queue is full.
+type webhook struct {
+	url     string
+	client  *http.Client
+	queue   chan Alert
+	failing bool // only the first of a run of errors is logged
+}
+
+// EnableWebhook posts the alerts raised on this node to the URL
+func EnableWebhook(url string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.webhook != nil {
+		return
+	}
+	e.webhook = newWebhook(url)
+	go e.webhook.run()
+}
+
+func newWebhook(url string) *webhook {
+	w := new(webhook)
+	w.url = url
+	w.client = &http.Client{Timeout: webhookTimeout}
+	w.queue = make(chan Alert, webhookQueue)
+	return w
+}
+
+func (w *webhook) send(a Alert) {
+	select {
+	case w.queue <- a:
+	default:
+		logger.WithField("alert", a.Name).Warn("Webhook queue is full, alert dropped")
+	}
+}
+
+func (w *webhook) run() {
+	for a := range w.queue {
+		err := w.post(a)
+		if err != nil {
+			if !w.failing {
+				logger.WithField("url", w.url).Errorf("Webhook failed: %v", err)
+			}
+			w.failing = true
+			continue
+		}
+		w.failing = false
+	}
+}
+
+func (w *webhook) post(a Alert) error {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, body)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid
+
+import (
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+/**************************************
+ * Multisig Signature Block
+ *
+ * The signature block for an RCD_2 input.  Each signature names the index of
+ * the address it signs for in the RCD_2, the RCD behind that address (so we
+ * can check the address), and the signature block that RCD needs.  If that
+ * RCD is itself a multisig, its signature block is another one of these.
+ *
+ * Binary:   uint16 count, then count times:
+ *             uint16 index, RCD, signature block for that RCD
+ **************************************/
+
+type MultisigSignature struct {
+	Index    int                        `json:"index"`
+	RCD      interfaces.IRCD            `json:"rcd"`
+	SigBlock interfaces.ISignatureBlock `json:"sigblock"`
+}
+
+type MultisigSignatureBlock struct {
+	Signatures []*MultisigSignature `json:"signatures"`
+}
+
+var _ interfaces.ISignatureBlock = (*MultisigSignatureBlock)(nil)
+
+// AddMultisigSignature adds (or replaces) the signature for one address of the
+// multisig, keeping the signatures in address order as CheckSig requires
+func (b *MultisigSignatureBlock) AddMultisigSignature(index int, rcd interfaces.IRCD, sigblk interfaces.ISignatureBlock) {
+	sig := &MultisigSignature{Index: index, RCD: rcd, SigBlock: sigblk}
+	for i, s := range b.Signatures {
+		if s.Index == index {
+			b.Signatures[i] = sig
+			return
+		}
+		if s.Index > index {
+			b.Signatures = append(b.Signatures, nil)
+			copy(b.Signatures[i+1:], b.Signatures[i:])
+			b.Signatures[i] = sig
+			return
+		}
+	}
+	b.Signatures = append(b.Signatures, sig)
+}
+
+func (b *MultisigSignatureBlock) IsSameAs(s interfaces.ISignatureBlock) bool {
+	if s == nil {
+		return b == nil
+	}
+	other, ok := s.(*MultisigSignatureBlock)
+	if !ok {
+		return false
+	}
+	d1, err := b.MarshalBinary()
+	if err != nil {
+		return false
+	}
+	d2, err := other.MarshalBinary()
+	if err != nil {
+		return false
+	}
+	return primitives.AreBytesEqual(d1, d2)
+}
+
+// AddSignature is meaningless without knowing which address signed, use AddMultisigSignature
+func (b *MultisigSignatureBlock) AddSignature(sig interfaces.ISignature) {
+}
+
+// GetSignature returns the index'th signature of all those in the block, nested ones included
+func (b *MultisigSignatureBlock) GetSignature(index int) interfaces.ISignature {
+	sigs := b.GetSignatures()
+	if len(sigs) <= index {
+		return nil
+	}
+	return sigs[index]
+}
+
+// GetSignatures returns all the signatures in the block, nested ones included
+func (b *MultisigSignatureBlock) GetSignatures() []interfaces.ISignature {
+	var sigs []interfaces.ISignature
+	for _, s := range b.Signatures {
+		if s.SigBlock != nil {
+			sigs = append(sigs, s.SigBlock.GetSignatures()...)
+		}
+	}
+	return sigs
+}
+
+func (b *MultisigSignatureBlock) MarshalBinary() ([]byte, error) {
+	buf := primitives.NewBuffer(nil)
+	err := buf.PushUInt16(uint16(len(b.Signatures)))
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range b.Signatures {
+		if s.RCD == nil || s.SigBlock == nil {
+			return nil, fmt.Errorf("Multisig signature %d is incomplete", s.Index)
+		}
+		err = buf.PushUInt16(uint16(s.Index))
+		if err != nil {
+			return nil, err
+		}
+		err = buf.PushBinaryMarshallable(s.RCD)
+		if err != nil {
+			return nil, err
+		}
+		err = buf.PushBinaryMarshallable(s.SigBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf.DeepCopyBytes(), nil
+}
+
+func (b *MultisigSignatureBlock) UnmarshalBinaryData(data []byte) ([]byte, error) {
+	buf := primitives.NewBuffer(data)
+	count, err := buf.PopUInt16()
+	if err != nil {
+		return nil, err
+	}
+	// Each signature needs at least an index and an RCD type byte
+	if int(count)*3 > buf.Len() {
+		return nil, fmt.Errorf("Multisig signature count %d is larger than the data", count)
+	}
+	b.Signatures = make([]*MultisigSignature, int(count))
+	for i := range b.Signatures {
+		s := new(MultisigSignature)
+		index, err := buf.PopUInt16()
+		if err != nil {
+			return nil, err
+		}
+		s.Index = int(index)
+
+		t, err := buf.PeekByte()
+		if err != nil {
+			return nil, err
+		}
+		if t != 1 && t != 2 && t != 3 {
+			return nil, fmt.Errorf("Invalid type byte for authorizations: %x ", t)
+		}
+		s.RCD = CreateRCD([]byte{t})
+		err = buf.PopBinaryMarshallable(s.RCD)
+		if err != nil {
+			return nil, err
+		}
+		s.SigBlock = NewSignatureBlockForRCD(s.RCD)
+		err = buf.PopBinaryMarshallable(s.SigBlock)
+		if err != nil {
+			return nil, err
+		}
+		b.Signatures[i] = s
+	}
+	return buf.DeepCopyBytes(), nil
+}
+
+func (b *MultisigSignatureBlock) UnmarshalBinary(data []byte) error {
+	_, err := b.UnmarshalBinaryData(data)
+	return err
+}
+
+func (b *MultisigSignatureBlock) JSONByte() ([]byte, error) {
+	return primitives.EncodeJSON(b)
+}
+
+func (b *MultisigSignatureBlock) JSONString() (string, error) {
+	return primitives.EncodeJSONString(b)
+}
+
+func (b *MultisigSignatureBlock) String() string {
+	txt, err := b.CustomMarshalText()
+	if err != nil {
+		return "<error>"
+	}
+	return string(txt)
+}
+
+func (b *MultisigSignatureBlock) CustomMarshalText() ([]byte, error) {
+	var out primitives.Buffer
+
+	out.WriteString("Multisig Signature Block: \n")
+	for _, s := range b.Signatures {
+		out.WriteString(fmt.Sprintf(" index: %d\n ", s.Index))
+		if s.RCD != nil {
+			txt, err := s.RCD.CustomMarshalText()
+			if err != nil {
+				return nil, err
+			}
+			out.Write(txt)
+		}
+		if s.SigBlock != nil {
+			txt, err := s.SigBlock.CustomMarshalText()
+			if err != nil {
+				return nil, err
+			}
+			out.Write(txt)
+		}
+	}
+
+	return out.DeepCopyBytes(), nil
+}
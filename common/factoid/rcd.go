@@ -33,6 +33,8 @@ func UnmarshalBinaryAuth(data []byte) (a interfaces.IRCD, newData []byte, err er
 		auth = new(RCD_1)
 	case 2:
 		auth = new(RCD_2)
+	case 3:
+		auth = new(RCD_3)
 	default:
 		return nil, nil, fmt.Errorf("Invalid type byte for authorizations: %x ", int(t))
 	}
@@ -49,10 +51,17 @@ func NewRCD_1(publicKey []byte) interfaces.IRCD {
 	return a
 }
 
+// NewRCD_2 returns a multisig RCD that needs n signatures from the m addresses
 func NewRCD_2(n int, m int, addresses []interfaces.IAddress) (interfaces.IRCD, error) {
 	if len(addresses) != m {
 		return nil, fmt.Errorf("Improper number of addresses.  m = %d n = %d #addresses = %d", m, n, len(addresses))
 	}
+	if n < 1 || n > m {
+		return nil, fmt.Errorf("Improper number of required signatures.  m = %d n = %d", m, n)
+	}
+	if err := checkDistinctAddresses(addresses); err != nil {
+		return nil, err
+	}
 
 	au := new(RCD_2)
 	au.N = n
@@ -63,12 +72,64 @@ func NewRCD_2(n int, m int, addresses []interfaces.IAddress) (interfaces.IRCD, e
 	return au, nil
 }
 
+// NewRCD_3 returns an RCD that can't spend until the lock height (LockHeight) or
+// unix time in seconds (LockTime) has passed, and then needs rcd to sign
+func NewRCD_3(lockType byte, lock uint64, rcd interfaces.IRCD) (interfaces.IRCD, error) {
+	if lockType != LockHeight && lockType != LockTime {
+		return nil, fmt.Errorf("Invalid lock type %d", lockType)
+	}
+	switch rcd.(type) {
+	case *RCD_1, *RCD_2:
+	default:
+		return nil, fmt.Errorf("A locked RCD must wrap a type 1 or type 2 RCD")
+	}
+
+	au := new(RCD_3)
+	au.LockType = lockType
+	au.Lock = lock
+	au.RCD = rcd
+
+	return au, nil
+}
+
+// NewSignatureBlockForRCD returns an empty signature block of the kind the RCD
+// is signed with
+func NewSignatureBlockForRCD(rcd interfaces.IRCD) interfaces.ISignatureBlock {
+	switch r := rcd.(type) {
+	case *RCD_2:
+		return new(MultisigSignatureBlock)
+	case *RCD_3:
+		// A locked RCD is signed the way the RCD it wraps is
+		return NewSignatureBlockForRCD(r.RCD)
+	}
+	return new(SignatureBlock)
+}
+
+// NewPlaceholderSignatureBlock returns an unsigned signature block the size a signed
+// one for the RCD will be, so fees can be worked out before signing.  Multisig signers
+// are assumed to be simple (RCD type 1) addresses.
+func NewPlaceholderSignatureBlock(rcd interfaces.IRCD) interfaces.ISignatureBlock {
+	switch r := rcd.(type) {
+	case *RCD_2:
+		sigs := new(MultisigSignatureBlock)
+		for i := 0; i < r.N; i++ {
+			sigs.AddMultisigSignature(i, new(RCD_1), new(SignatureBlock))
+		}
+		return sigs
+	case *RCD_3:
+		return NewPlaceholderSignatureBlock(r.RCD)
+	}
+	return new(SignatureBlock)
+}
+
 func CreateRCD(data []byte) interfaces.IRCD {
 	switch data[0] {
 	case 1:
 		return new(RCD_1)
 	case 2:
 		return new(RCD_2)
+	case 3:
+		return new(RCD_3)
 	default:
 		panic("Bad Data encountered by CreateRCD.  Should never happen")
 	}
@@ -7,7 +7,9 @@ package factoid
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
@@ -18,59 +20,123 @@ import (
  ************************/
 
 // Type 2 RCD implement multisig
-// m of n
+// n of m
 // Must have m addresses from which to choose, no fewer, no more
-// Must have n RCD, no fewer no more.
+// Must have n valid signatures from those addresses to spend.
+// Each address is the address of another RCD, so the signature block
+// names the RCD behind each address that signs (see MultisigSignatureBlock).
 // NOTE: This does mean you can have a multisig nested in a
 // multisig.  It just works.
 
 type RCD_2 struct {
-	M           int                   // Number signatures required
-	N           int                   // Total signatures possible
-	N_Addresses []interfaces.IAddress // n addresses
+	M           int                   // Total signatures possible, the number of addresses
+	N           int                   // Number signatures required
+	N_Addresses []interfaces.IAddress // m addresses
 }
 
 var _ interfaces.IRCD = (*RCD_2)(nil)
 
-/*************************************
- *       Stubs
- *************************************/
+/***************************************
+ *       Methods
+ ***************************************/
 
+// GetAddress returns the address of the multisig, the hash of the RCD like any other
 func (b RCD_2) GetAddress() (interfaces.IAddress, error) {
-	return nil, nil
+	data, err := b.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return CreateAddress(primitives.Shad(data)), nil
 }
 
 func (b RCD_2) NumberOfSignatures() int {
-	return 1
+	return b.N
 }
 
-/***************************************
- *       Methods
- ***************************************/
-
 func (b RCD_2) IsSameAs(rcd interfaces.IRCD) bool {
 	return b.String() == rcd.String()
 }
 
-func (b RCD_2) UnmarshalBinary(data []byte) error {
+func (b *RCD_2) UnmarshalBinary(data []byte) error {
 	_, err := b.UnmarshalBinaryData(data)
 	return err
 }
 
+// CheckSig is true if at least N of the addresses have signed the transaction.  The
+// signatures must be given in increasing address order, so no key can be counted twice
+// and there is only one valid way to write a signature block.
 func (b RCD_2) CheckSig(trans interfaces.ITransaction, sigblk interfaces.ISignatureBlock) bool {
-	return false
+	msigs, ok := sigblk.(*MultisigSignatureBlock)
+	if !ok || msigs == nil || b.N < 1 {
+		return false
+	}
+
+	valid := 0
+	last := -1
+	for _, sig := range msigs.Signatures {
+		if sig == nil || sig.RCD == nil || sig.SigBlock == nil {
+			return false
+		}
+		if sig.Index <= last || sig.Index >= len(b.N_Addresses) {
+			return false
+		}
+		last = sig.Index
+
+		// The RCD has to be the one behind the address it claims to sign for
+		address, err := sig.RCD.GetAddress()
+		if err != nil || address == nil || !address.IsSameAs(b.N_Addresses[sig.Index]) {
+			return false
+		}
+		if !sig.RCD.CheckSig(trans, sig.SigBlock) {
+			return false
+		}
+		valid++
+	}
+	return valid >= b.N
 }
 
 func (e *RCD_2) JSONByte() ([]byte, error) {
 	return primitives.EncodeJSON(e)
 }
 
-// TODO: Fix Json marshaling of RCD_2. Right now the RCD type
-// is not included in the json marshal.
 func (e *RCD_2) JSONString() (string, error) {
 	return primitives.EncodeJSONString(e)
 }
 
+// MarshalJSON writes the RCD as hex, type byte first, the same as RCD_1
+func (e *RCD_2) MarshalJSON() (rval []byte, err error) {
+	defer func(pe *error) {
+		if *pe != nil {
+			fmt.Fprintf(os.Stderr, "RCD_2.MarshalJSON err:%v", *pe)
+		}
+	}(&err)
+	data, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(hex.EncodeToString(data))
+}
+
+func (e *RCD_2) UnmarshalJSON(data []byte) error {
+	var str string
+	err := json.Unmarshal(data, &str)
+	if err != nil {
+		return err
+	}
+	bin, err := hex.DecodeString(str)
+	if err != nil {
+		return err
+	}
+	rest, err := e.UnmarshalBinaryData(bin)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("RCD_2.UnmarshalJSON: %d bytes of extra data", len(rest))
+	}
+	return nil
+}
+
 func (b RCD_2) String() string {
 	txt, err := b.CustomMarshalText()
 	if err != nil {
@@ -126,6 +192,9 @@ func (t *RCD_2) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 			t.M, t.N,
 		)
 	}
+	if t.N < 1 {
+		return nil, fmt.Errorf("Error: RCD_2.UnmarshalBinary: at least one signature must be required")
+	}
 
 	sigLimit := len(data) / 32
 	if t.M > sigLimit {
@@ -147,10 +216,27 @@ func (t *RCD_2) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
 			return nil, err
 		}
 	}
+	if err := checkDistinctAddresses(t.N_Addresses); err != nil {
+		return nil, err
+	}
 
 	return data, nil
 }
 
+// checkDistinctAddresses refuses a multisig listing an address twice, as its key would
+// count twice toward the signatures required
+func checkDistinctAddresses(addresses []interfaces.IAddress) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		key := string(address.Bytes())
+		if seen[key] {
+			return fmt.Errorf("Address %x is listed more than once in the multisig", address.Bytes())
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
 func (a RCD_2) CustomMarshalText() ([]byte, error) {
 	var out primitives.Buffer
 
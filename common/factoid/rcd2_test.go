@@ -5,11 +5,13 @@
 package factoid_test
 
 import (
+	"encoding/json"
 	"math/rand"
 	"testing"
 
 	. "github.com/FactomProject/factomd/common/factoid"
 	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/testHelper"
 )
 
 func TestUnmarshalNilRCD_2(t *testing.T) {
@@ -86,6 +88,26 @@ func TestUnmarshalBadRCD2(t *testing.T) {
 	}
 }
 
+func TestRCD2DuplicateAddresses(t *testing.T) {
+	a, b := nextAddress(), nextAddress()
+	if _, err := NewRCD_2(2, 3, []interfaces.IAddress{a, b, a}); err == nil {
+		t.Error("Expected an address listed twice to be refused")
+	}
+
+	rcd, err := NewRCD_2(2, 2, []interfaces.IAddress{a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := rcd.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(p[5+32:], a.Bytes()) // list the first address twice
+	if _, err := new(RCD_2).UnmarshalBinaryData(p); err == nil {
+		t.Error("Expected an address listed twice to be refused on unmarshal")
+	}
+}
+
 func TestRCD2Clone(t *testing.T) {
 	rcd := nextAuth2_rcd2()
 
@@ -128,3 +150,126 @@ func nextAuth2_rcd2() *RCD_2 {
 	rcd, _ := NewRCD_2(n, m, addresses)
 	return rcd.(*RCD_2)
 }
+
+// multisigTransaction returns a transaction spending from an n of m multisig of
+// the test keys 0..m-1, and the multisig RCD
+func multisigTransaction(n, m int) (*Transaction, *RCD_2) {
+	addresses := make([]interfaces.IAddress, m)
+	for i := range addresses {
+		addresses[i], _ = testHelper.NewFactoidRCDAddress(uint64(i)).GetAddress()
+	}
+	rcd, _ := NewRCD_2(n, m, addresses)
+	rcd2 := rcd.(*RCD_2)
+
+	tx := new(Transaction)
+	adr, _ := rcd2.GetAddress()
+	tx.AddInput(adr, 1000)
+	tx.AddOutput(testHelper.NewFactoidAddress(10), 1000)
+	tx.AddAuthorization(rcd2)
+	return tx, rcd2
+}
+
+func signMultisig(tx *Transaction, keys ...int) *MultisigSignatureBlock {
+	data, _ := tx.MarshalBinarySig()
+	sigs := new(MultisigSignatureBlock)
+	for _, k := range keys {
+		sigs.AddMultisigSignature(k, testHelper.NewFactoidRCDAddress(uint64(k)), NewSingleSignatureBlock(testHelper.NewPrivKey(uint64(k)), data))
+	}
+	return sigs
+}
+
+func TestRCD2CheckSig(t *testing.T) {
+	tx, rcd := multisigTransaction(2, 3)
+
+	if rcd.NumberOfSignatures() != 2 {
+		t.Errorf("Expected 2 signatures, found %d", rcd.NumberOfSignatures())
+	}
+	if err := tx.Validate(1); err != nil {
+		t.Errorf("Multisig input should match its RCD: %v", err)
+	}
+
+	if !rcd.CheckSig(tx, signMultisig(tx, 0, 2)) {
+		t.Error("2 of 3 signatures should be valid")
+	}
+	if !rcd.CheckSig(tx, signMultisig(tx, 2, 1, 0)) {
+		t.Error("3 of 3 signatures should be valid")
+	}
+	if rcd.CheckSig(tx, signMultisig(tx, 1)) {
+		t.Error("1 of 3 signatures should not be valid")
+	}
+	if rcd.CheckSig(tx, new(SignatureBlock)) {
+		t.Error("A single signature block should not be valid")
+	}
+
+	// The same key twice doesn't count twice
+	sigs := signMultisig(tx, 1)
+	sigs.Signatures = append(sigs.Signatures, sigs.Signatures[0])
+	if rcd.CheckSig(tx, sigs) {
+		t.Error("Duplicate signatures should not be valid")
+	}
+
+	// A key that isn't in the multisig
+	sigs = signMultisig(tx, 0)
+	data, _ := tx.MarshalBinarySig()
+	sigs.AddMultisigSignature(1, testHelper.NewFactoidRCDAddress(5), NewSingleSignatureBlock(testHelper.NewPrivKey(5), data))
+	if rcd.CheckSig(tx, sigs) {
+		t.Error("A signature from an outside key should not be valid")
+	}
+
+	// Signatures of a different transaction
+	other, _ := multisigTransaction(2, 3)
+	other.AddOutput(testHelper.NewFactoidAddress(11), 1)
+	if rcd.CheckSig(tx, signMultisig(other, 0, 1)) {
+		t.Error("Signatures of another transaction should not be valid")
+	}
+}
+
+func TestRCD2TransactionMarshal(t *testing.T) {
+	tx, _ := multisigTransaction(2, 3)
+	tx.SetSignatureBlock(0, signMultisig(tx, 0, 1))
+	if err := tx.ValidateSignatures(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx2 := new(Transaction)
+	rest, err := tx2.UnmarshalBinaryData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 0 {
+		t.Errorf("%d bytes left over", len(rest))
+	}
+	if !tx.IsSameAs(tx2) {
+		t.Error("Transactions are not the same")
+	}
+	if err := tx2.ValidateSignatures(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRCD2JSON(t *testing.T) {
+	_, rcd := multisigTransaction(2, 3)
+
+	data, err := json.Marshal(rcd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcd2 := new(RCD_2)
+	err = json.Unmarshal(data, rcd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rcd.IsSameAs(rcd2) {
+		t.Error("RCDs are not equal after a JSON round trip")
+	}
+
+	a1, _ := rcd.GetAddress()
+	a2, _ := rcd2.GetAddress()
+	if !a1.IsSameAs(a2) {
+		t.Error("Addresses are not equal after a JSON round trip")
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+/************************
+ * RCD 3
+ ************************/
+
+// Type 3 RCD locks funds until a directory block height or a time.
+// It wraps another RCD, which must sign to spend once the lock has
+// passed.  Funds sent to the address of an RCD_3 are locked from the
+// moment they arrive, which is how vesting grants are paid.
+
+const (
+	LockHeight = 0 // Lock is a directory block height
+	LockTime   = 1 // Lock is a unix time in seconds
+)
+
+type RCD_3 struct {
+	LockType byte            // LockHeight or LockTime
+	Lock     uint64          // Height or time at which the funds become spendable
+	RCD      interfaces.IRCD // The RCD that must sign to spend
+}
+
+var _ interfaces.IRCD = (*RCD_3)(nil)
+
+/***************************************
+ *       Methods
+ ***************************************/
+
+// IsLocked is true if the funds cannot be spent in a block at the given height
+// and timestamp (in milliseconds)
+func (b RCD_3) IsLocked(dbheight uint32, timestamp int64) bool {
+	if b.LockType == LockTime {
+		return uint64(timestamp/1000) < b.Lock
+	}
+	return uint64(dbheight) < b.Lock
+}
+
+// LockTypeName returns "height" or "time"
+func (b RCD_3) LockTypeName() string {
+	if b.LockType == LockTime {
+		return "time"
+	}
+	return "height"
+}
+
+func (b RCD_3) GetAddress() (interfaces.IAddress, error) {
+	data, err := b.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return CreateAddress(primitives.Shad(data)), nil
+}
+
+func (b RCD_3) NumberOfSignatures() int {
+	if b.RCD == nil {
+		return 0
+	}
+	return b.RCD.NumberOfSignatures()
+}
+
+func (b RCD_3) IsSameAs(rcd interfaces.IRCD) bool {
+	return b.String() == rcd.String()
+}
+
+// CheckSig only checks the wrapped RCD signed.  Whether the lock has passed depends on
+// the block, so that is checked by the factoid state.
+func (b RCD_3) CheckSig(trans interfaces.ITransaction, sigblk interfaces.ISignatureBlock) bool {
+	if b.RCD == nil {
+		return false
+	}
+	return b.RCD.CheckSig(trans, sigblk)
+}
+
+func (w RCD_3) Clone() interfaces.IRCD {
+	c := new(RCD_3)
+	c.LockType = w.LockType
+	c.Lock = w.Lock
+	if w.RCD != nil {
+		c.RCD = w.RCD.Clone()
+	}
+	return c
+}
+
+func (e *RCD_3) JSONByte() ([]byte, error) {
+	return primitives.EncodeJSON(e)
+}
+
+func (e *RCD_3) JSONString() (string, error) {
+	return primitives.EncodeJSONString(e)
+}
+
+// MarshalJSON writes the RCD as hex, type byte first, the same as RCD_1
+func (e *RCD_3) MarshalJSON() (rval []byte, err error) {
+	defer func(pe *error) {
+		if *pe != nil {
+			fmt.Fprintf(os.Stderr, "RCD_3.MarshalJSON err:%v", *pe)
+		}
+	}(&err)
+	data, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(hex.EncodeToString(data))
+}
+
+func (e *RCD_3) UnmarshalJSON(data []byte) error {
+	var str string
+	err := json.Unmarshal(data, &str)
+	if err != nil {
+		return err
+	}
+	bin, err := hex.DecodeString(str)
+	if err != nil {
+		return err
+	}
+	rest, err := e.UnmarshalBinaryData(bin)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("RCD_3.UnmarshalJSON: %d bytes of extra data", len(rest))
+	}
+	return nil
+}
+
+func (b RCD_3) String() string {
+	txt, err := b.CustomMarshalText()
+	if err != nil {
+		return "<error>"
+	}
+	return string(txt)
+}
+
+func (a RCD_3) MarshalBinary() ([]byte, error) {
+	if a.RCD == nil {
+		return nil, fmt.Errorf("RCD_3 has no RCD to unlock it")
+	}
+	var out primitives.Buffer
+
+	out.WriteByte(byte(3))
+	out.WriteByte(a.LockType)
+	binary.Write(&out, binary.BigEndian, a.Lock)
+	data, err := a.RCD.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	out.Write(data)
+
+	return out.DeepCopyBytes(), nil
+}
+
+func (b *RCD_3) UnmarshalBinary(data []byte) error {
+	_, err := b.UnmarshalBinaryData(data)
+	return err
+}
+
+func (t *RCD_3) UnmarshalBinaryData(data []byte) (newData []byte, err error) {
+	if data == nil || len(data) < 11 {
+		return nil, fmt.Errorf("Not enough data to unmarshal")
+	}
+	if data[0] != 3 {
+		return nil, fmt.Errorf("Bad data fed to RCD_3 UnmarshalBinaryData()")
+	}
+	t.LockType = data[1]
+	if t.LockType != LockHeight && t.LockType != LockTime {
+		return nil, fmt.Errorf("Error: RCD_3.UnmarshalBinary: invalid lock type %d", t.LockType)
+	}
+	t.Lock = binary.BigEndian.Uint64(data[2:10])
+	data = data[10:]
+
+	// Nesting locks would make no sense, so only simple and multisig RCDs can be wrapped
+	switch data[0] {
+	case 1:
+		t.RCD = new(RCD_1)
+	case 2:
+		t.RCD = new(RCD_2)
+	default:
+		return nil, fmt.Errorf("Error: RCD_3.UnmarshalBinary: cannot wrap an RCD of type %d", data[0])
+	}
+	return t.RCD.UnmarshalBinaryData(data)
+}
+
+func (a RCD_3) CustomMarshalText() ([]byte, error) {
+	var out primitives.Buffer
+
+	out.WriteString("RCD 3: ")
+	primitives.WriteNumber8(&out, uint8(3)) // Type 3 Authorization
+	out.WriteString(" locked until " + a.LockTypeName() + " ")
+	primitives.WriteNumber64(&out, a.Lock)
+	out.WriteString("\n")
+	if a.RCD != nil {
+		txt, err := a.RCD.CustomMarshalText()
+		if err != nil {
+			return nil, err
+		}
+		out.Write(txt)
+	}
+
+	return out.DeepCopyBytes(), nil
+}
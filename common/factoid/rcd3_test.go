@@ -0,0 +1,160 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package factoid_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestUnmarshalNilRCD_3(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Panic caught during the test - %v", r)
+		}
+	}()
+
+	a := new(RCD_3)
+	err := a.UnmarshalBinary(nil)
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+
+	err = a.UnmarshalBinary([]byte{})
+	if err == nil {
+		t.Errorf("Error is nil when it shouldn't be")
+	}
+}
+
+func TestNewRCD3(t *testing.T) {
+	inner := testHelper.NewFactoidRCDAddress(0)
+	if _, err := NewRCD_3(2, 10, inner); err == nil {
+		t.Error("An unknown lock type should not be allowed")
+	}
+	if _, err := NewRCD_3(LockHeight, 10, nil); err == nil {
+		t.Error("A lock without an RCD should not be allowed")
+	}
+	locked, _ := NewRCD_3(LockHeight, 10, inner)
+	if _, err := NewRCD_3(LockHeight, 20, locked); err == nil {
+		t.Error("Nested locks should not be allowed")
+	}
+}
+
+func TestRCD3MarshalUnmarshal(t *testing.T) {
+	_, multisig := multisigTransaction(2, 3)
+	for _, inner := range []interfaces.IRCD{testHelper.NewFactoidRCDAddress(0), multisig} {
+		for _, lockType := range []byte{LockHeight, LockTime} {
+			rcd, err := NewRCD_3(lockType, 123456, inner)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := rcd.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rcd2, rest, err := UnmarshalBinaryAuth(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rest) != 0 {
+				t.Errorf("%d bytes left over", len(rest))
+			}
+			if !rcd.IsSameAs(rcd2) {
+				t.Error("RCDs are not the same")
+			}
+			if !rcd.IsSameAs(rcd.Clone()) {
+				t.Error("Clone is not the same")
+			}
+
+			// The lock is part of the address, so the same key locked differently is a different address
+			a1, _ := rcd.GetAddress()
+			a2, _ := inner.GetAddress()
+			other, _ := NewRCD_3(lockType, 123457, inner)
+			a3, _ := other.GetAddress()
+			if a1.IsSameAs(a2) || a1.IsSameAs(a3) {
+				t.Error("Locked addresses should be unique to the lock")
+			}
+		}
+	}
+}
+
+func TestRCD3IsLocked(t *testing.T) {
+	inner := testHelper.NewFactoidRCDAddress(0)
+
+	rcd, _ := NewRCD_3(LockHeight, 100, inner)
+	locked := rcd.(*RCD_3)
+	if !locked.IsLocked(99, 0) {
+		t.Error("Should be locked below the lock height")
+	}
+	if locked.IsLocked(100, 0) {
+		t.Error("Should be unlocked at the lock height")
+	}
+
+	rcd, _ = NewRCD_3(LockTime, 1500000000, inner)
+	locked = rcd.(*RCD_3)
+	if !locked.IsLocked(1000000, 1499999999999) {
+		t.Error("Should be locked before the lock time")
+	}
+	if locked.IsLocked(0, 1500000000000) {
+		t.Error("Should be unlocked at the lock time")
+	}
+}
+
+func TestRCD3CheckSig(t *testing.T) {
+	rcd, _ := NewRCD_3(LockHeight, 100, testHelper.NewFactoidRCDAddress(0))
+
+	tx := new(Transaction)
+	adr, _ := rcd.GetAddress()
+	tx.AddInput(adr, 1000)
+	tx.AddOutput(testHelper.NewFactoidAddress(10), 1000)
+	tx.AddAuthorization(rcd)
+	if err := tx.Validate(1); err != nil {
+		t.Errorf("Locked input should match its RCD: %v", err)
+	}
+
+	data, _ := tx.MarshalBinarySig()
+	if !rcd.CheckSig(tx, NewSingleSignatureBlock(testHelper.NewPrivKey(0), data)) {
+		t.Error("Signature of the wrapped key should be valid")
+	}
+	if rcd.CheckSig(tx, NewSingleSignatureBlock(testHelper.NewPrivKey(1), data)) {
+		t.Error("Signature of another key should not be valid")
+	}
+
+	// A locked multisig is signed like the multisig
+	mtx, multisig := multisigTransaction(2, 3)
+	rcd, _ = NewRCD_3(LockTime, 1500000000, multisig)
+	mtx.RCDs[0] = rcd
+	if _, ok := NewSignatureBlockForRCD(rcd).(*MultisigSignatureBlock); !ok {
+		t.Error("A locked multisig should take a multisig signature block")
+	}
+	if !rcd.CheckSig(mtx, signMultisig(mtx, 0, 1)) {
+		t.Error("2 of 3 signatures should be valid")
+	}
+	if rcd.CheckSig(mtx, signMultisig(mtx, 1)) {
+		t.Error("1 of 3 signatures should not be valid")
+	}
+}
+
+func TestRCD3JSON(t *testing.T) {
+	rcd, _ := NewRCD_3(LockTime, 1500000000, testHelper.NewFactoidRCDAddress(0))
+
+	data, err := json.Marshal(rcd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcd2 := new(RCD_3)
+	err = json.Unmarshal(data, rcd2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rcd.IsSameAs(rcd2) {
+		t.Error("RCDs are not equal after a JSON round trip")
+	}
+}
@@ -185,16 +185,24 @@ func (t *Transaction) SetTimestamp(ts interfaces.Timestamp) {
 	t.MilliTimestamp = milli
 }
 
+// newSignatureBlock returns an empty signature block of the kind the i'th RCD needs
+func (t Transaction) newSignatureBlock(i int) interfaces.ISignatureBlock {
+	if i < len(t.RCDs) && t.RCDs[i] != nil {
+		return NewSignatureBlockForRCD(t.RCDs[i])
+	}
+	return new(SignatureBlock)
+}
+
 func (t *Transaction) SetSignatureBlock(i int, sig interfaces.ISignatureBlock) {
 	for len(t.SigBlocks) <= i {
-		t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+		t.SigBlocks = append(t.SigBlocks, t.newSignatureBlock(len(t.SigBlocks)))
 	}
 	t.SigBlocks[i] = sig
 }
 
 func (t *Transaction) GetSignatureBlock(i int) interfaces.ISignatureBlock {
 	for len(t.SigBlocks) <= i {
-		t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+		t.SigBlocks = append(t.SigBlocks, t.newSignatureBlock(len(t.SigBlocks)))
 	}
 	return t.SigBlocks[i]
 }
@@ -412,7 +420,7 @@ func (t *Transaction) GetSignatureBlocks() []interfaces.ISignatureBlock {
 		return t.SigBlocks
 	}
 	for i := len(t.SigBlocks); i < len(t.Inputs); i++ { // If too short, then
-		t.SigBlocks = append(t.SigBlocks, new(SignatureBlock)) // pad it with
+		t.SigBlocks = append(t.SigBlocks, t.newSignatureBlock(i)) // pad it with
 	} // signature blocks.
 	return t.SigBlocks
 }
@@ -523,7 +531,7 @@ func (t *Transaction) UnmarshalBinaryData(data []byte) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		t.SigBlocks[i] = new(SignatureBlock)
+		t.SigBlocks[i] = NewSignatureBlockForRCD(t.RCDs[i])
 		err = buf.PopBinaryMarshallable(t.SigBlocks[i])
 		if err != nil {
 			return nil, err
@@ -623,7 +631,7 @@ func (t Transaction) MarshalBinary() ([]byte, error) {
 		// we don't want to restrict what might be required to
 		// sign an input.
 		if len(t.SigBlocks) <= i {
-			t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+			t.SigBlocks = append(t.SigBlocks, t.newSignatureBlock(i))
 		}
 		err = buf.PushBinaryMarshallable(t.SigBlocks[i])
 		if err != nil {
@@ -719,7 +727,7 @@ func (t *Transaction) CustomMarshalText() (text []byte, err error) {
 		out.Write(text)
 
 		for len(t.SigBlocks) <= i {
-			t.SigBlocks = append(t.SigBlocks, new(SignatureBlock))
+			t.SigBlocks = append(t.SigBlocks, t.newSignatureBlock(len(t.SigBlocks)))
 		}
 		text, err := t.SigBlocks[i].CustomMarshalText()
 		if err != nil {
@@ -23,6 +23,8 @@ type FactomParams struct {
 	DropRate                 int
 	Journal                  string
 	Journaling               bool
+	JournalDebug             bool
+	JournalExpect            string
 	Follower                 bool
 	Leader                   bool
 	Db                       string
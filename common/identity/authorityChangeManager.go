@@ -0,0 +1,407 @@
+package identity
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// AuthorityChangeProposal is a scheduled authority set change, and the votes it has
+type AuthorityChangeProposal struct {
+	TargetChainID    interfaces.IHash   `json:"targetchainid"`
+	Change           byte               `json:"change"`
+	ActivationHeight uint32             `json:"activationheight"`
+	Votes            []interfaces.IHash `json:"votes"`    // Root chain ids of the voters
+	Majority         int                `json:"majority"` // Votes of current federated servers needed
+	Approved         bool               `json:"approved"`
+}
+
+// AuthorityChangeManager handles keeping track of authority change votes in identity
+// chains. Like the CoinbaseCancelManager it keeps the tallies, but the admin block entry
+// is only made at the activation height of the change.
+type AuthorityChangeManager struct {
+	// Heights of the proposals. This list will be sorted, and is used for garbage
+	// collecting proposals once their height has passed
+	ProposalsList []uint32
+
+	// Proposals is all votes for a given activation height
+	//		[activationheight][change key][id chain]Authority change identity entry
+	Proposals map[uint32]map[[32]byte]map[[32]byte]identityEntries.NewAuthorityChangeStruct
+
+	// Boolean indicator if it's been recorded to the admin block. We do not do this more than once
+	//		[activationheight][change key]
+	AdminBlockRecord map[uint32]map[[32]byte]bool
+
+	// Need a reference to the authority set
+	im *IdentityManager
+
+	// The API reads the proposals while blocks are processed
+	mutex sync.Mutex
+}
+
+func NewAuthorityChangeManager(im *IdentityManager) *AuthorityChangeManager {
+	c := new(AuthorityChangeManager)
+	c.Proposals = make(map[uint32]map[[32]byte]map[[32]byte]identityEntries.NewAuthorityChangeStruct)
+	c.AdminBlockRecord = make(map[uint32]map[[32]byte]bool)
+	c.im = im
+
+	return c
+}
+
+// AuthorityChangeKey identifies the change a vote is for at its activation height
+func AuthorityChangeKey(target interfaces.IHash, change byte) [32]byte {
+	return primitives.Sha(append(target.Bytes(), change)).Fixed()
+}
+
+// GC is garbage collecting proposals whose height is at or below dbheight, the current height
+func (c *AuthorityChangeManager) GC(dbheight uint32) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	count := 0
+	for _, h := range c.ProposalsList {
+		// The list is sorted, so the rest are still pending
+		if h > dbheight {
+			break
+		}
+		delete(c.Proposals, h)
+		delete(c.AdminBlockRecord, h)
+		count++
+	}
+	// Remove deleted items from sorted list
+	c.ProposalsList = append([]uint32{}, c.ProposalsList[count:]...)
+}
+
+// AddVote will add a vote to the tallies. It assumes the height check has already been done
+func (c *AuthorityChangeManager) AddVote(ac identityEntries.NewAuthorityChangeStruct) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.Proposals[ac.ActivationHeight]; !ok {
+		c.addNewProposalHeight(ac.ActivationHeight)
+		c.Proposals[ac.ActivationHeight] = make(map[[32]byte]map[[32]byte]identityEntries.NewAuthorityChangeStruct)
+		c.AdminBlockRecord[ac.ActivationHeight] = make(map[[32]byte]bool)
+	}
+
+	key := AuthorityChangeKey(ac.TargetChainID, ac.Change)
+	if _, ok := c.Proposals[ac.ActivationHeight][key]; !ok {
+		c.Proposals[ac.ActivationHeight][key] = make(map[[32]byte]identityEntries.NewAuthorityChangeStruct)
+	}
+
+	c.Proposals[ac.ActivationHeight][key][ac.RootIdentityChainID.Fixed()] = ac
+}
+
+// Majority is the number of votes from current federated servers a change needs
+func (c *AuthorityChangeManager) Majority() int {
+	return (c.im.FedServerCount() / 2) + 1
+}
+
+// ApprovedChanges returns the changes to make at the activation height that have a majority
+// of the current federated servers, and are not already recorded in the admin block.
+// They are sorted by target chain so every leader builds the same admin block.
+func (c *AuthorityChangeManager) ApprovedChanges(activationHeight uint32) []identityEntries.NewAuthorityChangeStruct {
+	maj := c.Majority()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var approved []identityEntries.NewAuthorityChangeStruct
+	for key, votes := range c.Proposals[activationHeight] {
+		if c.AdminBlockRecord[activationHeight][key] {
+			continue
+		}
+		if c.fedVotes(votes) >= maj {
+			for _, v := range votes {
+				approved = append(approved, v)
+				break
+			}
+		}
+	}
+	sort.Slice(approved, func(i, j int) bool {
+		if cmp := bytes.Compare(approved[i].TargetChainID.Bytes(), approved[j].TargetChainID.Bytes()); cmp != 0 {
+			return cmp < 0
+		}
+		return approved[i].Change < approved[j].Change
+	})
+	return approved
+}
+
+// fedVotes counts the votes made by current federated servers
+func (c *AuthorityChangeManager) fedVotes(votes map[[32]byte]identityEntries.NewAuthorityChangeStruct) int {
+	count := 0
+	for _, v := range votes {
+		if auth := c.im.GetAuthority(v.RootIdentityChainID); auth != nil && auth.Status == constants.IDENTITY_FEDERATED_SERVER {
+			count++
+		}
+	}
+	return count
+}
+
+// MarkAdminBlockRecorded will mark a change as recorded in the admin block, so it is not
+// recorded again
+func (c *AuthorityChangeManager) MarkAdminBlockRecorded(activationHeight uint32, target interfaces.IHash, change byte) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, ok := c.AdminBlockRecord[activationHeight]; !ok {
+		c.addNewProposalHeight(activationHeight)
+		c.AdminBlockRecord[activationHeight] = make(map[[32]byte]bool)
+		c.Proposals[activationHeight] = make(map[[32]byte]map[[32]byte]identityEntries.NewAuthorityChangeStruct)
+	}
+
+	c.AdminBlockRecord[activationHeight][AuthorityChangeKey(target, change)] = true
+}
+
+// PendingProposals returns every proposal that has not been garbage collected, in height order
+func (c *AuthorityChangeManager) PendingProposals() []*AuthorityChangeProposal {
+	maj := c.Majority()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var list []*AuthorityChangeProposal
+	for _, h := range c.ProposalsList {
+		var atHeight []*AuthorityChangeProposal
+		for _, votes := range c.Proposals[h] {
+			p := new(AuthorityChangeProposal)
+			p.ActivationHeight = h
+			p.Majority = maj
+			for _, v := range votes {
+				p.TargetChainID = v.TargetChainID
+				p.Change = v.Change
+				p.Votes = append(p.Votes, v.RootIdentityChainID)
+			}
+			sort.Slice(p.Votes, func(i, j int) bool {
+				return bytes.Compare(p.Votes[i].Bytes(), p.Votes[j].Bytes()) < 0
+			})
+			p.Approved = c.fedVotes(votes) >= maj
+			atHeight = append(atHeight, p)
+		}
+		sort.Slice(atHeight, func(i, j int) bool {
+			if cmp := bytes.Compare(atHeight[i].TargetChainID.Bytes(), atHeight[j].TargetChainID.Bytes()); cmp != 0 {
+				return cmp < 0
+			}
+			return atHeight[i].Change < atHeight[j].Change
+		})
+		list = append(list, atHeight...)
+	}
+	return list
+}
+
+// addNewProposalHeight does a insert into the sorted list.  The mutex must be held.
+func (c *AuthorityChangeManager) addNewProposalHeight(activationHeight uint32) {
+	i := sort.Search(len(c.ProposalsList), func(i int) bool { return c.ProposalsList[i] >= activationHeight })
+	if i < len(c.ProposalsList) && c.ProposalsList[i] == activationHeight {
+		return
+	}
+	c.ProposalsList = append(c.ProposalsList, 0)
+	copy(c.ProposalsList[i+1:], c.ProposalsList[i:])
+	c.ProposalsList[i] = activationHeight
+}
+
+// Clone copies the tallies for the identity manager im, which the copy counts authorities in
+func (c *AuthorityChangeManager) Clone(im *IdentityManager) *AuthorityChangeManager {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	b := NewAuthorityChangeManager(im)
+	b.ProposalsList = append([]uint32{}, c.ProposalsList...)
+	for h, keys := range c.Proposals {
+		b.Proposals[h] = make(map[[32]byte]map[[32]byte]identityEntries.NewAuthorityChangeStruct, len(keys))
+		for key, votes := range keys {
+			b.Proposals[h][key] = make(map[[32]byte]identityEntries.NewAuthorityChangeStruct, len(votes))
+			for voter, v := range votes {
+				b.Proposals[h][key][voter] = v
+			}
+		}
+	}
+	for h, records := range c.AdminBlockRecord {
+		b.AdminBlockRecord[h] = make(map[[32]byte]bool, len(records))
+		for key, r := range records {
+			b.AdminBlockRecord[h][key] = r
+		}
+	}
+	return b
+}
+
+// IsSameAs compares the tallies of two managers
+func (c *AuthorityChangeManager) IsSameAs(b *AuthorityChangeManager) bool {
+	x, err := c.MarshalBinary()
+	if err != nil {
+		return false
+	}
+	y, err := b.MarshalBinary()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(x, y)
+}
+
+// MarshalBinary saves the tallies, so the votes cast before a restart are still counted.
+// For each proposal height it writes the changes voted for, in key order, whether each is
+// recorded in the admin block, and the external IDs of its votes in voter order.
+func (c *AuthorityChangeManager) MarshalBinary() ([]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	buf := primitives.NewBuffer(nil)
+
+	err := buf.PushInt(len(c.ProposalsList))
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range c.ProposalsList {
+		err = buf.PushUInt32(h)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make(map[[32]byte]bool)
+		for key := range c.Proposals[h] {
+			keys[key] = true
+		}
+		for key := range c.AdminBlockRecord[h] {
+			keys[key] = true
+		}
+		err = buf.PushInt(len(keys))
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range sortedKeys(keys) {
+			err = buf.PushBytes(key[:])
+			if err != nil {
+				return nil, err
+			}
+			err = buf.PushBool(c.AdminBlockRecord[h][key])
+			if err != nil {
+				return nil, err
+			}
+
+			votes := c.Proposals[h][key]
+			voters := make(map[[32]byte]bool, len(votes))
+			for voter := range votes {
+				voters[voter] = true
+			}
+			err = buf.PushInt(len(votes))
+			if err != nil {
+				return nil, err
+			}
+			for _, voter := range sortedKeys(voters) {
+				v := votes[voter]
+				extIDs := v.ToExternalIDs()
+				err = buf.PushInt(len(extIDs))
+				if err != nil {
+					return nil, err
+				}
+				for _, ext := range extIDs {
+					err = buf.PushBytes(ext)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+	return buf.DeepCopyBytes(), nil
+}
+
+func (c *AuthorityChangeManager) UnmarshalBinary(p []byte) error {
+	_, err := c.UnmarshalBinaryData(p)
+	return err
+}
+
+func (c *AuthorityChangeManager) UnmarshalBinaryData(p []byte) (newData []byte, err error) {
+	buf := primitives.NewBuffer(p)
+	newData = p
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.ProposalsList = nil
+	c.Proposals = make(map[uint32]map[[32]byte]map[[32]byte]identityEntries.NewAuthorityChangeStruct)
+	c.AdminBlockRecord = make(map[uint32]map[[32]byte]bool)
+
+	hl, err := buf.PopInt()
+	if err != nil {
+		return
+	}
+	for i := 0; i < hl; i++ {
+		var h uint32
+		h, err = buf.PopUInt32()
+		if err != nil {
+			return
+		}
+		c.addNewProposalHeight(h)
+		c.Proposals[h] = make(map[[32]byte]map[[32]byte]identityEntries.NewAuthorityChangeStruct)
+		c.AdminBlockRecord[h] = make(map[[32]byte]bool)
+
+		var kl int
+		kl, err = buf.PopInt()
+		if err != nil {
+			return
+		}
+		for j := 0; j < kl; j++ {
+			var k []byte
+			k, err = buf.PopBytes()
+			if err != nil {
+				return
+			}
+			if len(k) != 32 {
+				err = fmt.Errorf("Bad authority change key length %d", len(k))
+				return
+			}
+			var key [32]byte
+			copy(key[:], k)
+
+			var recorded bool
+			recorded, err = buf.PopBool()
+			if err != nil {
+				return
+			}
+			if recorded {
+				c.AdminBlockRecord[h][key] = true
+			}
+
+			var vl int
+			vl, err = buf.PopInt()
+			if err != nil {
+				return
+			}
+			votes := make(map[[32]byte]identityEntries.NewAuthorityChangeStruct, vl)
+			for n := 0; n < vl; n++ {
+				var el int
+				el, err = buf.PopInt()
+				if err != nil {
+					return
+				}
+				extIDs := make([][]byte, el)
+				for e := range extIDs {
+					extIDs[e], err = buf.PopBytes()
+					if err != nil {
+						return
+					}
+				}
+				var v *identityEntries.NewAuthorityChangeStruct
+				v, err = identityEntries.DecodeNewAuthorityChangeStructFromExtIDs(extIDs)
+				if err != nil {
+					return
+				}
+				votes[v.RootIdentityChainID.Fixed()] = *v
+			}
+			if len(votes) > 0 {
+				c.Proposals[h][key] = votes
+			}
+		}
+	}
+
+	newData = buf.DeepCopyBytes()
+	return
+}
+
+// sortedKeys returns the keys of a set in byte order
+func sortedKeys(set map[[32]byte]bool) [][32]byte {
+	keys := make([][32]byte, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+	return keys
+}
@@ -0,0 +1,168 @@
+package identity_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestAuthorityChangeTally(t *testing.T) {
+	im := RandomIdentityManagerWithCounts(5, 3)
+	c := NewAuthorityChangeManager(im)
+
+	var feds, auds []*Authority
+	for _, a := range im.Authorities {
+		if a.Status == constants.IDENTITY_FEDERATED_SERVER {
+			feds = append(feds, a)
+		} else {
+			auds = append(auds, a)
+		}
+	}
+	if c.Majority() != 3 {
+		t.Fatalf("Exp a majority of 3, got %d", c.Majority())
+	}
+
+	target := primitives.RandomHash()
+	h := uint32(20)
+
+	// Audit servers do not count towards the majority
+	for _, a := range auds {
+		c.AddVote(newAuthorityChange(a, target, identityEntries.AuthorityChangeAddFederated, h))
+	}
+	for _, a := range feds[:2] {
+		c.AddVote(newAuthorityChange(a, target, identityEntries.AuthorityChangeAddFederated, h))
+		// Duplicate votes count once
+		c.AddVote(newAuthorityChange(a, target, identityEntries.AuthorityChangeAddFederated, h))
+	}
+	if len(c.ApprovedChanges(h)) != 0 {
+		t.Errorf("Exp no approved changes without a majority")
+	}
+
+	// A vote for another change or height is not a vote for this one
+	c.AddVote(newAuthorityChange(feds[2], target, identityEntries.AuthorityChangeAddAudit, h))
+	c.AddVote(newAuthorityChange(feds[2], target, identityEntries.AuthorityChangeAddFederated, h+1))
+	if len(c.ApprovedChanges(h)) != 0 {
+		t.Errorf("Exp no approved changes without a majority")
+	}
+
+	c.AddVote(newAuthorityChange(feds[3], target, identityEntries.AuthorityChangeAddFederated, h))
+	approved := c.ApprovedChanges(h)
+	if len(approved) != 1 {
+		t.Fatalf("Exp 1 approved change, got %d", len(approved))
+	}
+	if !approved[0].TargetChainID.IsSameAs(target) || approved[0].Change != identityEntries.AuthorityChangeAddFederated {
+		t.Errorf("Wrong change approved %x %d", approved[0].TargetChainID.Bytes(), approved[0].Change)
+	}
+	if len(c.ApprovedChanges(h+1)) != 0 {
+		t.Errorf("Exp no approved changes at %d", h+1)
+	}
+
+	proposals := c.PendingProposals()
+	if len(proposals) != 3 {
+		t.Fatalf("Exp 3 proposals, got %d", len(proposals))
+	}
+	for i, exp := range []struct {
+		height   uint32
+		change   byte
+		votes    int
+		approved bool
+	}{
+		{h, identityEntries.AuthorityChangeAddFederated, len(auds) + 3, true},
+		{h, identityEntries.AuthorityChangeAddAudit, 1, false},
+		{h + 1, identityEntries.AuthorityChangeAddFederated, 1, false},
+	} {
+		p := proposals[i]
+		if p.ActivationHeight != exp.height || p.Change != exp.change || len(p.Votes) != exp.votes || p.Approved != exp.approved {
+			t.Errorf("Proposal %d exp %v, got %d %d %d %v", i, exp, p.ActivationHeight, p.Change, len(p.Votes), p.Approved)
+		}
+	}
+
+	// Once recorded it is not approved again
+	c.MarkAdminBlockRecorded(h, target, identityEntries.AuthorityChangeAddFederated)
+	if len(c.ApprovedChanges(h)) != 0 {
+		t.Errorf("Exp no approved changes once recorded")
+	}
+
+	c.GC(h)
+	proposals = c.PendingProposals()
+	if len(proposals) != 1 || proposals[0].ActivationHeight != h+1 {
+		t.Errorf("Exp only the proposal at %d after GC, got %d", h+1, len(proposals))
+	}
+}
+
+func TestAuthorityChangeApprovedOrder(t *testing.T) {
+	im := RandomIdentityManagerWithCounts(1, 0)
+	c := NewAuthorityChangeManager(im)
+
+	var fed *Authority
+	for _, a := range im.Authorities {
+		fed = a
+	}
+
+	h := uint32(5)
+	for i := 0; i < 10; i++ {
+		c.AddVote(newAuthorityChange(fed, primitives.RandomHash(), identityEntries.AuthorityChangeRemove, h))
+	}
+	approved := c.ApprovedChanges(h)
+	if len(approved) != 10 {
+		t.Fatalf("Exp 10 approved changes, got %d", len(approved))
+	}
+	for i := 1; i < len(approved); i++ {
+		if approved[i-1].TargetChainID.String() >= approved[i].TargetChainID.String() {
+			t.Errorf("Approved changes are not sorted by target")
+		}
+	}
+}
+
+func newAuthorityChange(id *Authority, target interfaces.IHash, change byte, h uint32) identityEntries.NewAuthorityChangeStruct {
+	ac := new(identityEntries.NewAuthorityChangeStruct)
+	ac.RootIdentityChainID = id.AuthorityChainID
+	ac.TargetChainID = target
+	ac.Change = change
+	ac.ActivationHeight = h
+	return *ac
+}
+
+func TestAuthorityChangeMarshal(t *testing.T) {
+	im := RandomIdentityManagerWithCounts(3, 0)
+	c := NewAuthorityChangeManager(im)
+
+	target := primitives.RandomHash()
+	for _, a := range im.Authorities {
+		c.AddVote(*identityEntries.NewSignedAuthorityChange(a.AuthorityChainID, target, identityEntries.AuthorityChangeAddAudit, 30, primitives.RandomPrivateKey()))
+	}
+	c.AddVote(*identityEntries.NewSignedAuthorityChange(primitives.RandomHash(), target, identityEntries.AuthorityChangeRemove, 40, primitives.RandomPrivateKey()))
+	c.MarkAdminBlockRecorded(20, primitives.RandomHash(), identityEntries.AuthorityChangeRemove)
+
+	data, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2 := NewAuthorityChangeManager(im)
+	rest, err := c2.UnmarshalBinaryData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 0 {
+		t.Errorf("Exp all the data to be read, %d bytes left", len(rest))
+	}
+	if !c.IsSameAs(c2) {
+		t.Errorf("Tallies differ after unmarshalling")
+	}
+	if len(c2.ApprovedChanges(30)) != 1 || len(c2.PendingProposals()) != 2 {
+		t.Errorf("Exp 1 approved change and 2 proposals, got %d and %d", len(c2.ApprovedChanges(30)), len(c2.PendingProposals()))
+	}
+
+	clone := c.Clone(im)
+	if !c.IsSameAs(clone) {
+		t.Errorf("Clone differs")
+	}
+	clone.GC(30)
+	if c.IsSameAs(clone) {
+		t.Errorf("Exp the clone to be a copy")
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package identity
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// AuthorityChange is an admin block entry that changed an authority, and the
+// directory block it is in
+type AuthorityChange struct {
+	DBHeight        uint32              `json:"dbheight"`
+	IdentityChainID interfaces.IHash    `json:"identitychainid"`
+	Entry           interfaces.IABEntry `json:"entry"`
+}
+
+// AuthorityAt is an authority as it was at a directory block height, with every
+// change made to it up to that height
+type AuthorityAt struct {
+	Authority *Authority        `json:"authority"`
+	History   []AuthorityChange `json:"history"`
+}
+
+// NewAuthorityChange returns the change an admin block entry makes to an authority,
+// or nil if the entry does not change any authority
+func NewAuthorityChange(dbheight uint32, entry interfaces.IABEntry) *AuthorityChange {
+	var id interfaces.IHash
+	switch e := entry.(type) {
+	case *adminBlock.AddFederatedServer:
+		id = e.IdentityChainID
+	case *adminBlock.AddAuditServer:
+		id = e.IdentityChainID
+	case *adminBlock.RemoveFederatedServer:
+		id = e.IdentityChainID
+	case *adminBlock.AddFederatedServerSigningKey:
+		id = e.IdentityChainID
+	case *adminBlock.AddFederatedServerBitcoinAnchorKey:
+		id = e.IdentityChainID
+	case *adminBlock.AddReplaceMatryoshkaHash:
+		id = e.IdentityChainID
+	case *adminBlock.AddEfficiency:
+		id = e.IdentityChainID
+	case *adminBlock.AddFactoidAddress:
+		id = e.IdentityChainID
+	default:
+		return nil
+	}
+	return &AuthorityChange{DBHeight: dbheight, IdentityChainID: id, Entry: entry}
+}
+
+// AuthoritySetAt replays the changes, which must be in height order, to find the
+// authorities as they were at the end of the directory block at dbheight.  The
+// network starts with the bootstrap identity as its only federated server, the same
+// as the identity manager does.
+func AuthoritySetAt(changes []AuthorityChange, dbheight uint32, bootstrapID interfaces.IHash, bootstrapKey interfaces.IHash) []*AuthorityAt {
+	set := make(map[[32]byte]*AuthorityAt)
+	history := make(map[[32]byte][]AuthorityChange)
+
+	boot := NewAuthority()
+	boot.AuthorityChainID = bootstrapID
+	boot.SigningKey = bootstrapKey.Fixed()
+	boot.Status = constants.IDENTITY_FEDERATED_SERVER
+	set[bootstrapID.Fixed()] = &AuthorityAt{Authority: boot}
+
+	get := func(chainID interfaces.IHash) *Authority {
+		if a := set[chainID.Fixed()]; a != nil {
+			return a.Authority
+		}
+		return nil
+	}
+
+	for _, c := range changes {
+		if c.DBHeight > dbheight {
+			break
+		}
+		chainID := c.IdentityChainID
+		// The federated server added at height 1 is the bootstrap identity
+		if f, ok := c.Entry.(*adminBlock.AddFederatedServer); ok && f.DBHeight == 1 {
+			chainID = bootstrapID
+		}
+		history[chainID.Fixed()] = append(history[chainID.Fixed()], c)
+
+		switch e := c.Entry.(type) {
+		case *adminBlock.AddFederatedServer, *adminBlock.AddAuditServer:
+			auth := get(chainID)
+			if auth == nil {
+				auth = NewAuthority()
+				auth.AuthorityChainID = chainID
+				set[chainID.Fixed()] = &AuthorityAt{Authority: auth}
+			}
+			auth.Status = constants.IDENTITY_FEDERATED_SERVER
+			if _, ok := e.(*adminBlock.AddAuditServer); ok {
+				auth.Status = constants.IDENTITY_AUDIT_SERVER
+			}
+		case *adminBlock.RemoveFederatedServer:
+			delete(set, chainID.Fixed())
+		case *adminBlock.AddFederatedServerSigningKey:
+			if auth := get(chainID); auth != nil {
+				auth.SigningKey = e.PublicKey
+			}
+		case *adminBlock.AddFederatedServerBitcoinAnchorKey:
+			if auth := get(chainID); auth != nil {
+				setAnchorKey(auth, e)
+			}
+		case *adminBlock.AddReplaceMatryoshkaHash:
+			if auth := get(chainID); auth != nil {
+				auth.MatryoshkaHash = e.MHash
+			}
+		case *adminBlock.AddEfficiency:
+			if auth := get(chainID); auth != nil {
+				auth.Efficiency = e.Efficiency
+			}
+		case *adminBlock.AddFactoidAddress:
+			if auth := get(chainID); auth != nil {
+				auth.CoinbaseAddress = e.FactoidAddress
+			}
+		}
+	}
+
+	var list []*AuthorityAt
+	for k, a := range set {
+		a.History = history[k]
+		list = append(list, a)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i].Authority.AuthorityChainID.Bytes(), list[j].Authority.AuthorityChainID.Bytes()) < 0
+	})
+	return list
+}
+
+// setAnchorKey replaces the key of the same level and type, as ApplyAddFederatedServerBitcoinAnchorKey does
+func setAnchorKey(auth *Authority, e *adminBlock.AddFederatedServerBitcoinAnchorKey) {
+	var ask AnchorSigningKey
+	ask.SigningKey = e.ECDSAPublicKey
+	ask.KeyLevel = e.KeyPriority
+	ask.KeyType = e.KeyType
+	ask.BlockChain = "BTC"
+
+	for i, a := range auth.AnchorKeys {
+		if a.KeyLevel == ask.KeyLevel && a.KeyType == ask.KeyType {
+			auth.AnchorKeys[i] = ask
+			return
+		}
+	}
+	auth.AnchorKeys = append(auth.AnchorKeys, ask)
+}
@@ -0,0 +1,83 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package identity_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+func TestAuthoritySetAt(t *testing.T) {
+	boot := primitives.RandomHash()
+	bootKey := primitives.RandomHash()
+	a := primitives.RandomHash()
+	b := primitives.RandomHash()
+
+	var key1, key2 primitives.PublicKey
+	copy(key1[:], primitives.RandomHash().Bytes())
+	copy(key2[:], primitives.RandomHash().Bytes())
+
+	var changes []AuthorityChange
+	add := func(height uint32, e interfaces.IABEntry) {
+		c := NewAuthorityChange(height, e)
+		if c == nil {
+			t.Fatalf("%T should be an authority change", e)
+		}
+		changes = append(changes, *c)
+	}
+	add(10, adminBlock.NewAddFederatedServer(a, 11))
+	add(10, adminBlock.NewAddFederatedServerSigningKey(a, 0, key1, 11))
+	add(20, adminBlock.NewAddAuditServer(b, 21))
+	add(20, adminBlock.NewAddFederatedServerSigningKey(a, 0, key2, 21))
+	add(30, adminBlock.NewAddEfficiency(a, 5000))
+	add(40, adminBlock.NewRemoveFederatedServer(b, 41))
+
+	if NewAuthorityChange(10, adminBlock.NewIncreaseSererCount(1)) != nil {
+		t.Error("Increasing the server count does not change an authority")
+	}
+
+	find := func(set []*AuthorityAt, chainID interfaces.IHash) *AuthorityAt {
+		for _, s := range set {
+			if s.Authority.AuthorityChainID.IsSameAs(chainID) {
+				return s
+			}
+		}
+		return nil
+	}
+
+	set := AuthoritySetAt(changes, 5, boot, bootKey)
+	if len(set) != 1 || find(set, boot) == nil {
+		t.Fatalf("Only the bootstrap identity should be an authority at 5, found %d", len(set))
+	}
+
+	set = AuthoritySetAt(changes, 20, boot, bootKey)
+	if len(set) != 3 {
+		t.Fatalf("Expected 3 authorities at 20, found %d", len(set))
+	}
+	if auth := find(set, a); auth.Authority.SigningKey != key2 || len(auth.History) != 3 {
+		t.Errorf("Authority should have the second key and 3 changes, has %d changes", len(auth.History))
+	}
+	if auth := find(set, b); auth.Authority.Status != constants.IDENTITY_AUDIT_SERVER {
+		t.Errorf("Expected an audit server, found status %d", auth.Authority.Status)
+	}
+
+	set = AuthoritySetAt(changes, 15, boot, bootKey)
+	if auth := find(set, a); auth == nil || auth.Authority.SigningKey != key1 || auth.Authority.Efficiency != 10000 {
+		t.Error("Authority should have the first key at 15")
+	}
+
+	set = AuthoritySetAt(changes, 40, boot, bootKey)
+	if find(set, b) != nil {
+		t.Error("Removed server should not be in the set")
+	}
+	if auth := find(set, a); auth.Authority.Efficiency != 5000 {
+		t.Errorf("Efficiency should be 5000, found %d", auth.Authority.Efficiency)
+	}
+}
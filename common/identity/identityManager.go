@@ -39,6 +39,9 @@ type IdentityManagerWithoutMutex struct {
 	// Not Marshalled
 	// Tracks cancellation of coinbases
 	CancelManager *CoinbaseCancelManager
+	// Tracks votes for scheduled authority set changes.  Saved with the fastboot state
+	// on its own, after the identity manager.
+	ChangeManager *AuthorityChangeManager
 
 	// Map of all coinbase outputs that are cancelled.
 	//	The map key is the block height of the DESCRIPTOR
@@ -81,6 +84,7 @@ func NewIdentityManager() *IdentityManager {
 	im.Identities = make(map[[32]byte]*Identity)
 	im.IdentityRegistrations = make(map[[32]byte]*identityEntries.RegisterFactomIdentityStructure)
 	im.CancelManager = NewCoinbaseCancelManager(im)
+	im.ChangeManager = NewAuthorityChangeManager(im)
 	im.CanceledCoinbaseOutputs = make(map[uint32][]uint32)
 	if im == nil {
 		atomic.WhereAmIMsg("no identity manager")
@@ -625,6 +629,9 @@ func (im *IdentityManager) Clone() *IdentityManager {
 	}
 
 	b.MaxAuthorityServerCount = im.MaxAuthorityServerCount
+	if im.ChangeManager != nil {
+		b.ChangeManager = im.ChangeManager.Clone(b)
+	}
 	b.OldEntries = make([]*OldEntry, len(im.OldEntries))
 	for k, v := range im.OldEntries {
 		copy := *v
@@ -19,19 +19,33 @@ func (im *IdentityManager) ProcessIdentityEntry(entry interfaces.IEBEntry, dBloc
 	return im.ProcessIdentityEntryWithABlockUpdate(entry, dBlockHeight, dBlockTimestamp, nil, newEntry)
 }
 
+// CheckIdentityEntry processes an entry against a copy of the identities, to see if
+// it would be accepted without changing anything.
+//
+//	Returns
+//		change				bool		If the entry would change the identity
+//		err					error		Why the entry would be rejected
+func (im *IdentityManager) CheckIdentityEntry(entry interfaces.IEBEntry, dBlockHeight uint32, dBlockTimestamp interfaces.Timestamp) (bool, error) {
+	im.Mutex.RLock()
+	c := im.Clone()
+	im.Mutex.RUnlock()
+	return c.ProcessIdentityEntry(entry, dBlockHeight, dBlockTimestamp, false)
+}
+
 // ProcessIdentityEntryWithABlockUpdate will process an entry and update an entry. It will also update the admin block
 // with any changes.
 // There are some special parameters:
-//		Params:
-//			entry
-//			dBlockHeight
-//			dBlockTimestamp
-//			d					DBState		If not nil, it means to update the admin block with changes
-//			newEntry			bool		Setting this to true means it can be put into the oldEntries queue to be reprocesses (helps for out of order entries)
 //
-//		Returns
-//			change				bool		If a key has been changed
-//			err					error
+//	Params:
+//		entry
+//		dBlockHeight
+//		dBlockTimestamp
+//		d					DBState		If not nil, it means to update the admin block with changes
+//		newEntry			bool		Setting this to true means it can be put into the oldEntries queue to be reprocesses (helps for out of order entries)
+//
+//	Returns
+//		change				bool		If a key has been changed
+//		err					error
 func (im *IdentityManager) ProcessIdentityEntryWithABlockUpdate(entry interfaces.IEBEntry, dBlockHeight uint32, dBlockTimestamp interfaces.Timestamp, a interfaces.IAdminBlock, newEntry bool) (bool, error) {
 	if entry == nil {
 		return false, fmt.Errorf("Entry is nil")
@@ -215,6 +229,20 @@ func (im *IdentityManager) ProcessIdentityEntryWithABlockUpdate(entry interfaces
 		if err != nil {
 			return false, err
 		}
+	case "Authority Change":
+		ac, err := DecodeNewAuthorityChangeStructFromExtIDs(extIDs)
+		if err != nil {
+			return false, err
+		}
+		change, tryAgain, err = im.ApplyNewAuthorityChangeStruct(ac, chainID, dBlockHeight)
+		if tryAgain == true && newEntry == true {
+			//if it's a new entry, push it and return nil
+			return false, im.PushEntryForLater(entry, dBlockHeight, dBlockTimestamp)
+		}
+		//if it's an old entry, return error to signify the entry has not been processed and should be kept
+		if err != nil {
+			return false, err
+		}
 	}
 
 	return change, nil
@@ -245,10 +273,11 @@ func (im *IdentityManager) ApplyIdentityChainStructure(ic *IdentityChainStructur
 	return false, nil
 }
 
-//		Returns
-//			bool	change		If a key has been changed
-//			bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
-//			error	err			Any errors
+// Returns
+//
+//	bool	change		If a key has been changed
+//	bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
+//	error	err			Any errors
 func (im *IdentityManager) ApplyNewBitcoinKeyStructure(bnk *NewBitcoinKeyStructure, subChainID interfaces.IHash, BlockChain string, dBlockTimestamp interfaces.Timestamp, a interfaces.IAdminBlock) (bool, bool, error) {
 	chainID := bnk.RootIdentityChainID
 
@@ -307,10 +336,11 @@ func (im *IdentityManager) ApplyNewBitcoinKeyStructure(bnk *NewBitcoinKeyStructu
 }
 
 // ApplyNewBlockSigningKeyStruct will parse a new block signing key and attempt to add the signing to the proper identity.
-//		Returns
-//			bool	change		If a key has been changed
-//			bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
-//			error	err			Any errors
+//
+//	Returns
+//		bool	change		If a key has been changed
+//		bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
+//		error	err			Any errors
 func (im *IdentityManager) ApplyNewBlockSigningKeyStruct(nbsk *NewBlockSigningKeyStruct, subchainID interfaces.IHash, dBlockTimestamp interfaces.Timestamp, a interfaces.IAdminBlock) (bool, bool, error) {
 	chainID := nbsk.RootIdentityChainID
 	id := im.GetIdentity(chainID)
@@ -355,10 +385,11 @@ func (im *IdentityManager) ApplyNewBlockSigningKeyStruct(nbsk *NewBlockSigningKe
 }
 
 // ApplyNewMatryoshkaHashStructure will parse a new matryoshka hash and attempt to add the signing to the proper identity.
-//		Returns
-//			bool	change		If a key has been changed
-//			bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
-//			error	err			Any errors
+//
+//	Returns
+//		bool	change		If a key has been changed
+//		bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
+//		error	err			Any errors
 func (im *IdentityManager) ApplyNewMatryoshkaHashStructure(nmh *NewMatryoshkaHashStructure, dBlockTimestamp interfaces.Timestamp, a interfaces.IAdminBlock) (bool, bool, error) {
 	id := im.GetIdentity(nmh.RootIdentityChainID)
 	if id == nil {
@@ -431,6 +462,7 @@ func (im *IdentityManager) ApplyRegisterServerManagementStructure(rsm *RegisterS
 }
 
 // ApplyServerManagementStructure is the first entry in the management chain
+//
 //	DO NOT set the management chain in the identity, as it will be set on the register
 //		"Server Management"
 func (im *IdentityManager) ApplyServerManagementStructure(sm *ServerManagementStructure, chainID interfaces.IHash, dBlockHeight uint32) (bool, error) {
@@ -449,10 +481,11 @@ func (im *IdentityManager) ApplyServerManagementStructure(sm *ServerManagementSt
 }
 
 // ApplyNewServerEfficiencyStruct will parse a new server efficiency and attempt to add the signing to the proper identity.
-//		Returns
-//			bool	change		If a key has been changed
-//			bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
-//			error	err			Any errors
+//
+//	Returns
+//		bool	change		If a key has been changed
+//		bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
+//		error	err			Any errors
 func (im *IdentityManager) ApplyNewServerEfficiencyStruct(nses *NewServerEfficiencyStruct, subchainID interfaces.IHash, dBlockTimestamp interfaces.Timestamp, a interfaces.IAdminBlock) (bool, bool, error) {
 	chainID := nses.RootIdentityChainID
 	id := im.GetIdentity(chainID)
@@ -495,10 +528,11 @@ func (im *IdentityManager) ApplyNewServerEfficiencyStruct(nses *NewServerEfficie
 }
 
 // ApplyNewCoinbaseAddressStruct will parse a new coinbase address and attempt to add the signing to the proper identity.
-//		Returns
-//			bool	change		If a key has been changed
-//			bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
-//			error	err			Any errors
+//
+//	Returns
+//		bool	change		If a key has been changed
+//		bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
+//		error	err			Any errors
 func (im *IdentityManager) ApplyNewCoinbaseAddressStruct(ncas *NewCoinbaseAddressStruct, rootchainID interfaces.IHash, dBlockTimestamp interfaces.Timestamp, a interfaces.IAdminBlock) (bool, bool, error) {
 	root := ncas.RootIdentityChainID
 	id := im.GetIdentity(root)
@@ -537,13 +571,14 @@ func (im *IdentityManager) ApplyNewCoinbaseAddressStruct(ncas *NewCoinbaseAddres
 }
 
 // ApplyNewCoinbaseCancelStruct will parse a new coinbase cancel
-//		Validation Difference:
-//			Most entries check the timestamps to ensure entry is within 12 hours of dblock. That does not apply to coinbase, it can be replayed
-//			as long as it is between the block window.
-//		Returns
-//			bool	change		If a key has been changed
-//			bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
-//			error	err			Any errors
+//
+//	Validation Difference:
+//		Most entries check the timestamps to ensure entry is within 12 hours of dblock. That does not apply to coinbase, it can be replayed
+//		as long as it is between the block window.
+//	Returns
+//		bool	change		If a key has been changed
+//		bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
+//		error	err			Any errors
 func (im *IdentityManager) ApplyNewCoinbaseCancelStruct(nccs *NewCoinbaseCancelStruct, managechain interfaces.IHash, dblockHeight uint32, a interfaces.IAdminBlock) (bool, bool, error) {
 	// Validate Block window
 	//		If the descriptor to cancel has already been applied, then this entry is no longer valid
@@ -595,3 +630,39 @@ func (im *IdentityManager) ApplyNewCoinbaseCancelStruct(nccs *NewCoinbaseCancelS
 	}
 	return false, false, nil
 }
+
+// ApplyNewAuthorityChangeStruct will parse a new authority change vote
+//
+//	Validation Difference:
+//		Like coinbase cancels, the timestamp is not checked. The vote counts as long as it
+//		is in a block before the activation height. The admin block is not updated here,
+//		that happens at the activation height if the change has a majority.
+//	Returns
+//		bool	change		If a key has been changed
+//		bool	tryagain	If this is set to true, this entry can be reprocessed if it is *new*
+//		error	err			Any errors
+func (im *IdentityManager) ApplyNewAuthorityChangeStruct(nacs *NewAuthorityChangeStruct, managechain interfaces.IHash, dblockHeight uint32) (bool, bool, error) {
+	// Votes at or after the activation height are too late
+	if dblockHeight >= nacs.ActivationHeight {
+		return false, false, nil
+	}
+
+	root := nacs.RootIdentityChainID
+	id := im.GetIdentity(root)
+	if id == nil {
+		return false, true, fmt.Errorf("(authority change) ChainID doesn't exists! %v", nacs.RootIdentityChainID.String())
+	}
+
+	if !managechain.IsSameAs(id.ManagementChainID) {
+		return false, true, fmt.Errorf("(authority change) ChainID of entry should match manage chain id.")
+	}
+
+	err := nacs.VerifySignature(id.Keys[0])
+	if err != nil {
+		return false, false, err
+	}
+
+	// Add the vote to our tallies
+	im.ChangeManager.AddVote(*nacs)
+	return false, false, nil
+}
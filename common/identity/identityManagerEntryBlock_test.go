@@ -4,10 +4,88 @@
 
 package identity_test
 
-/*
 import (
 	"testing"
 
+	"github.com/FactomProject/factomd/common/entryBlock"
 	. "github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
 )
-*/
+
+func identityChainID() *primitives.Hash {
+	b := primitives.RandomHash().Bytes()
+	copy(b, []byte{0x88, 0x88, 0x88})
+	return primitives.NewHash(b).(*primitives.Hash)
+}
+
+func TestCheckIdentityEntry(t *testing.T) {
+	im := NewIdentityManager()
+	priv := primitives.RandomPrivateKey()
+
+	id := NewIdentity()
+	id.IdentityChainID = identityChainID()
+	id.ManagementChainID = identityChainID()
+	id.Keys[0] = primitives.Shad(identityEntries.IdentityKeyPreimage(priv))
+	im.SetIdentity(id.IdentityChainID, id)
+
+	ts := primitives.NewTimestampNow()
+	entry := entryBlock.NewEntry()
+	entry.ChainID = id.ManagementChainID
+	for _, ext := range identityEntries.NewSignedServerEfficiency(id.IdentityChainID, 4000, ts, priv).ToExternalIDs() {
+		entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: ext})
+	}
+
+	if _, err := im.CheckIdentityEntry(entry, 10, ts); err != nil {
+		t.Errorf("Efficiency entry should be accepted: %v", err)
+	}
+	if im.GetIdentity(id.IdentityChainID).Efficiency != 10000 {
+		t.Error("Checking an entry should not change the identity")
+	}
+
+	// Signed by a key that isn't the identity's
+	entry.ExtIDs = nil
+	for _, ext := range identityEntries.NewSignedServerEfficiency(id.IdentityChainID, 4000, ts, primitives.RandomPrivateKey()).ToExternalIDs() {
+		entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: ext})
+	}
+	if _, err := im.CheckIdentityEntry(entry, 10, ts); err == nil {
+		t.Error("Entry signed by the wrong key should be rejected")
+	}
+}
+
+func TestProcessAuthorityChangeEntry(t *testing.T) {
+	im := NewIdentityManager()
+	priv := primitives.RandomPrivateKey()
+
+	id := NewIdentity()
+	id.IdentityChainID = identityChainID()
+	id.ManagementChainID = identityChainID()
+	id.Keys[0] = primitives.Shad(identityEntries.IdentityKeyPreimage(priv))
+	im.SetIdentity(id.IdentityChainID, id)
+
+	ts := primitives.NewTimestampNow()
+	vote := func(root interfaces.IHash) *entryBlock.Entry {
+		entry := entryBlock.NewEntry()
+		entry.ChainID = id.ManagementChainID
+		for _, ext := range identityEntries.NewSignedAuthorityChange(root, identityChainID(), identityEntries.AuthorityChangeAddAudit, 30, priv).ToExternalIDs() {
+			entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: ext})
+		}
+		return entry
+	}
+
+	if _, err := im.ProcessIdentityEntry(vote(id.IdentityChainID), 10, ts, true); err != nil {
+		t.Errorf("Vote should be accepted: %v", err)
+	}
+	if len(im.ChangeManager.PendingProposals()) != 1 || len(im.OldEntries) != 0 {
+		t.Error("Vote should be tallied")
+	}
+
+	// A vote from an identity we don't know yet is kept to try again
+	if _, err := im.ProcessIdentityEntry(vote(identityChainID()), 10, ts, true); err != nil {
+		t.Errorf("Vote from an unknown identity should be pushed for later: %v", err)
+	}
+	if len(im.ChangeManager.PendingProposals()) != 1 || len(im.OldEntries) != 1 {
+		t.Error("Vote from an unknown identity should be pushed for later, not tallied")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package identityEntries
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The changes an authority change can make to the authority set
+const (
+	AuthorityChangeAddFederated byte = 0
+	AuthorityChangeAddAudit     byte = 1
+	AuthorityChangeRemove       byte = 2
+)
+
+// NewAuthorityChangeStruct is a federated server's vote for an authority set change
+// to be made at a future directory block height.  It goes in the voter's management chain.
+type NewAuthorityChangeStruct struct {
+	//The message is a Factom Entry with several extIDs holding the various parts.
+	//[0 (version)] [Authority Change] [identity ChainID] [target ChainID] [change] [activation height] [identity key preimage] [signature of version through activation height]
+
+	//The first part is a version binary string 0.
+	Version byte
+	//The second is the ASCII string "Authority Change".
+	FunctionName []byte
+	//The third is the root identity ChainID of the voter.
+	RootIdentityChainID interfaces.IHash
+	//Forth is the root identity ChainID of the server being changed
+	TargetChainID interfaces.IHash
+	//Fifth is the change, add federated, add audit or remove
+	Change byte
+	//Sixth is the directory block height the change is made at
+	ActivationHeight uint32
+	//7th is the identity key preimage.
+	PreimageIdentityKey []byte
+	//8th is the signature of the serialized version, through activation height.
+	Signature []byte
+}
+
+func DecodeNewAuthorityChangeStructFromExtIDs(extIDs [][]byte) (*NewAuthorityChangeStruct, error) {
+	nacs := new(NewAuthorityChangeStruct)
+	err := nacs.DecodeFromExtIDs(extIDs)
+	if err != nil {
+		return nil, err
+	}
+	return nacs, nil
+}
+
+func (nacs *NewAuthorityChangeStruct) SetFunctionName() {
+	nacs.FunctionName = []byte("Authority Change")
+}
+
+func (nacs *NewAuthorityChangeStruct) MarshalForSig() []byte {
+	answer := []byte{}
+
+	ht := make([]byte, 4)
+	binary.BigEndian.PutUint32(ht, nacs.ActivationHeight)
+
+	answer = append(answer, nacs.Version)
+	answer = append(answer, nacs.FunctionName...)
+	answer = append(answer, nacs.RootIdentityChainID.Bytes()...)
+	answer = append(answer, nacs.TargetChainID.Bytes()...)
+	answer = append(answer, nacs.Change)
+	answer = append(answer, ht...)
+	return answer
+}
+
+func (nacs *NewAuthorityChangeStruct) VerifySignature(key1 interfaces.IHash) error {
+	bin := nacs.MarshalForSig()
+	pk := new(primitives.PublicKey)
+	err := pk.UnmarshalBinary(nacs.PreimageIdentityKey[1:])
+	if err != nil {
+		return err
+	}
+	var sig [64]byte
+	copy(sig[:], nacs.Signature)
+	ok := pk.Verify(bin, &sig)
+	if ok == false {
+		return fmt.Errorf("Invalid signature")
+	}
+
+	if key1 == nil {
+		return nil
+	}
+	hashedKey := primitives.Shad(nacs.PreimageIdentityKey)
+	if hashedKey.IsSameAs(key1) == false {
+		return fmt.Errorf("PreimageIdentityKey does not equal Key1 - %v vs %v", hashedKey, key1)
+	}
+
+	return nil
+}
+
+func (nacs *NewAuthorityChangeStruct) DecodeFromExtIDs(extIDs [][]byte) error {
+	if len(extIDs) != 8 {
+		return fmt.Errorf("Wrong number of ExtIDs - expected 8, got %v", len(extIDs))
+	}
+	if CheckExternalIDsLength(extIDs, []int{1, 16, 32, 32, 1, 4, 33, 64}) == false {
+		return fmt.Errorf("Wrong lengths of ExtIDs")
+	}
+	nacs.Version = extIDs[0][0]
+	if nacs.Version != 0 {
+		return fmt.Errorf("Wrong Version - expected 0, got %v", nacs.Version)
+	}
+	nacs.FunctionName = extIDs[1]
+	if string(nacs.FunctionName) != "Authority Change" {
+		return fmt.Errorf("Invalid FunctionName - expected 'Authority Change', got '%s'", nacs.FunctionName)
+	}
+	h, err := primitives.NewShaHash(extIDs[2])
+	if err != nil {
+		return err
+	}
+	nacs.RootIdentityChainID = h
+
+	h, err = primitives.NewShaHash(extIDs[3])
+	if err != nil {
+		return err
+	}
+	nacs.TargetChainID = h
+
+	nacs.Change = extIDs[4][0]
+	if nacs.Change > AuthorityChangeRemove {
+		return fmt.Errorf("Invalid Change - expected 0 to %d, got %v", AuthorityChangeRemove, nacs.Change)
+	}
+	nacs.ActivationHeight = binary.BigEndian.Uint32(extIDs[5])
+
+	nacs.PreimageIdentityKey = extIDs[6]
+	nacs.Signature = extIDs[7]
+
+	err = nacs.VerifySignature(nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (nacs *NewAuthorityChangeStruct) ToExternalIDs() [][]byte {
+	extIDs := [][]byte{}
+
+	ht := make([]byte, 4)
+	binary.BigEndian.PutUint32(ht, nacs.ActivationHeight)
+
+	extIDs = append(extIDs, []byte{nacs.Version})
+	extIDs = append(extIDs, nacs.FunctionName)
+	extIDs = append(extIDs, nacs.RootIdentityChainID.Bytes())
+	extIDs = append(extIDs, nacs.TargetChainID.Bytes())
+	extIDs = append(extIDs, []byte{nacs.Change})
+	extIDs = append(extIDs, ht)
+	extIDs = append(extIDs, nacs.PreimageIdentityKey)
+	extIDs = append(extIDs, nacs.Signature)
+
+	return extIDs
+}
+
+func (nacs *NewAuthorityChangeStruct) GetChainID() (rval interfaces.IHash) {
+	defer func() {
+		if rval != nil && reflect.ValueOf(rval).IsNil() {
+			rval = nil // convert an interface that is nil to a nil interface
+			primitives.LogNilHashBug("NewAuthorityChangeStruct.GetChainID() saw an interface that was nil")
+		}
+	}()
+
+	extIDs := nacs.ToExternalIDs()
+
+	return entryBlock.ExternalIDsToChainID(extIDs)
+}
@@ -0,0 +1,126 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package identityEntries
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/btcsuitereleases/btcutil/base58"
+)
+
+// Builders for the identity entries an authority operator makes to manage their
+// identity.  Each returns the structure signed by the identity key, ready for
+// ToExternalIDs() and an entry in the right chain.
+
+// IdentityPrivateKeyToPrivateKey accepts an identity private key in human readable
+// form (sk1...) or as hex, and returns the private key
+func IdentityPrivateKeyToPrivateKey(key string) (*primitives.PrivateKey, error) {
+	key = strings.TrimSpace(key)
+	if len(key) == 64 {
+		return primitives.NewPrivateKeyFromHex(key)
+	}
+
+	data := base58.Decode(key)
+	if len(data) != 39 {
+		return nil, fmt.Errorf("Invalid identity private key length")
+	}
+	prefix := hex.EncodeToString(data[:3])
+	switch prefix {
+	case IdentityPrivateKeyPrefix1, IdentityPrivateKeyPrefix2, IdentityPrivateKeyPrefix3, IdentityPrivateKeyPrefix4:
+	default:
+		return nil, fmt.Errorf("Invalid identity private key prefix %s", prefix)
+	}
+	check := primitives.Sha(primitives.Sha(data[:35]).Bytes()).Bytes()
+	if !bytes.Equal(check[:4], data[35:]) {
+		return nil, fmt.Errorf("Invalid identity private key checksum")
+	}
+	return primitives.NewPrivateKeyFromHex(hex.EncodeToString(data[3:35]))
+}
+
+// IdentityKeyPreimage returns the preimage of the identity key, which hashes to
+// the key recorded in the identity chain
+func IdentityKeyPreimage(priv *primitives.PrivateKey) []byte {
+	return append([]byte{0x01}, priv.Public()...)
+}
+
+// TimestampBytes returns the 8 byte epoch seconds identity entries carry
+func TimestampBytes(ts interfaces.Timestamp) []byte {
+	t := make([]byte, 8)
+	binary.BigEndian.PutUint64(t, uint64(ts.GetTimeSeconds()))
+	return t
+}
+
+func NewSignedRegisterFactomIdentity(rootChainID interfaces.IHash, priv *primitives.PrivateKey) *RegisterFactomIdentityStructure {
+	rfi := new(RegisterFactomIdentityStructure)
+	rfi.FunctionName = []byte("Register Factom Identity")
+	rfi.IdentityChainID = rootChainID
+	rfi.PreimageIdentityKey = IdentityKeyPreimage(priv)
+	rfi.Signature = priv.Sign(rfi.MarshalForSig()).Bytes()
+	return rfi
+}
+
+func NewSignedBlockSigningKey(rootChainID interfaces.IHash, key []byte, ts interfaces.Timestamp, priv *primitives.PrivateKey) *NewBlockSigningKeyStruct {
+	nbsk := new(NewBlockSigningKeyStruct)
+	nbsk.FunctionName = []byte("New Block Signing Key")
+	nbsk.RootIdentityChainID = rootChainID
+	nbsk.NewPublicKey = key
+	nbsk.Timestamp = TimestampBytes(ts)
+	nbsk.PreimageIdentityKey = IdentityKeyPreimage(priv)
+	nbsk.Signature = priv.Sign(nbsk.MarshalForSig()).Bytes()
+	return nbsk
+}
+
+func NewSignedBitcoinKey(rootChainID interfaces.IHash, level byte, keyType byte, key [20]byte, ts interfaces.Timestamp, priv *primitives.PrivateKey) *NewBitcoinKeyStructure {
+	nbks := new(NewBitcoinKeyStructure)
+	nbks.FunctionName = []byte("New Bitcoin Key")
+	nbks.RootIdentityChainID = rootChainID
+	nbks.BitcoinKeyLevel = level
+	nbks.KeyType = keyType
+	nbks.NewKey = key
+	nbks.Timestamp = TimestampBytes(ts)
+	nbks.PreimageIdentityKey = IdentityKeyPreimage(priv)
+	nbks.Signature = priv.Sign(nbks.MarshalForSig()).Bytes()
+	return nbks
+}
+
+func NewSignedCoinbaseAddress(rootChainID interfaces.IHash, address interfaces.IHash, ts interfaces.Timestamp, priv *primitives.PrivateKey) *NewCoinbaseAddressStruct {
+	ncas := new(NewCoinbaseAddressStruct)
+	ncas.SetFunctionName()
+	ncas.RootIdentityChainID = rootChainID
+	ncas.CoinbaseAddress = address
+	ncas.Timestamp = TimestampBytes(ts)
+	ncas.PreimageIdentityKey = IdentityKeyPreimage(priv)
+	ncas.Signature = priv.Sign(ncas.MarshalForSig()).Bytes()
+	return ncas
+}
+
+func NewSignedServerEfficiency(rootChainID interfaces.IHash, efficiency uint16, ts interfaces.Timestamp, priv *primitives.PrivateKey) *NewServerEfficiencyStruct {
+	nses := new(NewServerEfficiencyStruct)
+	nses.SetFunctionName()
+	nses.RootIdentityChainID = rootChainID
+	nses.Efficiency = efficiency
+	nses.Timestamp = TimestampBytes(ts)
+	nses.PreimageIdentityKey = IdentityKeyPreimage(priv)
+	nses.Signature = priv.Sign(nses.MarshalForSig()).Bytes()
+	return nses
+}
+
+func NewSignedAuthorityChange(rootChainID interfaces.IHash, target interfaces.IHash, change byte, activationHeight uint32, priv *primitives.PrivateKey) *NewAuthorityChangeStruct {
+	nacs := new(NewAuthorityChangeStruct)
+	nacs.SetFunctionName()
+	nacs.RootIdentityChainID = rootChainID
+	nacs.TargetChainID = target
+	nacs.Change = change
+	nacs.ActivationHeight = activationHeight
+	nacs.PreimageIdentityKey = IdentityKeyPreimage(priv)
+	nacs.Signature = priv.Sign(nacs.MarshalForSig()).Bytes()
+	return nacs
+}
@@ -0,0 +1,114 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package identityEntries_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	. "github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/btcsuitereleases/btcutil/base58"
+)
+
+func TestIdentityPrivateKeyToPrivateKey(t *testing.T) {
+	priv := primitives.RandomPrivateKey()
+
+	k, err := IdentityPrivateKeyToPrivateKey(priv.PrivateKeyString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.PublicKeyString() != priv.PublicKeyString() {
+		t.Error("Hex key does not match")
+	}
+
+	prefix, _ := hex.DecodeString(IdentityPrivateKeyPrefix1)
+	data := append(prefix, priv.Key[:32]...)
+	check := primitives.Sha(primitives.Sha(data).Bytes()).Bytes()
+	human := base58.Encode(append(data, check[:4]...))
+
+	k, err = IdentityPrivateKeyToPrivateKey(human)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.PublicKeyString() != priv.PublicKeyString() {
+		t.Error("Human readable key does not match")
+	}
+
+	if _, err := IdentityPrivateKeyToPrivateKey(human[:len(human)-1] + "1"); err == nil {
+		t.Error("A bad checksum should not decode")
+	}
+}
+
+func TestSignedEntries(t *testing.T) {
+	priv := primitives.RandomPrivateKey()
+	key1 := primitives.Shad(IdentityKeyPreimage(priv))
+	root := primitives.RandomHash()
+	ts := primitives.NewTimestampNow()
+
+	rfi := NewSignedRegisterFactomIdentity(root, priv)
+	if _, err := DecodeRegisterFactomIdentityStructureFromExtIDs(rfi.ToExternalIDs()); err != nil {
+		t.Error(err)
+	}
+	if err := rfi.VerifySignature(key1); err != nil {
+		t.Error(err)
+	}
+
+	nbsk := NewSignedBlockSigningKey(root, primitives.RandomHash().Bytes(), ts, priv)
+	if _, err := DecodeNewBlockSigningKeyStructFromExtIDs(nbsk.ToExternalIDs()); err != nil {
+		t.Error(err)
+	}
+	if err := nbsk.VerifySignature(key1); err != nil {
+		t.Error(err)
+	}
+
+	var btc [20]byte
+	copy(btc[:], primitives.RandomHash().Bytes())
+	nbks := NewSignedBitcoinKey(root, 0, 0, btc, ts, priv)
+	if _, err := DecodeNewBitcoinKeyStructureFromExtIDs(nbks.ToExternalIDs()); err != nil {
+		t.Error(err)
+	}
+	if err := nbks.VerifySignature(key1); err != nil {
+		t.Error(err)
+	}
+
+	ncas := NewSignedCoinbaseAddress(root, primitives.RandomHash(), ts, priv)
+	if _, err := DecodeNewNewCoinbaseAddressStructFromExtIDs(ncas.ToExternalIDs()); err != nil {
+		t.Error(err)
+	}
+	if err := ncas.VerifySignature(key1); err != nil {
+		t.Error(err)
+	}
+
+	nses := NewSignedServerEfficiency(root, 4952, ts, priv)
+	decoded, err := DecodeNewServerEfficiencyStructFromExtIDs(nses.ToExternalIDs())
+	if err != nil {
+		t.Error(err)
+	} else if decoded.Efficiency != 4952 {
+		t.Errorf("Should be 4952, found %d", decoded.Efficiency)
+	}
+
+	target := primitives.RandomHash()
+	nacs := NewSignedAuthorityChange(root, target, AuthorityChangeRemove, 1000, priv)
+	change, err := DecodeNewAuthorityChangeStructFromExtIDs(nacs.ToExternalIDs())
+	if err != nil {
+		t.Error(err)
+	} else if !change.TargetChainID.IsSameAs(target) || change.Change != AuthorityChangeRemove || change.ActivationHeight != 1000 {
+		t.Errorf("Authority change did not decode, found %v", change)
+	}
+	if err := nacs.VerifySignature(key1); err != nil {
+		t.Error(err)
+	}
+	nacs.ActivationHeight++
+	if err := nacs.VerifySignature(key1); err == nil {
+		t.Error("Signature should not verify a changed height")
+	}
+
+	// Someone else's key
+	other := primitives.RandomPrivateKey()
+	if err := nses.VerifySignature(primitives.Shad(IdentityKeyPreimage(other))); err == nil {
+		t.Error("Signature should not verify against another key")
+	}
+}
@@ -17,6 +17,15 @@ type DBStateSent struct {
 	Sent     Timestamp
 }
 
+// HealthThresholds are the limits the health and readiness checks are evaluated against
+type HealthThresholds struct {
+	MaxBlockLag      int  // blocks the node may be behind the network
+	MaxEntryLag      int  // blocks the entry sync may be behind the saved blocks
+	MinPeers         int  // p2p connections needed
+	MaxMinuteSeconds int  // longest a minute may take, 0 is three times the expected minute
+	RequireAuthority bool // fail if this node is not in the authority set
+}
+
 // IQueue is the interface returned by returning queue functions
 type IQueue interface {
 	Length() int
@@ -309,10 +318,22 @@ type IState interface {
 	GetElections() IElections
 	GetAuthorities() []IAuthority
 	GetAuthorityInterface(chainid IHash) IAuthority
+	GetIdentityInterface(chainid IHash) interface{}
+	GetAuthorityHistory() (interface{}, error)
+	// GetAuthorityChangeProposals returns the scheduled authority set changes
+	GetAuthorityChangeProposals() interface{}
+	// Health section
+	GetHealthThresholds() HealthThresholds
+	GetNumberOfPeers() int // -1 if the node is not on a p2p network
+	CheckDatabaseWritable() error
+	CheckIdentityEntry(entry IEBEntry) (bool, error)
 	GetLeaderPL() IProcessList
+	GetProcessListHistory(dbheight uint32, count int) interface{}
+	DumpFlightRecorder(reason string) (file string, err error)
 	GetLLeaderHeight() uint32
 	GetEntryDBHeightComplete() uint32
 	GetMissingEntryCount() uint32
+	GetEntrySyncProgress() (missing int, rate float64, eta time.Duration)
 	GetEntryBlockDBHeightProcessing() uint32
 	GetEntryBlockDBHeightComplete() uint32
 	GetCurrentBlockStartTime() int64
@@ -15,12 +15,13 @@ import (
 	"github.com/FactomProject/factomd/common/messages/msgbase"
 	"github.com/FactomProject/factomd/common/primitives"
 
+	flog "github.com/FactomProject/factomd/log"
 	log "github.com/sirupsen/logrus"
 )
 
 // packageLogger is the general logger for all message related logs. You can add additional fields,
 // or create more context loggers off of this
-var packageLogger = log.WithFields(log.Fields{"package": "messages"})
+var packageLogger = flog.Subsystem("messages")
 
 //General acknowledge message
 type Ack struct {
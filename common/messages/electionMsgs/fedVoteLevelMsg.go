@@ -12,6 +12,7 @@ import (
 	"os"
 	"reflect"
 
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
@@ -138,6 +139,14 @@ func (m *FedVoteLevelMsg) processIfCommitted(is interfaces.IState, elect interfa
 		e.Federated[m.Volunteer.FedIdx], e.Audit[m.Volunteer.ServerIdx] =
 			e.Audit[m.Volunteer.ServerIdx], e.Federated[m.Volunteer.FedIdx]
 		e.Adapter.SetElectionProcessed(true)
+		e.State.(*state.State).ProcessListHistory.ElectionFinished(m.Volunteer.DBHeight, int(m.Volunteer.Minute), m.Volunteer.VMIndex,
+			m.Volunteer.SigType, m.Volunteer.FedID, m.Volunteer.ServerID)
+		alerts.Raise(is.GetFactomNodeName(), alerts.ElectionFinished, m.Volunteer.DBHeight, "Leader %x of VM %d replaced by audit server %x in minute %d",
+			m.Volunteer.FedID.Bytes()[3:6], m.Volunteer.VMIndex, m.Volunteer.ServerID.Bytes()[3:6], m.Volunteer.Minute)
+		if m.Volunteer.FedID.IsSameAs(is.GetIdentityChainID()) {
+			alerts.Raise(is.GetFactomNodeName(), alerts.Faulted, m.Volunteer.DBHeight, "This node was replaced as the leader of VM %d in minute %d",
+				m.Volunteer.VMIndex, m.Volunteer.Minute)
+		}
 		m.ProcessInState = true
 		m.SetValid()
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages/msgbase"
@@ -81,6 +82,12 @@ func (m *StartElectionInternal) FollowerExecute(is interfaces.IState) {
 	}
 
 	m.VMHeight = vm.Height
+	s.ProcessListHistory.ElectionStarted(m.DBHeight, int(m.Minute), m.VMIndex, m.SigType)
+	missing := "DBSig"
+	if m.SigType {
+		missing = "EOM"
+	}
+	alerts.Raise(s.FactomNodeName, alerts.ElectionStarted, m.DBHeight, "Election to replace the leader of VM %d in minute %d, missing its %s", m.VMIndex, m.Minute, missing)
 
 	// Send to elections
 	is.ElectionsQueue().Enqueue(m)
@@ -72,6 +72,28 @@ func checkForChangesInDebugRegex() {
 	globals.LastDebugLogRegEx = globals.Params.DebugLogRegEx
 }
 
+// SetDebugLogRegEx changes which message logs are written while the node runs.  An empty
+// regex turns them all off.
+func SetDebugLogRegEx(regex string) error {
+	_, r := SplitUpDebugLogRegEx(regex)
+	if _, err := regexp.Compile("(?i)" + r); err != nil {
+		return err
+	}
+	traceMutex.Lock()
+	defer traceMutex.Unlock()
+	globals.Params.DebugLogRegEx = regex
+	checkForChangesInDebugRegex()
+	return nil
+}
+
+// GetDebugLogRegEx returns the regex picking the message logs, with its directory
+func GetDebugLogRegEx() string {
+	traceMutex.Lock()
+	defer traceMutex.Unlock()
+	checkForChangesInDebugRegex()
+	return globals.Params.DebugLogLocation + globals.Params.DebugLogRegEx
+}
+
 func SplitUpDebugLogRegEx(DebugLogRegEx string) (string, string) {
 	lastSlashIndex := strings.LastIndex(DebugLogRegEx, string(os.PathSeparator))
 	regex := DebugLogRegEx[lastSlashIndex+1:]
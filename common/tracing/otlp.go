@@ -0,0 +1,163 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// The OTLP/HTTP JSON encoding of an export request.  Only the fields factomd sets are
+// here.  Trace and span ids are hex, and times are nanoseconds since the epoch as strings.
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code int `json:"code"` // 1 ok, 2 error
+}
+
+const (
+	spanKindInternal = 1
+	statusOk         = 1
+	statusError      = 2
+)
+
+type exporter struct {
+	endpoint string
+	service  string
+	client   *http.Client
+	failing  bool // only the first of a run of errors is reported
+}
+
+func newExporter(endpoint string, service string) *exporter {
+	e := new(exporter)
+	e.endpoint = endpoint
+	e.service = service
+	e.client = &http.Client{Timeout: exportTimeout}
+	return e
+}
+
+// request builds the export request, with a resource for each node
+func (e *exporter) request(spans []*span) *otlpRequest {
+	byNode := make(map[string][]otlpSpan)
+	for _, s := range spans {
+		byNode[s.node] = append(byNode[s.node], toOTLP(s))
+	}
+	var nodes []string
+	for node := range byNode {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	r := new(otlpRequest)
+	for _, node := range nodes {
+		r.ResourceSpans = append(r.ResourceSpans, otlpResourceSpans{
+			Resource: otlpResource{Attributes: []otlpKeyValue{
+				{"service.name", otlpValue{e.service}},
+				{"service.instance.id", otlpValue{node}},
+			}},
+			ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{"factomd"}, Spans: byNode[node]}},
+		})
+	}
+	return r
+}
+
+func toOTLP(s *span) otlpSpan {
+	o := otlpSpan{
+		TraceID:           hex.EncodeToString(s.traceID[:]),
+		SpanID:            hex.EncodeToString(s.spanID[:]),
+		Name:              s.name,
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+		Status:            otlpStatus{statusOk},
+	}
+	if s.parentID != ([8]byte{}) {
+		o.ParentSpanID = hex.EncodeToString(s.parentID[:])
+	}
+	if s.failed {
+		o.Status.Code = statusError
+	}
+	for _, a := range s.attrs {
+		o.Attributes = append(o.Attributes, otlpKeyValue{a.Key, otlpValue{a.Value}})
+	}
+	return o
+}
+
+func (e *exporter) export(spans []*span) {
+	data, err := json.Marshal(e.request(spans))
+	if err != nil {
+		e.reportError(err)
+		return
+	}
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		e.reportError(err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		e.reportError(fmt.Errorf("%s: %s", resp.Status, body))
+		return
+	}
+	e.failing = false
+}
+
+func (e *exporter) reportError(err error) {
+	if e.failing {
+		return
+	}
+	e.failing = true
+	fmt.Fprintf(os.Stderr, "Trace export to %s failed: %v\n", e.endpoint, err)
+}
@@ -0,0 +1,343 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package tracing records the lifecycle of acknowledged messages as distributed
+// trace spans, and exports them to an OpenTelemetry collector over OTLP/HTTP.
+//
+// Each node keeps one trace per message hash.  The trace id is taken from the message
+// hash, so every node traces a message under the same id even if the trace context is
+// lost on the way.  Each stage is a span covering the time since the previous stage on
+// that node, so a long span shows where the message waited.  A root span per node
+// covers the message from the first stage to the block stage.  Nodes pass the trace
+// context to each other in the p2p parcel header, so the root span on the receiving
+// node is a child of the span that sent it.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The stages of a message's lifecycle
+const (
+	StageAPI         = "api"         // submitted to this node's API
+	StageReceive     = "receive"     // received from the p2p network
+	StageValidate    = "validate"    // first validated
+	StageHolding     = "holding"     // put in holding to wait for an ack
+	StageAck         = "ack"         // acknowledged by its leader
+	StageProcessList = "processlist" // added to the process list with its ack
+	StageBlock       = "block"       // processed into the block being built
+)
+
+const (
+	maxTraces     = 100000           // open traces kept, across all nodes
+	maxFinished   = 20000            // spans waiting to be exported
+	maxTraceAge   = time.Hour        // a trace not in a block by then is ended
+	maxRemote     = 100000           // trace contexts from peers kept
+	maxRemoteAge  = 10 * time.Minute // a trace context from a peer not used by then is dropped
+	exportPeriod  = 5 * time.Second
+	exportTimeout = 10 * time.Second
+)
+
+const typeKey = "factomd.msg.type"
+
+// Attribute is a string attribute of a span
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+type span struct {
+	node     string
+	traceID  [16]byte
+	spanID   [8]byte
+	parentID [8]byte
+	name     string
+	start    time.Time
+	end      time.Time
+	attrs    []Attribute
+	failed   bool
+}
+
+// msgTrace is a message's trace on one node
+type msgTrace struct {
+	root   *span
+	last   time.Time // time of the last stage
+	lastID [8]byte   // span id of the last stage
+	stages map[string]bool
+	typed  bool // the root span has the message type
+}
+
+type remoteContext struct {
+	traceID  [16]byte
+	parentID [8]byte
+	received time.Time
+}
+
+type tracer struct {
+	mutex    sync.Mutex
+	exporter *exporter
+	traces   map[string]map[[32]byte]*msgTrace // [node][message hash]
+	count    int
+	remote   map[[32]byte]remoteContext // [message hash]
+	finished []*span
+	dropped  int
+}
+
+// The tracer once tracing is enabled.  Loaded without a lock, as every stage of every
+// message checks it.
+var current atomic.Value // *tracer
+var enableMutex sync.Mutex
+
+// Enable starts tracing, with the spans exported to an OTLP/HTTP traces endpoint
+// such as http://localhost:4318/v1/traces
+func Enable(endpoint string, service string) {
+	enableMutex.Lock()
+	defer enableMutex.Unlock()
+	if getTracer() != nil {
+		return
+	}
+	tr := newTracer(newExporter(endpoint, service))
+	current.Store(tr)
+	go tr.run()
+}
+
+// Enabled returns true if messages are being traced
+func Enabled() bool {
+	return getTracer() != nil
+}
+
+func getTracer() *tracer {
+	tr, _ := current.Load().(*tracer)
+	return tr
+}
+
+func newTracer(e *exporter) *tracer {
+	tr := new(tracer)
+	tr.exporter = e
+	tr.traces = make(map[string]map[[32]byte]*msgTrace)
+	tr.remote = make(map[[32]byte]remoteContext)
+	return tr
+}
+
+// Traced returns true for the message types that are traced, the ones that are acknowledged
+func Traced(msg interfaces.IMsg) bool {
+	return msg != nil && constants.NeedsAck(msg.Type())
+}
+
+// Stage records a stage of the message's lifecycle on the node.  Only the first time a
+// stage is reached is recorded.
+func Stage(node string, msg interfaces.IMsg, stage string, attrs ...Attribute) {
+	tr := getTracer()
+	if tr == nil || !Traced(msg) || msg.GetMsgHash() == nil {
+		return
+	}
+	attrs = append(attrs, Attribute{typeKey, constants.MessageName(msg.Type())})
+	tr.stage(node, msg.GetMsgHash().Fixed(), stage, time.Now(), attrs)
+}
+
+// StageHash records a stage for a message known only by its hash, such as when its ack
+// arrives first
+func StageHash(node string, hash interfaces.IHash, stage string, attrs ...Attribute) {
+	tr := getTracer()
+	if tr == nil || hash == nil {
+		return
+	}
+	tr.stage(node, hash.Fixed(), stage, time.Now(), attrs)
+}
+
+// TraceParent returns the W3C traceparent of the message's latest span on the node, to
+// send with the message, or "" if it is not traced
+func TraceParent(node string, msg interfaces.IMsg) string {
+	tr := getTracer()
+	if tr == nil || !Traced(msg) || msg.GetMsgHash() == nil {
+		return ""
+	}
+	return tr.traceParent(node, msg.GetMsgHash().Fixed())
+}
+
+// Received records a message from the network, with the traceparent it was sent with
+func Received(node string, msg interfaces.IMsg, traceParent string) {
+	tr := getTracer()
+	if tr == nil || !Traced(msg) || msg.GetMsgHash() == nil {
+		return
+	}
+	hash := msg.GetMsgHash().Fixed()
+	if rc, ok := parseTraceParent(traceParent); ok {
+		rc.received = time.Now()
+		tr.addRemote(hash, rc)
+	}
+	tr.stage(node, hash, StageReceive, time.Now(), []Attribute{{typeKey, constants.MessageName(msg.Type())}})
+}
+
+func (tr *tracer) stage(node string, hash [32]byte, stage string, now time.Time, attrs []Attribute) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+
+	mt := tr.get(node, hash, now)
+	if mt == nil || mt.stages[stage] {
+		return
+	}
+	mt.stages[stage] = true
+
+	s := &span{node: node, traceID: mt.root.traceID, spanID: newSpanID(), parentID: mt.root.spanID, name: stage, start: mt.last, end: now}
+	s.attrs = append(s.attrs, attrs...)
+	mt.last = now
+	mt.lastID = s.spanID
+	tr.finish(s)
+
+	// The root span has the message type, and where the message ended up
+	for _, a := range attrs {
+		if a.Key != typeKey || !mt.typed {
+			mt.root.attrs = append(mt.root.attrs, a)
+			mt.typed = mt.typed || a.Key == typeKey
+		}
+	}
+	if stage == StageBlock {
+		mt.root.end = now
+		tr.finish(mt.root)
+		tr.remove(node, hash)
+	}
+}
+
+// get returns the message's trace on the node, starting one if there is room
+func (tr *tracer) get(node string, hash [32]byte, now time.Time) *msgTrace {
+	if tr.traces[node] == nil {
+		tr.traces[node] = make(map[[32]byte]*msgTrace)
+	}
+	mt := tr.traces[node][hash]
+	if mt != nil {
+		return mt
+	}
+	if tr.count >= maxTraces {
+		tr.dropped++
+		return nil
+	}
+
+	root := &span{node: node, spanID: newSpanID(), name: "message", start: now}
+	copy(root.traceID[:], hash[:16])
+	root.attrs = []Attribute{{"factomd.msg.hash", hex.EncodeToString(hash[:])}}
+	if rc, ok := tr.remote[hash]; ok {
+		root.traceID = rc.traceID
+		root.parentID = rc.parentID
+		delete(tr.remote, hash)
+	}
+
+	mt = &msgTrace{root: root, last: now, lastID: root.spanID, stages: make(map[string]bool)}
+	tr.traces[node][hash] = mt
+	tr.count++
+	return mt
+}
+
+// addRemote keeps the trace context a peer sent a message with, until the message is
+// traced here.  Peers can send any number of messages, so when there are maxRemote
+// contexts waiting the new one is dropped, and the message traced on its own.
+func (tr *tracer) addRemote(hash [32]byte, rc remoteContext) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	if _, ok := tr.remote[hash]; !ok && len(tr.remote) >= maxRemote {
+		return
+	}
+	tr.remote[hash] = rc
+}
+
+func (tr *tracer) remove(node string, hash [32]byte) {
+	delete(tr.traces[node], hash)
+	tr.count--
+}
+
+func (tr *tracer) finish(s *span) {
+	if len(tr.finished) >= maxFinished {
+		tr.dropped++
+		return
+	}
+	tr.finished = append(tr.finished, s)
+}
+
+func (tr *tracer) traceParent(node string, hash [32]byte) string {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	mt := tr.traces[node][hash]
+	if mt == nil {
+		return ""
+	}
+	return fmt.Sprintf("00-%x-%x-01", mt.root.traceID, mt.lastID)
+}
+
+// gc ends the traces that have been open too long, and drops old trace contexts
+func (tr *tracer) gc(now time.Time) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	for node, traces := range tr.traces {
+		for hash, mt := range traces {
+			if now.Sub(mt.root.start) > maxTraceAge {
+				mt.root.end = now
+				mt.root.failed = true
+				mt.root.attrs = append(mt.root.attrs, Attribute{"factomd.msg.result", "not in a block"})
+				tr.finish(mt.root)
+				tr.remove(node, hash)
+			}
+		}
+	}
+	for hash, rc := range tr.remote {
+		if now.Sub(rc.received) > maxRemoteAge {
+			delete(tr.remote, hash)
+		}
+	}
+}
+
+// take returns the finished spans, and how many were dropped since the last take
+func (tr *tracer) take() ([]*span, int) {
+	tr.mutex.Lock()
+	defer tr.mutex.Unlock()
+	spans, dropped := tr.finished, tr.dropped
+	tr.finished, tr.dropped = nil, 0
+	return spans, dropped
+}
+
+func (tr *tracer) run() {
+	for {
+		time.Sleep(exportPeriod)
+		tr.gc(time.Now())
+		spans, dropped := tr.take()
+		if dropped > 0 {
+			tr.exporter.reportError(fmt.Errorf("dropped %d spans, too many messages to trace", dropped))
+		}
+		if len(spans) > 0 {
+			tr.exporter.export(spans)
+		}
+	}
+}
+
+func newSpanID() (id [8]byte) {
+	rand.Read(id[:])
+	return id
+}
+
+// parseTraceParent parses a W3C traceparent, version-traceid-parentid-flags
+func parseTraceParent(tp string) (rc remoteContext, ok bool) {
+	parts := strings.Split(tp, "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return rc, false
+	}
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return rc, false
+	}
+	parentID, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return rc, false
+	}
+	copy(rc.traceID[:], traceID)
+	copy(rc.parentID[:], parentID)
+	return rc, true
+}
@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMessageLifecycle(t *testing.T) {
+	tr := newTracer(nil)
+	var hash [32]byte
+	hash[0] = 7
+	now := time.Now()
+
+	tr.stage("FNode0", hash, StageAPI, now, []Attribute{{typeKey, "Commit Entry"}})
+	tr.stage("FNode0", hash, StageValidate, now.Add(time.Second), []Attribute{{typeKey, "Commit Entry"}})
+	// Only the first validate is recorded
+	tr.stage("FNode0", hash, StageValidate, now.Add(2*time.Second), []Attribute{{typeKey, "Commit Entry"}})
+
+	tp := tr.traceParent("FNode0", hash)
+	rc, ok := parseTraceParent(tp)
+	if !ok {
+		t.Fatalf("Bad traceparent %s", tp)
+	}
+	if rc.traceID[0] != 7 {
+		t.Errorf("Expected the trace id from the message hash, got %x", rc.traceID)
+	}
+
+	// The trace context carries to another node
+	tr.addRemote(hash, rc)
+	tr.stage("FNode1", hash, StageReceive, now.Add(3*time.Second), nil)
+	tr.stage("FNode1", hash, StageBlock, now.Add(4*time.Second), []Attribute{{"factomd.dbheight", "5"}})
+
+	spans, dropped := tr.take()
+	if dropped != 0 {
+		t.Errorf("Expected no dropped spans, got %d", dropped)
+	}
+	names := []string{StageAPI, StageValidate, StageReceive, StageBlock, "message"}
+	if len(spans) != len(names) {
+		t.Fatalf("Expected %d spans, got %d", len(names), len(spans))
+	}
+	for i, s := range spans {
+		if s.name != names[i] {
+			t.Errorf("Expected span %d to be %s, got %s", i, names[i], s.name)
+		}
+	}
+
+	validate := spans[1]
+	if validate.end.Sub(validate.start) != time.Second {
+		t.Errorf("Expected validate to cover the second since the api stage, got %v", validate.end.Sub(validate.start))
+	}
+	root := spans[4]
+	if root.node != "FNode1" || root.parentID != rc.parentID || root.traceID != rc.traceID {
+		t.Errorf("Expected the FNode1 root span to be a child of the FNode0 validate span")
+	}
+	if root.end.Sub(root.start) != time.Second {
+		t.Errorf("Expected the root span to end at the block stage, got %v", root.end.Sub(root.start))
+	}
+	if tr.count != 1 || tr.traces["FNode1"][hash] != nil {
+		t.Errorf("Expected only the FNode0 trace to be open")
+	}
+
+	// FNode0 never saw the block
+	tr.gc(now.Add(maxTraceAge + time.Minute))
+	spans, _ = tr.take()
+	if len(spans) != 1 || !spans[0].failed || spans[0].node != "FNode0" {
+		t.Errorf("Expected the open trace to be ended as failed")
+	}
+	if tr.count != 0 {
+		t.Errorf("Expected no open traces, got %d", tr.count)
+	}
+}
+
+func TestRemoteLimit(t *testing.T) {
+	tr := newTracer(nil)
+	var hash [32]byte
+	for i := 0; i < maxRemote+10; i++ {
+		hash[0], hash[1], hash[2] = byte(i), byte(i>>8), byte(i>>16)
+		tr.addRemote(hash, remoteContext{received: time.Now()})
+	}
+	if len(tr.remote) != maxRemote {
+		t.Errorf("Expected %d trace contexts kept, got %d", maxRemote, len(tr.remote))
+	}
+
+	// A context already kept is still updated
+	hash = [32]byte{}
+	tr.addRemote(hash, remoteContext{parentID: [8]byte{1}})
+	if tr.remote[hash].parentID[0] != 1 {
+		t.Errorf("Expected the kept trace context to be replaced")
+	}
+}
+
+func TestParseTraceParent(t *testing.T) {
+	for _, bad := range []string{"", "00-xx-yy-01", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b71692033-01", "00-0af7651916cd43dd8448eb211c80319z-b7ad6b7169203331-01"} {
+		if _, ok := parseTraceParent(bad); ok {
+			t.Errorf("Expected %q to be refused", bad)
+		}
+	}
+	rc, ok := parseTraceParent("00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	if !ok || rc.traceID[0] != 0x0a || rc.parentID[7] != 0x31 {
+		t.Errorf("Wrong parse %v", rc)
+	}
+}
+
+func TestExport(t *testing.T) {
+	var got otlpRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	e := newExporter(server.URL, "factomd")
+	now := time.Now()
+	e.export([]*span{
+		{node: "FNode1", name: StageAck, start: now, end: now, parentID: [8]byte{1}},
+		{node: "FNode0", name: "message", start: now, end: now, failed: true, attrs: []Attribute{{typeKey, "EOM"}}},
+	})
+	if e.failing {
+		t.Fatalf("Export failed")
+	}
+
+	if len(got.ResourceSpans) != 2 {
+		t.Fatalf("Expected a resource per node, got %d", len(got.ResourceSpans))
+	}
+	node0 := got.ResourceSpans[0]
+	if node0.Resource.Attributes[1].Value.StringValue != "FNode0" {
+		t.Errorf("Expected the resources in node order")
+	}
+	s := node0.ScopeSpans[0].Spans[0]
+	if s.Status.Code != statusError || s.ParentSpanID != "" || s.Attributes[0].Value.StringValue != "EOM" {
+		t.Errorf("Wrong span %+v", s)
+	}
+	if got.ResourceSpans[1].ScopeSpans[0].Spans[0].ParentSpanID != "0100000000000000" {
+		t.Errorf("Wrong parent span id")
+	}
+}
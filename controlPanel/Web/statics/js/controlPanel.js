@@ -46,6 +46,9 @@ function updateHTML() {
   } else if($("#indexnav-more").hasClass("is-active")) {
     // Detailed Tab
     updataDataDumps()
+  } else if($("#indexnav-authorities").hasClass("is-active")) {
+    // Authority Tab
+    updateAuthorities()
   }
 
 }
@@ -74,6 +77,7 @@ $("#indexnav-main > a").click(function() {
     $("#transactions").removeClass("hide")
     $("#local").removeClass("hide")
     $("#dataDump").addClass("hide")
+    $("#authorities").addClass("hide")
   }
 })
 
@@ -83,6 +87,17 @@ $("#indexnav-more > a").click(function() {
     $("#transactions").addClass("hide")
     $("#local").addClass("hide")
     $("#dataDump").removeClass("hide")
+    $("#authorities").addClass("hide")
+  }
+})
+
+$("#indexnav-authorities > a").click(function() {
+  if (jQuery(this).hasClass("is-active")) {
+  } else {
+    $("#transactions").addClass("hide")
+    $("#local").addClass("hide")
+    $("#dataDump").addClass("hide")
+    $("#authorities").removeClass("hide")
   }
 })
 
@@ -113,6 +128,108 @@ function updataDataDumps() {
         obj.LogSettingsDump.CurrentLogSettings = " "
     }
     $("#dump7 #current-log-value").text(obj.LogSettingsDump.CurrentLogSettings)
+
+    $("#dump8 #dumpAlertsActive").text(obj.AlertsDump.Active)
+    $("#dump8 #dumpAlertsRecent").text(obj.AlertsDump.Recent)
+  })
+}
+
+// Server whose history is charted on the authority tab
+var selectedAuthority = ""
+
+$("#authority-blocks").change(function() {
+  updateAuthorities()
+})
+
+function updateAuthorities() {
+  queryState("authorityPerformance", $("#authority-blocks").val(), function(resp){
+    servers = JSON.parse(resp)
+    if (servers == null) {
+      servers = []
+    }
+    body = $("#authority-table tbody")
+    body.html("")
+    var selected = null
+    servers.forEach(function(server) {
+      row = $("<tr>")
+      row.append($("<td>").text(server.serverid.substring(0, 10) + "...").attr("title", server.serverid))
+      row.append($("<td>").text(server.federated ? "Federated" : "Audit"))
+      row.append($("<td>").text(server.fedblocks))
+      row.append($("<td>").text(server.acks))
+      row.append($("<td>").text(server.acklatency.toFixed(0)))
+      row.append($("<td>").text(server.eomlag.toFixed(0)))
+      row.append($("<td>").text(server.dbsiglag.toFixed(0)))
+      row.append($("<td>").text(server.missedeoms))
+      row.append($("<td>").text(server.missedblocks))
+      row.append($("<td>").text(server.faults))
+      row.append($("<td>").text(server.elections))
+      if (server.missedeoms > 0 || server.missedblocks > 0 || server.faults > 0) {
+        row.addClass("authority-trouble")
+      }
+      if (server.serverid == selectedAuthority) {
+        row.addClass("selected")
+        selected = server
+      }
+      row.click(function() {
+        selectedAuthority = server.serverid
+        updateAuthorities()
+      })
+      body.append(row)
+    })
+    updateAuthorityDetail(selected)
+  })
+}
+
+function updateAuthorityDetail(server) {
+  if (server == null) {
+    $("#authority-detail").addClass("hide")
+    return
+  }
+  $("#authority-detail").removeClass("hide")
+  $("#authority-detail-server").text(server.serverid)
+  minutes = $("#authority-minutes tbody tr")
+  minutes.html("")
+  server.minuteeomlag.forEach(function(lag) {
+    minutes.append($("<td>").text(lag.toFixed(0)))
+  })
+  drawAuthorityChart("#authority-chart-ack", server.history, "acklatency")
+  drawAuthorityChart("#authority-chart-eom", server.history, "eomlag")
+  drawAuthorityChart("#authority-chart-dbsig", server.history, "dbsiglag")
+}
+
+// Draws a bar for each block of the history, scaled to the largest
+function drawAuthorityChart(id, history, field) {
+  svg = $(id)
+  svg.html("")
+  if (history == null || history.length == 0) {
+    return
+  }
+  var max = 1
+  history.forEach(function(block) {
+    max = Math.max(max, block[field])
+  })
+  width = 300 / history.length
+  history.forEach(function(block, i) {
+    height = 100 * block[field] / max
+    bar = document.createElementNS("http://www.w3.org/2000/svg", "rect")
+    bar.setAttribute("x", i * width)
+    bar.setAttribute("y", 100 - height)
+    bar.setAttribute("width", Math.max(width - 1, 1))
+    bar.setAttribute("height", height)
+    color = "#2199e8"
+    if (!block.federated) {
+      color = "#cacaca"
+    } else if (block.misseddbsig || block.missedeoms > 0 || block.faults > 0) {
+      // Show trouble even when there is no lag to draw
+      color = "#c60f13"
+      bar.setAttribute("y", Math.min(100 - height, 95))
+      bar.setAttribute("height", Math.max(height, 5))
+    }
+    bar.setAttribute("fill", color)
+    title = document.createElementNS("http://www.w3.org/2000/svg", "title")
+    title.textContent = "Block " + block.dbheight + ": " + block[field].toFixed(0) + " ms"
+    bar.appendChild(title)
+    svg.append(bar)
   })
 }
 
@@ -383,14 +500,13 @@ function updateAllPeers() {
 
 // Add listeners to disconnect buttons
 $("body").on('mouseup',"#peerList  #disconnect",function(e) {
-  queryState("disconnect", jQuery(this).attr("value"), function(resp){
-    obj = JSON.parse(resp)
-    if(obj.Access == "denied") {
-      $("#" + obj.Id).find("#disconnect").addClass("disabled")
-      $("#" + obj.Id).find("#disconnect").text("Denied")
+  var button = jQuery(this)
+  postAPI("peers/disconnect", {"peer": button.attr("value")}, function(status, resp){
+    button.addClass("disabled")
+    if(status == 200) {
+      button.text("Attempting")
     } else {
-        $("#" + obj.Id).find("#disconnect").addClass("disabled")
-        $("#" + obj.Id).find("#disconnect").text("Attempting")
+      button.text("Denied")
     }
   })
 })
@@ -11,6 +11,20 @@ function queryState(item, value, func) {
   req.send()
 }
 
+// postAPI sends a JSON request to a method of the API, see api.go
+function postAPI(method, body, func) {
+  var req = new XMLHttpRequest()
+
+  req.onreadystatechange = function() {
+    if(req.readyState == 4) {
+      func(req.status, req.response)
+    }
+  }
+  req.open("POST", "./api/v1/" + method, true)
+  req.setRequestHeader("Content-Type", "application/json")
+  req.send(JSON.stringify(body))
+}
+
 function batchQueryState(item, func) {
   var req = new XMLHttpRequest()
 
@@ -0,0 +1,150 @@
+package controlPanel
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Accounts let people use the control panel with a role that limits what they can do.
+// They are configured as a list of name:role:token entries, and authenticate with HTTP
+// basic auth as the name and token, or with an "Authorization: Bearer <token>" header.
+// The RPC user and password are still accepted as an admin.  If there are no accounts
+// and no RPC user, the control panel is open to anyone as an admin, as it always was.
+//
+// The ControlPanelSetting is the most anyone may do: readonly makes everyone a viewer.
+
+type Role int
+
+// Roles, each allowed everything the roles before it are
+const (
+	RoleNone     Role = iota
+	RoleViewer        // read the status of the node
+	RoleOperator      // connect and disconnect peers
+	RoleAdmin         // change the node's settings, such as its debug logs
+)
+
+var roleNames = map[Role]string{
+	RoleNone:     "none",
+	RoleViewer:   "viewer",
+	RoleOperator: "operator",
+	RoleAdmin:    "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+func ParseRole(name string) (Role, error) {
+	for r, n := range roleNames {
+		if r != RoleNone && n == strings.ToLower(strings.TrimSpace(name)) {
+			return r, nil
+		}
+	}
+	return RoleNone, fmt.Errorf("Unknown role %q, use viewer, operator or admin", name)
+}
+
+type Account struct {
+	Name string `json:"name"`
+	Role Role   `json:"-"`
+
+	tokenHash []byte
+}
+
+type Accounts struct {
+	list        []*Account
+	rpcUser     string        // also accepted as an admin, if set
+	rpcAuthHash func() []byte // hash of the RPC user's basic auth header, set once the API starts
+}
+
+// ParseAccounts reads a list of name:role:token entries, such as
+// "noc:viewer:secret1, ops:operator:secret2", along with the RPC user and where to get
+// the hash of its basic auth header
+func ParseAccounts(config string, rpcUser string, rpcAuthHash func() []byte) (*Accounts, error) {
+	a := new(Accounts)
+	a.rpcUser = rpcUser
+	a.rpcAuthHash = rpcAuthHash
+	names := make(map[string]bool)
+	for _, entry := range strings.Split(config, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("Bad control panel account %q, expected name:role:token", entry)
+		}
+		role, err := ParseRole(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if names[parts[0]] || parts[0] == rpcUser {
+			return nil, fmt.Errorf("Control panel account %s is defined twice", parts[0])
+		}
+		names[parts[0]] = true
+		a.list = append(a.list, &Account{Name: parts[0], Role: role, tokenHash: hashToken(parts[2])})
+	}
+	return a, nil
+}
+
+// hashToken gives tokens a constant size, so comparing them takes constant time
+func hashToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+// Open is true if anyone may use the control panel without authenticating
+func (a *Accounts) Open() bool {
+	return len(a.list) == 0 && a.rpcUser == ""
+}
+
+// Authenticate returns the account a request authenticates as, or false if it does not
+func (a *Accounts) Authenticate(r *http.Request) (*Account, bool) {
+	if a.Open() {
+		return &Account{Name: "anonymous", Role: RoleAdmin}, true
+	}
+
+	header := r.Header.Get("Authorization")
+	if strings.HasPrefix(header, "Bearer ") {
+		presented := hashToken(strings.TrimSpace(strings.TrimPrefix(header, "Bearer ")))
+		for _, account := range a.list {
+			if subtle.ConstantTimeCompare(presented, account.tokenHash) == 1 {
+				return account, true
+			}
+		}
+		return nil, false
+	}
+
+	name, token, ok := r.BasicAuth()
+	if !ok {
+		return nil, false
+	}
+	if a.rpcUser != "" && name == a.rpcUser {
+		presented := sha256.Sum256([]byte(header))
+		if subtle.ConstantTimeCompare(presented[:], a.rpcAuthHash()) == 1 {
+			return &Account{Name: name, Role: RoleAdmin}, true
+		}
+		return nil, false
+	}
+	for _, account := range a.list {
+		if account.Name == name && subtle.ConstantTimeCompare(hashToken(token), account.tokenHash) == 1 {
+			return account, true
+		}
+	}
+	return nil, false
+}
+
+// CapRole limits a role to what the control panel setting allows
+func CapRole(role Role, controlPanelSetting int) Role {
+	switch {
+	case controlPanelSetting <= 0:
+		return RoleNone
+	case controlPanelSetting == 1 && role > RoleViewer:
+		return RoleViewer
+	}
+	return role
+}
@@ -0,0 +1,77 @@
+package controlPanel_test
+
+import (
+	"crypto/sha256"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/FactomProject/factomd/controlPanel"
+)
+
+func rpcAuthHash() []byte {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.SetBasicAuth("rpcuser", "rpcpass")
+	h := sha256.Sum256([]byte(r.Header.Get("Authorization")))
+	return h[:]
+}
+
+func TestAuthenticate(t *testing.T) {
+	accounts, err := ParseAccounts("noc:viewer:secret1, ops:Operator:secret2", "rpcuser", rpcAuthHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accounts.Open() {
+		t.Error("Expected accounts to close the control panel")
+	}
+
+	tests := []struct {
+		user, pass, bearer string
+		name               string
+		role               Role
+	}{
+		{user: "noc", pass: "secret1", name: "noc", role: RoleViewer},
+		{bearer: "secret2", name: "ops", role: RoleOperator},
+		{user: "rpcuser", pass: "rpcpass", name: "rpcuser", role: RoleAdmin},
+		{user: "noc", pass: "secret2"},
+		{user: "rpcuser", pass: "secret1"},
+		{bearer: "rpcpass"},
+		{},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("GET", "/api/v1/status", nil)
+		if test.user != "" {
+			r.SetBasicAuth(test.user, test.pass)
+		}
+		if test.bearer != "" {
+			r.Header.Set("Authorization", "Bearer "+test.bearer)
+		}
+		account, ok := accounts.Authenticate(r)
+		if ok != (test.name != "") {
+			t.Errorf("Expected %v authenticating %+v", !ok, test)
+			continue
+		}
+		if ok && (account.Name != test.name || account.Role != test.role) {
+			t.Errorf("Expected %s as %s, got %s as %s", test.name, test.role, account.Name, account.Role)
+		}
+	}
+
+	open, _ := ParseAccounts("", "", rpcAuthHash)
+	account, ok := open.Authenticate(httptest.NewRequest("GET", "/", nil))
+	if !ok || account.Role != RoleAdmin {
+		t.Error("Expected anyone to be an admin without accounts or an RPC user")
+	}
+}
+
+func TestParseAccounts(t *testing.T) {
+	for _, bad := range []string{"noc:viewer", "noc:root:secret", ":viewer:secret", "noc:viewer:a,noc:admin:b", "rpcuser:admin:secret"} {
+		if _, err := ParseAccounts(bad, "rpcuser", rpcAuthHash); err == nil {
+			t.Errorf("Expected %q to be refused", bad)
+		}
+	}
+}
+
+func TestCapRole(t *testing.T) {
+	if CapRole(RoleAdmin, 0) != RoleNone || CapRole(RoleAdmin, 1) != RoleViewer || CapRole(RoleOperator, 2) != RoleOperator {
+		t.Error("Expected the control panel setting to limit roles")
+	}
+}
@@ -0,0 +1,253 @@
+package controlPanel
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/alerts"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/p2p"
+)
+
+// The JSON API serves the control panel's data under /api/v1/ for dashboards and scripts.
+// Every method needs a role, see accounts.go.  Methods that change something are POSTed
+// a JSON object with an application/json Content-Type, which a browser will not send to
+// another site without asking it first, so other pages cannot POST to the API with the
+// credentials the browser holds.  Errors are answered with {"error": "..."} and an HTTP
+// status.
+
+type apiMethod struct {
+	httpMethod string
+	role       Role
+	handle     func(r *http.Request, account *Account, role Role) (interface{}, error)
+}
+
+var apiMethods = map[string]apiMethod{
+	"whoami":           {"GET", RoleViewer, apiWhoAmI},
+	"status":           {"GET", RoleViewer, apiStatus},
+	"peers":            {"GET", RoleViewer, apiPeers},
+	"peers/totals":     {"GET", RoleViewer, apiPeerTotals},
+	"transactions":     {"GET", RoleViewer, apiTransactions},
+	"authorities":      {"GET", RoleViewer, apiAuthorities},
+	"alerts":           {"GET", RoleViewer, apiAlerts},
+	"peers/connect":    {"POST", RoleOperator, apiConnect},
+	"peers/disconnect": {"POST", RoleOperator, apiDisconnect},
+	"logs/regex":       {"POST", RoleAdmin, apiSetLogRegex},
+}
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+func writeAPI(w http.ResponseWriter, status int, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(apiError{err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func apiHandler(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Println("Control Panel has encountered a panic in ApiHandler.\n", r)
+		}
+	}()
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	method, ok := apiMethods[name]
+	if !ok {
+		writeAPI(w, http.StatusNotFound, apiError{"No API method " + name})
+		return
+	}
+	if r.Method != method.httpMethod {
+		w.Header().Set("Allow", method.httpMethod)
+		writeAPI(w, http.StatusMethodNotAllowed, apiError{name + " needs a " + method.httpMethod})
+		return
+	}
+
+	account, ok := accounts.Authenticate(r)
+	if !ok {
+		w.Header().Add("WWW-Authenticate", `Basic realm="factomd Control Panel"`)
+		writeAPI(w, http.StatusUnauthorized, apiError{"Unauthorized"})
+		return
+	}
+	role := CapRole(account.Role, StatePointer.ControlPanelSetting)
+	if role < method.role {
+		writeAPI(w, http.StatusForbidden, apiError{fmt.Sprintf("%s needs the %s role, %s has %s", name, method.role, account.Name, role)})
+		return
+	}
+	if method.httpMethod == "POST" && !isJSON(r) {
+		writeAPI(w, http.StatusUnsupportedMediaType, apiError{name + " needs an application/json request"})
+		return
+	}
+
+	result, err := method.handle(r, account, role)
+	if err != nil {
+		writeAPI(w, http.StatusBadRequest, apiError{err.Error()})
+		return
+	}
+	writeAPI(w, http.StatusOK, result)
+}
+
+// isJSON is true if the request says its body is JSON
+func isJSON(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "application/json"
+}
+
+// readAPIRequest decodes the JSON object POSTed to a method
+func readAPIRequest(r *http.Request, v interface{}) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return fmt.Errorf("Bad request, %s", err)
+	}
+	return nil
+}
+
+type WhoAmIResponse struct {
+	Name string `json:"name"`
+	Role string `json:"role"` // limited by the control panel setting
+}
+
+func apiWhoAmI(r *http.Request, account *Account, role Role) (interface{}, error) {
+	return WhoAmIResponse{Name: account.Name, Role: role.String()}, nil
+}
+
+type StatusResponse struct {
+	NodeName       string `json:"nodename"`
+	Version        string `json:"version"`
+	GitBuild       string `json:"gitbuild"`
+	NodeHeight     uint32 `json:"nodeheight"`
+	LeaderHeight   uint32 `json:"leaderheight"`
+	CompleteHeight uint32 `json:"completeheight"` // entries are complete to this block
+	FedServers     int    `json:"fedservers"`
+	AuditServers   int    `json:"auditservers"`
+	Connections    int    `json:"connections"`
+}
+
+func apiStatus(r *http.Request, account *Account, role Role) (interface{}, error) {
+	status := StatusResponse{
+		NodeName: indexTemplateData.NodeName,
+		Version:  indexTemplateData.Version,
+		GitBuild: indexTemplateData.GitBuild,
+	}
+	DisplayStateMutex.RLock()
+	status.NodeHeight = DisplayState.CurrentNodeHeight
+	status.LeaderHeight = DisplayState.LeaderHeight
+	status.CompleteHeight = DisplayState.CurrentEBDBHeight
+	for _, a := range DisplayState.Authorities {
+		if a.Status == 1 {
+			status.FedServers++
+		} else if a.Status == 2 {
+			status.AuditServers++
+		}
+	}
+	DisplayStateMutex.RUnlock()
+	if Controller != nil {
+		status.Connections = Controller.GetNumberOfConnections()
+	}
+	return status, nil
+}
+
+func apiPeers(r *http.Request, account *Account, role Role) (interface{}, error) {
+	return AllConnections.SortedConnections(), nil
+}
+
+func apiPeerTotals(r *http.Request, account *Account, role Role) (interface{}, error) {
+	AllConnections.Lock.Lock()
+	defer AllConnections.Lock.Unlock()
+	totals := AllConnections.Totals
+	return totals, nil
+}
+
+func apiTransactions(r *http.Request, account *Account, role Role) (interface{}, error) {
+	RecentTransactionsMutex.Lock()
+	defer RecentTransactionsMutex.Unlock()
+	data, err := json.Marshal(RecentTransactions)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
+
+// apiAuthorities takes the number of blocks in the "blocks" parameter
+func apiAuthorities(r *http.Request, account *Account, role Role) (interface{}, error) {
+	blocks := defaultPerformanceBlocks
+	if b := r.FormValue("blocks"); b != "" {
+		n, err := strconv.Atoi(b)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("Bad number of blocks %q", b)
+		}
+		blocks = n
+	}
+	return StatePointer.GetAuthorityPerformance(blocks), nil
+}
+
+type AlertsResponse struct {
+	Active []alerts.Alert `json:"active"`
+	Recent []alerts.Alert `json:"recent"`
+}
+
+func apiAlerts(r *http.Request, account *Account, role Role) (interface{}, error) {
+	return AlertsResponse{Active: alerts.Active(StatePointer.FactomNodeName), Recent: alerts.Recent("")}, nil
+}
+
+type PeerRequest struct {
+	Address string `json:"address,omitempty"` // ip:port to connect to
+	Peer    string `json:"peer,omitempty"`    // the PeerHash listed by peers
+}
+
+func apiConnect(r *http.Request, account *Account, role Role) (interface{}, error) {
+	req := new(PeerRequest)
+	if err := readAPIRequest(r, req); err != nil {
+		return nil, err
+	}
+	host, port, err := net.SplitHostPort(req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("Bad address %q, expected ip:port", req.Address)
+	}
+	if Controller == nil {
+		return nil, fmt.Errorf("The node is not networked")
+	}
+	fmt.Printf("ControlPanel: %s connected to %s.\n", account.Name, req.Address)
+	peer := new(p2p.Peer).Init(host, port, 0, p2p.RegularPeer, 0)
+	Controller.DialPeer(*peer, false)
+	return req, nil
+}
+
+func apiDisconnect(r *http.Request, account *Account, role Role) (interface{}, error) {
+	req := new(PeerRequest)
+	if err := readAPIRequest(r, req); err != nil {
+		return nil, err
+	}
+	if req.Peer == "" {
+		return nil, fmt.Errorf("No peer to disconnect")
+	}
+	fmt.Printf("ControlPanel: %s disconnected %s.\n", account.Name, req.Peer)
+	disconnectPeer(req.Peer)
+	return req, nil
+}
+
+type LogRegexRequest struct {
+	Regex string `json:"regex"`
+}
+
+func apiSetLogRegex(r *http.Request, account *Account, role Role) (interface{}, error) {
+	req := new(LogRegexRequest)
+	if err := readAPIRequest(r, req); err != nil {
+		return nil, err
+	}
+	if err := messages.SetDebugLogRegEx(req.Regex); err != nil {
+		return nil, err
+	}
+	fmt.Printf("ControlPanel: %s changed the log regex to: '%s'\n", account.Name, req.Regex)
+	return req, nil
+}
@@ -0,0 +1,100 @@
+package controlPanel
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/FactomProject/factomd/state"
+)
+
+func TestAPIHandler(t *testing.T) {
+	defer func(a *Accounts, s *state.State) { accounts, StatePointer = a, s }(accounts, StatePointer)
+
+	var err error
+	accounts, err = ParseAccounts("noc:viewer:secret1, ops:operator:secret2", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	StatePointer = new(state.State)
+
+	tests := []struct {
+		name        string
+		setting     int
+		httpMethod  string
+		path        string
+		token       string
+		contentType string
+		body        string
+		status      int
+	}{
+		{name: "unknown method", setting: 2, httpMethod: "GET", path: "/api/v1/nosuch", token: "secret1", status: 404},
+		{name: "wrong http method", setting: 2, httpMethod: "GET", path: "/api/v1/peers/disconnect", token: "secret2", status: 405},
+		{name: "no credentials", setting: 2, httpMethod: "GET", path: "/api/v1/whoami", status: 401},
+		{name: "bad token", setting: 2, httpMethod: "GET", path: "/api/v1/whoami", token: "secret3", status: 401},
+		{name: "viewer", setting: 2, httpMethod: "GET", path: "/api/v1/whoami", token: "secret1", status: 200},
+		{name: "trailing slash", setting: 2, httpMethod: "GET", path: "/api/v1/whoami/", token: "secret1", status: 200},
+		{name: "disabled", setting: 0, httpMethod: "GET", path: "/api/v1/whoami", token: "secret1", status: 403},
+		{name: "viewer disconnects", setting: 2, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret1",
+			contentType: "application/json", body: `{"peer":"abc"}`, status: 403},
+		{name: "operator on a read only panel", setting: 1, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret2",
+			contentType: "application/json", body: `{"peer":"abc"}`, status: 403},
+		{name: "operator disconnects", setting: 2, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret2",
+			contentType: "application/json; charset=utf-8", body: `{"peer":"abc"}`, status: 200},
+		{name: "form post", setting: 2, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret2",
+			contentType: "application/x-www-form-urlencoded", body: `{"peer":"abc"}`, status: 415},
+		{name: "text post", setting: 2, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret2",
+			contentType: "text/plain", body: `{"peer":"abc"}`, status: 415},
+		{name: "no content type", setting: 2, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret2",
+			body: `{"peer":"abc"}`, status: 415},
+		{name: "bad json", setting: 2, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret2",
+			contentType: "application/json", body: `{"peer":`, status: 400},
+		{name: "no peer", setting: 2, httpMethod: "POST", path: "/api/v1/peers/disconnect", token: "secret2",
+			contentType: "application/json", body: `{}`, status: 400},
+		{name: "operator sets the log regex", setting: 2, httpMethod: "POST", path: "/api/v1/logs/regex", token: "secret2",
+			contentType: "application/json", body: `{"regex":"."}`, status: 403},
+	}
+	for _, test := range tests {
+		StatePointer.ControlPanelSetting = test.setting
+		r := httptest.NewRequest(test.httpMethod, test.path, strings.NewReader(test.body))
+		if test.token != "" {
+			r.Header.Set("Authorization", "Bearer "+test.token)
+		}
+		if test.contentType != "" {
+			r.Header.Set("Content-Type", test.contentType)
+		}
+		w := httptest.NewRecorder()
+		apiHandler(w, r)
+		if w.Code != test.status {
+			t.Errorf("%s: expected %d, got %d %s", test.name, test.status, w.Code, w.Body.String())
+			continue
+		}
+		if w.Code != 200 {
+			e := new(apiError)
+			if err := json.Unmarshal(w.Body.Bytes(), e); err != nil || e.Error == "" {
+				t.Errorf("%s: expected an error, got %s", test.name, w.Body.String())
+			}
+		}
+	}
+
+	StatePointer.ControlPanelSetting = 2
+	r := httptest.NewRequest("GET", "/api/v1/whoami", nil)
+	r.Header.Set("Authorization", "Bearer secret2")
+	w := httptest.NewRecorder()
+	apiHandler(w, r)
+	who := new(WhoAmIResponse)
+	if err := json.Unmarshal(w.Body.Bytes(), who); err != nil {
+		t.Fatal(err)
+	}
+	if who.Name != "ops" || who.Role != RoleOperator.String() {
+		t.Errorf("Expected ops as an operator, got %+v", who)
+	}
+}
+
+// The pages' GET queries must not change anything, only the API's POSTs may
+func TestFactomdQueryDisconnect(t *testing.T) {
+	if data := factomdQuery("disconnect", "abc", true); len(data) != 0 {
+		t.Errorf("Expected no answer to a disconnect query, got %s", data)
+	}
+}
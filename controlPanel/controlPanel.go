@@ -1,8 +1,6 @@
 package controlPanel
 
 import (
-	"crypto/sha256"
-	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -45,6 +43,7 @@ var (
 	StatePointer      *state.State
 	Controller        *p2p.Controller // Used for Disconnect
 	indexTemplateData *IndexTemplateData
+	accounts          *Accounts
 
 	LastRequest     time.Time
 	TimeRequestHold float64 = 3 // Amount of time in seconds before can request data again
@@ -122,6 +121,13 @@ func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer
 		return
 	}
 
+	var err error
+	accounts, err = ParseAccounts(statePointer.ControlPanelAccounts, statePointer.GetRpcUser(), statePointer.GetRpcAuthHash)
+	if err != nil {
+		fmt.Println("Control Panel will not be served,", err)
+		return
+	}
+
 	go DisplayStateDrain(displayStateChannel)
 
 	indexTemplateData = new(IndexTemplateData)
@@ -149,6 +155,7 @@ func ServeControlPanel(displayStateChannel chan state.DisplayState, statePointer
 	controlPanelMux.HandleFunc("/post", postHandler)
 	controlPanelMux.HandleFunc("/factomd", factomdHandler)
 	controlPanelMux.HandleFunc("/factomdBatch", factomdBatchHandler)
+	controlPanelMux.HandleFunc("/api/v1/", apiHandler)
 
 	tlsIsEnabled, tlsPrivate, tlsPublic := StatePointer.GetTlsInfo()
 	if tlsIsEnabled {
@@ -222,7 +229,8 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 			fmt.Println("Control Panel has encountered a panic in PostHandler.\n", r)
 		}
 	}()
-	if false == checkControlPanelPassword(w, r) {
+	role, ok := authorize(w, r, RoleViewer)
+	if !ok {
 		return
 	}
 	if r.Method != "POST" {
@@ -243,8 +251,7 @@ func postHandler(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 	case "changelogs":
-		// >= 2 means we have write access
-		if StatePointer.ControlPanelSetting == 2 {
+		if role >= RoleAdmin {
 			newRegex := r.FormValue("logsetting")
 			fmt.Printf("Changing log regex to: '%s'\n", newRegex)
 			globals.Params.DebugLogRegEx = newRegex
@@ -285,7 +292,8 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 
 // Batches Json in []byte form to an array of json []byte objects
 func factomdBatchHandler(w http.ResponseWriter, r *http.Request) {
-	if false == checkControlPanelPassword(w, r) {
+	_, ok := authorize(w, r, RoleViewer)
+	if !ok {
 		return
 	}
 	RequestData()
@@ -314,7 +322,8 @@ func factomdHandler(w http.ResponseWriter, r *http.Request) {
 			fmt.Println("Control Panel has encountered a panic in FactomdHandler.\n", r)
 		}
 	}()
-	if false == checkControlPanelPassword(w, r) {
+	_, ok := authorize(w, r, RoleViewer)
+	if !ok {
 		return
 	}
 	if r.Method != "GET" {
@@ -347,6 +356,7 @@ func RequestData() {
 	requestMutex = false
 }
 
+// factomdQuery only reads, peers are disconnected through the API's peers/disconnect
 func factomdQuery(item string, value string, batchQueried bool) []byte {
 	if !batchQueried {
 		RequestData()
@@ -428,20 +438,9 @@ func factomdQuery(item string, value string, batchQueried bool) []byte {
 			}
 		}
 		return data
-	case "disconnect":
-		hash := ""
-		if len(value) > 0 {
-			hash = hashPeerAddress(value)
-		}
-		DisplayStateMutex.RLock()
-		CPS := DisplayState.ControlPanelSetting
-		DisplayStateMutex.RUnlock()
-		if CPS == 2 {
-			disconnectPeer(value)
-			return []byte(`{"Access":"granted", "Id":"` + hash + `"}`)
-		} else {
-			return []byte(`{"Access":"denied", "Id":"` + hash + `"}`)
-		}
+	case "authorityPerformance": // value is the number of blocks
+		data := getAuthorityPerformance(value)
+		return data
 	}
 	return []byte("")
 }
@@ -461,6 +460,25 @@ func getPeers() []byte {
 	return data
 }
 
+// Number of blocks the authority dashboard covers if not asked for
+const defaultPerformanceBlocks = 25
+
+// Returns the performance of the authority servers over the last few blocks
+func getAuthorityPerformance(blocks string) []byte {
+	n, err := strconv.Atoi(blocks)
+	if err != nil || n <= 0 {
+		n = defaultPerformanceBlocks
+	}
+	if StatePointer == nil {
+		return []byte(`[]`)
+	}
+	data, err := json.Marshal(StatePointer.GetAuthorityPerformance(n))
+	if err != nil {
+		return []byte(`error`)
+	}
+	return data
+}
+
 // Returns the total and average statistics for the peer table
 func getPeetTotals() []byte {
 	AllConnections.Lock.Lock()
@@ -827,37 +845,27 @@ func doEvery(d time.Duration, f func(time.Time)) {
 }
 
 func checkControlPanelPassword(response http.ResponseWriter, request *http.Request) bool {
-	if false == checkAuthHeader(request) {
+	_, ok := authorize(response, request, RoleViewer)
+	return ok
+}
+
+// authorize checks the request authenticates as an account that may do what needs the
+// role, and answers the request itself if not.  It returns the account's role, limited
+// by the control panel setting.
+func authorize(response http.ResponseWriter, request *http.Request, need Role) (Role, bool) {
+	account, ok := accounts.Authenticate(request)
+	if !ok {
 		remoteIP := ""
 		remoteIP += strings.Split(request.RemoteAddr, ":")[0]
 		fmt.Printf("Unauthorized Control Panel client connection attempt from %s\n", remoteIP)
 		response.Header().Add("WWW-Authenticate", `Basic realm="factomd Control Panel"`)
 		http.Error(response, "401 Unauthorized.", http.StatusUnauthorized)
-		return false
+		return RoleNone, false
 	}
-	return true
-}
-
-func checkAuthHeader(r *http.Request) bool {
-	if "" == StatePointer.GetRpcUser() {
-		//no username was specified in the config file or command line, meaning factomd control panel is open access
-		return true
-	}
-
-	authhdr := r.Header["Authorization"]
-	if len(authhdr) == 0 {
-		return false
-	}
-
-	correctAuth := StatePointer.GetRpcAuthHash()
-
-	h := sha256.New()
-	h.Write([]byte(authhdr[0]))
-	presentedPassHash := h.Sum(nil)
-
-	cmp := subtle.ConstantTimeCompare(presentedPassHash, correctAuth) //compare hashes because ConstantTimeCompare takes a constant time based on the slice size.  hashing gives a constant slice size.
-	if cmp != 1 {
-		return false
+	role := CapRole(account.Role, StatePointer.ControlPanelSetting)
+	if role < need {
+		http.Error(response, "403 Forbidden.", http.StatusForbidden)
+		return role, false
 	}
-	return true
+	return role, true
 }
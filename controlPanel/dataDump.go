@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/globals"
 	dd "github.com/FactomProject/factomd/controlPanel/dataDumpFormatting"
 )
@@ -38,6 +39,10 @@ type DataDump struct {
 	LogSettingsDump struct {
 		CurrentLogSettings string
 	}
+	AlertsDump struct {
+		Active string
+		Recent string
+	}
 }
 
 func GetDataDumps() []byte {
@@ -68,6 +73,9 @@ func GetDataDumps() []byte {
 
 	holder.LogSettingsDump.CurrentLogSettings = globals.LastDebugLogRegEx
 
+	holder.AlertsDump.Active = AlertsString(alerts.Active(StatePointer.FactomNodeName), false)
+	holder.AlertsDump.Recent = AlertsString(alerts.Recent(""), true)
+
 	ret, err := json.Marshal(holder)
 	if err != nil {
 		return []byte(`{"list":"none"}`)
@@ -75,6 +83,22 @@ func GetDataDumps() []byte {
 	return ret
 }
 
+// AlertsString lists the alerts one to a line, newest first if reversed
+func AlertsString(list []alerts.Alert, reversed bool) string {
+	if len(list) == 0 {
+		return "No alerts"
+	}
+	str := ""
+	for i := range list {
+		a := list[i]
+		if reversed {
+			a = list[len(list)-1-i]
+		}
+		str += a.String() + "\n"
+	}
+	return str
+}
+
 func SortedConnectionString() string {
 	arr := AllConnections.SortedConnections()
 	str := ""
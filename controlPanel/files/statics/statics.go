@@ -24,11 +24,11 @@ type staticFilesFile struct {
 
 var staticFiles = map[string]*staticFilesFile{
 	"css/app.css": {
-		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xacY\xddn\xa4:\x12\xbe\x0eO\xe1U4ҙن\x00\xdd\xf4\x0f\xad\x93\x8b\xd5\xd9}\x89\xa3\xb90`\x825\x06#c\x92\xf4Dy\xf7\x95\xffh\x03\x86\xee\xd9Yq\x91\xe0*ן\xab>\xca\xd5O\xdf\x00C\x1d\xe2\x1d\xf8\xf6\xe4y\x19-.\xe0\x03x\x00\x00\x90\xc1\xfc\xc7\v\xa3}S\xf89%\x94\xa5\xe01\x0e\xc5s\x96\xe4\x926\xdc\xef\xf0O\x94\x82h\u07fe\x9f\xbdOσ\xe0\x03\x18\xdem~8\xc1\xf8\f8\x83M\x879\xa6M\n\x10\xec\x10\b\x83(\xe9\x14\xdb\x19|z0\xad\xe8+b\xd6Nt̒8\x124\xaf\x8a6\xa0\x8a7\xa0\xdan@\xb5ۀ*ـj\xbf\x01\x04f\x88l\x806\xd7l<mO\xc9\xe9(6V\x11\xf8\x98Z\xb9\x8d\x85\x95\xc3\xe2\x1b\xc2/\x15OACY\r\x89\"p\xf4\xce}ipIY\x9d\x82\xbem\x11\xcba\x87\x84wU\x04\xba\x1a\x12\xa2%O\x94\xbac\xe2\x12\xda\xd0F\xca\xf3\x02\x06\x9b\x1f>C\x85\xe5B\xb1\x8b\x8f\xb1tAQ_(\xb1\xc9e\tQ\x18Zd\x86Pc\xd1\xe3\f&q&C\x17d=\xe7\xb4\xd9\x00\xf3\x177m\xcf\xff\xe6\x97\x16\xfd\xd9\xf5Y\x8d\xf9w\xed\x89렏\xbbSR\x9eG\x8e\xee\xf3S\x9e\x15w\x04JkV\xe7j\xf4\x9b\xb7\xb9\x15\xc3\xf9;\xec\xd8E\x87\f\ue93fJL\x00\tb\xdc\xcd\x1c\x17Q\x19\x95gG:\x8c6\xaf\xe9;\xc4\xf1!>\x9c\xadx\x972\x98\x96\xd5\xc2\xf5\xefc?\x10dy5^C5\xc4d\xbc\xd4®{\xa3\xac\xf8\xbe\x01\x1d\"(\xe7\x1b\x19F\xc8\x10\xd4\a\xd1¢\xc0͋OP\xc9S\x101T\x9f'\a$\xbc,ţ\t\x94\x15\x88\x99\x94\xba\xae\xf8\f\x16\xb8\xefRp4I\x98\xd1w\xbf\xab`A\xdfln\xe3&\x84P\x1c\xdc\xd4˴\xa4y߹|uP\x94\xc7\x0e\xc2\u0de1)\xef͛\x89\x81z\x9f\xa5\xa4@\x92R<\xcb\x1eO=\xfb\xf4$<hQ5d/\xb8\xf13\xca9\xadS\xb0\x1b\x81\xc0\x18\xbf\xd2\xd4\u007fC\xd9\x0f\xcc}i\xbe\xdf\x12\x98\xa3\x8a\x92B$\xee\xd8\xffeN\xab\x1a\x93R<g\xad\x8a_\bJ\x01\xe6\x90\xe0\\$U\xea\xd7\xf4皎)\xfd~\xc97E\xff\x86l\xbf\xeen\x87\xc7\xc9t\xb7\x8e@m\x15\tК\x84X\xc8kQ\xca%\x11g_\xe1\xa2@\x8d\xc4\x1f{\xfb\xbcr\x97\xc8C\x19/1\x98\x9a^\xa2\x0f\x89.\xb0elo8u\xcb\xd7)\xea\x04=(\x9e\xf38\xd7]\xa8\xc6a֍D\x18\xde\xf1N\xcd\xe9s\xcc\t\x02\x1f\xa6\",\x94q\"\x8c+\xe6\xf3x\x8f\xa5\xa7%f\x1d\xf7\xf3\n\xcbϖ\xd2dܿ\xb2=CS\xfd\xa3ſ!\xc3\xd0W\xf0\x80\x8a?9\xeb\xd1\xf7\x89\u007f\xe6\xcb4E\xe8\xb1\xf09\u008b\xb0\x1eĳ\xb6\x13\xd8\xfd\x8b\xc0ā\x9cӆ\xa3\x86\xffB\xb0[\xd8\xc8\xf3\u0558\xae\x02\rB\x15\n\x0e3\x82\x00\xaf\x10,\xee\xfb\x06\xcfao\xf5\vl\xcb\xe7\x95i\xe8l\xc7g\x10\xb8[m\x8e\x06\x91\xaa\xe3Z4y\xf1\xdb4\x16\xc0Y\xda\xf0J\xa5\xc9\x1f\xe8\x155_ݕ\xb0\u074bǊ\x98\xde\r\xb8Ȯ\xa9\xf9W\xb6\x92R\xbel\xa5N\x845+\xa5\x00^LZ\xbd\xff!p\xf7\x1e\x95p\xc9.\x9e\r\xb8\x12\xaaIUMڄ \x91%\xfc\x86\v^\xa5 \n\xc3/\xb2e\xf1\xbc\xa7o \xef;Nk \x11Vu\xf8A\tsNk_\xa1\x9d\x8f\x18\xa3\f|x\x0fC\xa9\x82\u007fລ\x8cÆ\x9f\xbd\a-59\x84\xed\xfb\x88\"\x9as\x04\x05\xaa\xff\x13\x04\x8c\xbe]q\xc5|ie\xbf-,yz\xf2\x1e\t\xcd!Q>m\x80\xfd\xa6\xb2t\xba&\xcey\xba\xa6\x0e\xd5\xf8y\x10\x16\t\xf1\xbf%\xac\xc0]K\xe0%\x05\x19\xa1\xf9\x0f[\x9c\xae\x9d\xf1\x99\x18\xa2J\xc3\xc2MԹ394mv\x1cN\xcc6z\xae\xf5\x10\u007f\x9d+rS\x8d&\x9bzU\xb5\xbf\xa9i\xbb\xaai\xbb\xaaiki\x8an)ڭ*ڭ*\xdaيnG/YU\x95\xac\xaaJlU\xc9M\xaf\xf6\xab\xaa\xf6\xab\xaa\xf6#U\xc9-U\x87UU\x87UU\a[UttU\x8d\xb9\xf6?L\xeaA\xc0»_iL\x8b\xf7\xb2\xedz0=\x80\u007fIA\x973J\xe4\a\xe2Q\"\x1c\xcc\xc5M\xbf\x1b*}\xbe8Ԩ\x8b\xa4K\xd5E\x1a\x97\u007f\x14\r\xf5\xff\u007fW\xe1\x00\x85%A\xb7\x83\x96Ȕ]\fZ\v\x9b\xff\b@\xc6E\xb7\x849#\x16\a\xf2X\x15\x9f\x98\x988\xc5N@\xc4)\xd7\r%\xd1!\xb9O\xf0\xf6\x0e\xc1#\xe4\xd8\xdfi\xf1\xee\x0e\xc1\xbb%\x8b\xff\xddp\x86\xd1Z\x80\a\x8e\xd5\xf8&\xf1aE\xe8<\xbc3\xa9\xee\xe8\xde+v{[\xac\x1d\xdb\xd3\xe9*\xf5/\xccP\xce)\xbb\xfcK\xa4\xe8J \xa6\x8c\xab\xf1\xd8\xda\xf9\xb6\xa4b\x1e\x96%\x1d\xee\xe8\x1cíVR@\x0e\xff\xea\xeb\xd6\x1e\x9b<Ը\x99\xd5ڨ\xed\x0f\xc3p\xa1\xfc\x1ed\xc7V\xc2\x1a\x93K\nj\xdaЮ\x85\xb9\xec\xca\x1e\xd1{K(CL\x17\xfb\x87\xfa\xeb\x13x\xa1=OA\x89\xdfQ!\x8d\x9a0\xca\xc6\xf4zI\x82=\xa7n6wD\xa3D6o\xba\xb3\x1aM\x85R\x10\xc7\xed;\bA\xa2\xa7\x15\x9a)P\x9d\x9c\xd5\x17rڦ 2\xddW\x87$l\xb9\xa7!\xc7p$\xebY\xb6rz\xae\xfa\f\xcc\xd6\xe7\xa1\xc3Ío,\x1d:\x19\xc35\xcc\\\xaf\xadd\xa8\x87\xaeW\xa6\xa0F\x9c\xe1\xdcmM|t3\a-\xa3/\fuf:4|\x90\x82\xdd\xf6\x908\xc6dC\xab\x1f\x1d\xc4s\xfe\xe5\x11²\x05\xc3\u007f~\x8d\xf8pF\xceY\xf9\xf6\x14E\xbf$,\xa8Q\x81\xfbza*\xb0\xdf\x1e\xc3\xd2\x0e\xf8myD\x9e\x9aKX\x12\x85Q\xf8K¦ﾨB\xed\xfe\xe8&\xa2\xee<\xd7\xeb\x8e\xfc\x97@\x8e\xfe\b\xbfl\x80\x9f\x84_\xbe\x9e\uf7e0\xcf-T\x15\xa4G\xba\xd3!\x83u_g\x94\x98[\xf8\xe8Ƥ&\x1ef\xc9\xe4\x9eZ\xfd\U001028af[\x9f\xc3\xecC\x983\x9f\x92J#\t\x85<\x95^\xab\x85i\a c\x82\x1b4 S4\xf3.\xb0\x13w:,\x1a\x97\x91\x04$i\x96p\v\xe2\xe6\n\rT\xff\xb2\xc2\x10\x81\x1c\xbf\"\xc1Y\xf6\x84t9C\xa8\xf1i+\xa8\x8a\xf7\xa7\x8f\x9b\x02\xbd\xa7`\xbbP-o\x15\xe6z\xb8\xa0͎\a\v\x99|\x8f\x92\xe17\r\x011\xa1y\xcb{։\b\xb5\x147\x1c\xb1\x05\xf9\xb8\xa9\x10\xc3\xdc\xfb\x04^\x00\xb3\x8e\x92\x9e#\u007ff\xac\xc01\xe3\x150l\xf2`$\xe6\xde\xd80\xe0\xf2,`\xcf \xb8n\x9dD$>\xab\x83\x1e]\xa1GHc-͔Y\x01\xd1/\xaa\x1c\xe4ۧ\a\x80\x174\x94\xfb\xa5\f\x85\xb9o\xbb$Y\x91\x06I\xf8Ŋ\xb4|\x93N\xe1.\xa7M\x83\xf2k+|\xd20\xec=\xb6\b1\xbf\xe8\x19\xd4a\x99\x1e\x8b\xfcP\v\x1eܮQ;5\xe8Z\xa43\x94#\xfc\xaa~\xb5\x9a\xf3x\x81BQ\xf0\xf1\x8a;\x9ca\x82\xf9e@\xd6O\xef\xbf\x01\x00\x00\xff\xff\xfdH\xe3\x99o\x1c\x00\x00",
-		hash:  "a1dc72781e71734520dacd5105e7916d11a2f7ca8bc1bddc5844976f9ff7b2da",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xacYݎ\xa3:\x12\xben\x9e\xa2V\xa3\x91\xce\xcc\x06\x1aH\xc8\x0f\xe8\xf4\xc5\xea\xec\xbe\xc4\xd1\\\x180\x8d5\x06#c\xba\x93\x89\xfa\xddW6?1`HZs\xc4E7\xaer\xfd|\xae*ʕ\xe7\xef\xc0q\x8dE\rߟ-+f\xe9\x05\xae`\x01\x00\xc4(\xf9\xf9\xcaYS\xa6v\xc2(\xe3!|\xf1]\xf9D\x8a\x9c\xb1R\xd85\xf9\x85C\xf0\xf6\xd59\xb2>,\v\xc1\x15z\xdemr8!?\x02\xc1QY\x13AX\x19\x02F5\x06\xd7\xf1\x82\xbae\x8b\xe0\xc3Ba\xce\xde0\xd7v\xe2c\x1c\xf8\x9e\xa4Y\xb9\xb7\x81\xdc\xdf@\xbe\xdd@\xbe\xdb@\x1el \xdfo\x80\xa2\x18\xd3\rt\xe6\xf6\x1bO\xdbSp:ʍ\xb9\aש\x95[_Z9,\xbec\xf2\x9a\x8b\x10J\xc6\vD[\x82\xc0ga+\x833Ƌ\x10\x9a\xaa\xc2<A5\x96\xde\xe5\x1e\xd4\x05\xa2\xb4\x93<Qj\xc6\xc4$\xb4d\xa5\x92g9\x1c\x95?m\x8eSͅt\xe7\x1f}\xe5BK}eT'g\x19®\xab\x919ƥF\xf7c\x14\xf8\xb1\x82Ή\x1b!X\xb9\x81\xfe/)\xabF\xfc-.\x15\xfe\xb3n₈\x1fp]<\xe8\xe3\xee\x14d\xd1\xc8\xd1}rJ\xe2\xf4\x01\xa0:\xcd\xed\xb9n`\xfc6\xb7b8\x7f\x83\x1d;\xef\x10\xa3\x9d\xf2\xb7\x15\xe3 \x8a\xb903\xfb\xa9\x97yYd\b\x87\xd1\xe65}\a\xdf?\xf8\x87H\xc3;S`jVK\xd7\x7f\x8c\xfd\xc0\x88'\xf9x\r\x17\x88\xd0\xf1R\x85\xea\xfa\x9d\xf1\xf4\xc7\x06jLq\"6\nF\xc41\xea\x0e\xa2BiJ\xcaW\x9b\xe2L\x84\xe0q\\D\x93\x03\x92^f\xf2\xe9\b\x8c\xa7\x98\xf7!u[\xb19JIS\x87p\xec\x830fg\xbb\xceQ\xca\xdeu\xee\xdeM\x84\x90<\xb8\xa9\x97aƒ\xa66\xf9j\xa0\xb4\x1e\x1b\b\x83\xdf=\xad\xf5\xbe\x7f\xeb1h\xdfg!)+I&\x9fe\x8f\xa7\x9e}X\xaa<t\xa2\n\xc4_Ii\xc7L\bV\x84\xb0\xab\xce\v\xb9\xfaa\x85\xa1\xfd\x8e\xe3\x9fD\xd8\xca|\xbb\xa2(\xc19\xa3\xa9\fܱ\xff˜Z6\x06\x99|\xa2N\x95\xb8P\x1c\x02\x11\x88\x92D\x06Uh\x17\xecך\x8e)\xfdq\xc9wE\xff\x86l\xbb\xa8\xef\xc3cdzX\x87\xd3n\x95\x01P\xc1U;\xf7y\\\xcbTΨ<\xfb\x9c\xa4).#k\xb2}\x9e\xb9K\xe4!\x8d\x97\x18\xfa\x9c^\xa2\x0f\x81\x0eש\xbd\xee\xd4-\xbb\vQc\xd1C\xf2\x89Ʊn\xaaj\x02\xc5\xf5HD\xcf;\xde\xd9qڂ\b\x8a\xe1\xdag\x84Ve\x8c\x15Ƅ\xf9\x1c\xef\xb1\xf40#\xbc\x16v\x92\x13\x9a\x0e\x9az\xf7ol/\xa8\xcf\xfe\xd1\xe2߈\x13d\xb7\xe5\x01\xa7\x7f\n\xde\xe0\x1f\x13\xff\xfa/ӴB\x8f\x85\xcf+\xbc\x84\xf5 \x9f\xb5\x9d\xa0\xf7/\xb2&\x0e䄕\x02\x97\xe2\x13`W\xa8T\xe7\xdb\xd5\xf4\x16hp[(\x04\x8a)\x06\x91c\x94>\xf6\r\x9e\x97\xbd\xd5/\xb0._\xe4}C\xa7;>+\x81\xbb\xd5\xe6h\x10\xd9v\\\x8b&/~\x9b\xc6\x02\x04\x0fK\x91\xb7a\xf2\a~\xc3\xe57s&l\xf7\xf2\x89`\xba\x1b\x84\x8c\xae\xa9\xf97\xb6\x8c1\xb1le\x17\bkV*\x01\"\x85\xeb\xef\x02\xf7\xe8QI\x97\xf4\xe4\xd9\xc0\x8d\x90O\xb2j\xd2&8\x81J\xe1w\x92\x8a<\x04\xcfu\xbf\xaa\x96Ų\x9e\xbfC\xd2Ԃ\x15\xa0*l\xdb\xe1;\x19J\x04+\xec\xb6\xda٘s\xc6\xe1j=\r\xa9\n\xff\"EŸ@\xa5\x88\xac\xa7Njpp\xab\xf3\x88\"\x9bs\x8cR\xcc\xe1\xdf\xe0p\xf6\x0e\xd7\xe9\x97V\xf5\xdbҒ\xe7g\xeb\ve\t\xa2\xadO\x1b\xd0\xdf\xda(\x9d\xae\xc9s\x9e\xae\xb5\x87\xda\xfby8\xb8\xad\xf8\xdf\x12\x96\x92\xba\xa2\xe8\x12BLY\xf2S\x17\xd7\xe5\xce\xf8Lzb\x1b\x86\xa9\x99\xd8\xc5\xce\xe4\xd0:\xb3}wbv\xaf\xe7\x96\x0f\xfe\xb7\xb9\"3\xb5פSo\xaa\xf6w5mW5mW5m5M\xde=E\xbbUE\xbbUE;]\xd1}\xf4\x82UU\xc1\xaa\xaa@W\x15\xdc\xf5j\xbf\xaaj\xbf\xaaj?R\x15\xdcSuXUuXUu\xd0UyGS\xd6\xf4\xd7\xfe\xa7I>Ȳp\xb6\xf3\xae\xa6\xf9{\xb9\xd9z\xea{\x00\xfb\x12B\x9dpF\xd5\a⋪p(\x917\xfdz\xc8\xf4\xf9␣&R\x97\xaa&\xd28\xfd=o\xc8\xff\x7f\\\x85\xa1(,\t\xba\x0fZ\xe0\xbak\xa0U\xa8\xfc\x9f,\xc8$\xad\x97jΈ\xc5Py\xb4\x8c\x0fzL\x8cb'E\xc4(\xd7\\J\xbcC\xf0\x98\xe0\xed\x03\x82G\x95c\xff\xa0Ż\a\x04\xef\x96,\xfeo)8\xc1k\x00\x0f\x1c\xab\xf8\x06\xfeaE\xe8\x1cޙT3\xba\x8f\x8a\xdd\xde\x17\xabc{:ݤ\xfeE8N\x04\xe3\x97\xff\xc8\x10]\x01bʸ\x8a\xc7V\x8f\xb7%\x15sX\x96t\x98\xd19\xba\xdbNI\x8a\x04\xfa\xab)*}l\xf2T\x90r\x96k\xa3\xb6\xdfu݅\xf4{R\x1d[\x86\nB/!\x14\xacdu\x85\x12Օ}\xc1\xe7\x8a2\x8ey\x97\xec\xd7\xf6\xafMх5\"\x84\x8c\x9cq\x1a\xc1\x9cQ5\xa6\xb7K\x12j\x043\xb3\x99\x11\xf5\x02ռu\x9d\xd5h*\x14\x82\xefWgp!\xe8\xa6\x15\x1d\x93\xd3vrZ_(X\x15\x82\xd7w_5Ve\xcb<\r9\xba#Y/\xaa\x95\xeb\xe6\xaa/\xd0o}\x19:<Rڽ\xa5C'\xd3s\xe5\xdeH\x87l%\xddn\xe8zcr\n,8I\xcc\xd6\xf8G3\xb3Sq\xf6\xcaq\xddO\x87\x86\x0f\x92\xb3\xdb\x1e\x02Ølh\xf5\xbd\x83|\xa2O\x8f\x10\x96-\x18\xfe\xb3\v,\x8632\xceʷ'\xcf\xfb\x940\xa7\xc0)i\x8a\x85\xa9\xc0~{t\xb3\b>#\x8f\xb2\xf7\x05a\x81\xe7z\ue9c4M\xdfm\x99\x85\x9d\xfb\xa3\x9b\x88ZѮ;\xea_\x8a\x04\xfe\xc3\xfd\xba\x01;p\xbf~\x8b\x1e\x9f\xa0\xcf-l3\xa8\x1b\xe9N\x87\f\xda}\x9d3\xda\xdf\xc2G7\xa6v\xe2\xd1/\xf5\xb1\u05ee~XN\xda\x14\x95-P|\x05\x00\x98OI\x95\x91\x94!\x11*\xafۅi\a\xa00!%\x1e*\x937\xf3\xce\xd1\x03w:,\x1a\xa7\x91*H\xca,\xe9\x16\"\xe5\xad4\xb0\xee\x97\x15\x8e)\x12\xe4\rKά\xa1\xb4N8ƥ\xcd*Imy\x7f٤L\xf19\x84\xedB\xb6\xbc\xe7DtÅ\xcel\x7f\xb0\x90\xabw/\x18~Ӑ%\xc6\xedߒ\x86\xd7\x12\xa1\x8a\x91R`\xbe \x9f\x949\xe6DX\x1f`9(\xae\x19m\x04\xb6g\xc6\xc2\xf5\xe6\x15\xf4l\xea`Tͽ\xb3a\xa8\xcb3\xc0^\xc0\xb9m\x9d \xe2G\xedA\x8f\xaeУJ\xa3-͔i\x80t/m:\xa8\xb7\x0f\v\xc0rJ&\xecLA\xd1߷M\x924\xa4!p\xbfjH\xab7\xe5\x14\xa9\x13V\x968\xb9\xb5§\xae\f[_*\x8c\xb9\x9d6\x1cu\xb0L\x8f\x05>:\x1eR\xadQ\xebvеH\xe78\xc1\xe4\r\xa7f\x1e\xcbi\xab(\\\xdfHMbB\x89\xb8\f\x95UZ\x89\x1a\x913N\xc4Ş\xcct\xcc*\xa7\xecN?\x1e\x84\xebx\xe2\x123\xaaN\xde\xd1vpִ_\xef>\x85\x93\xbd\x9by\xdb\t[\x92#.F\x97̯\x91~\xf2\x12_C\r͎\xf2\x91\xb2\xfe?\x00\x8c=@\bD\x1d\x00\x00",
+		hash:  "176eea4c029ae94770d1f0890acbcbd16d6a4d99f9fc2e08f97c8b25d472c212",
 		mime:  "text/css; charset=utf-8",
-		mtime: time.Unix(1539243717, 0),
-		size:  7279,
+		mtime: time.Unix(1792404711, 0),
+		size:  7492,
 	},
 	"css/font-awesome.min.css": {
 		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xcc}M\x8f\xe4\xb8\xd1\xe6ݿ\"w\x06\xde\xe96J5%eV~T\xc1\xeb\xd9\x0f\x180`c\x0f\xf6a\x0f{\xa1\xa8P\x8a\x9d\x14\xa9!\xa9\xac\xcan\xf4\u007f\u007f!\x89AQYAy^`\x0e\xafa\xd8\xd5\xe4C\x8a\x1f\xc1`0\xe2!\xf3\xe7?\xfd\xb7?l\xfe\xb4\xd9\xfcU+\xb7\xf9\x9fo`u\v\x9b\xdd\xe3\xfeq\xbb)o\x9b_*v\x853S\xd5m\x93m\x1a纗\x9f\u007f\xae\xb5rl\x02>\n\xbd\xc96\xbfD)c]\u007f\x17\x1c\x94\x85T\x91\x9f\xa5\xcf\xff4|\xf4e\xf3Ͽ\xfd}\xf3\u007f\xff\xfa\xf7M\xfe\x98?l\xfe\xf7?\xff\xf9\xb2\xf9\xc7\xdf\xfe\x85\x95|\xfe\xc3\xe6O?\x8f_\xc8j\xc6\xe1\x9b\xff\xab\x15\xf2\xf6\xf2\xd3P\u07b7\xf9\xa7Wk\xf8Ko䧟\x1e\x1f\xc7\x0f\xda\xf8\xb3\xd9\x1b\x94\xc3?\x1fA\xbb\xbf\\\xff<v\xf0\xa7\xcf\xff\x892?\n\xa8\xc5\xfb\u007f\x0fE7\xb56-s\x9f~\x82\xb6\x84\xaa\x82*\xd3\x1d(w\xeb\xe0\xa7\xcf\x0f\xff\xbe\xca7]\xd7\xc5_>\xd66\xa6\xff\xe6\x1a\x12\x15\xfc\xa6\xf2\xceQŝ\xe9\xe17w\xc2^\xcfXŏQ\xbe\x81s/\x99\x89j\xb5\xd7\xf3O\x9f_ǩ{\x03qn܋\x1ar\xe4\x94d\xddM\x82O\xf9\xfeX\xb3o\x95\xb0\x9dd\xb7\x17\xa1\xa4P\x90\x95R\xf3\xcb\b\xf5\xa0\xcd\xf2\xff\xf2]\xf7\xfes\xbe\x89\x84\xc1\xd7+\xbe\u008bP\r\x18\xe1^\x1d\xbc\xbb̀\xaa\xc0\bu~a\xbdӯCG.\xc2e\x13\xba\xd5\xda5c\x9er\x82I\xc1,T\xafY\xab\xbffھ\xdfcΆ\xdd,g\x12\x86\x06g\xf2\xfcm\xfeb\xfe\xb8\xf5\xff\x81\xf6u\xec@3\xf5\xf9\xf1\xf0\f\xed\xeb\x15\x8c\x13\x9cɌIqV/Y\xfe\xfcǱ\x8e\xe2=\xaa\xa3\x80vL\xdcƉ[\x9f\xb8\x8b\x13w>\xf19N|\xf6\x89\xf5۷7Q\xb9\xe6%\u007f,\x8eχ|W\x9c\xa0\x9d\x86b\xfa:\a\xe5\xc0\x8c\xd8^~\xebXU\tu\xce$\xd4\xee\xe5\xe9\xb5e\xe6,\xd4\xf4\xaf\xe21\xdfMU\x8c\x9d\xb2~ֲAV^\x94V\xe0\xeb\xf8\x1fR|\xeb\xb4\x15Nh\xf5b@2'\xae~\x8c\xa2\fVZ-{\a\xafc\xdd٢\xf2\xa9\xc1\x8b$\xa7\xbb\x97ſ\xc9\x0eH\xe1\xa7b\xaa4\u007f\x9cz|\xdc\xfb\xc1(\xb5\xa9\xc0`'_\x1e\vh7\x8f\xc5\xf3\xf0\xbf\xf901S\xfe\x8b\xd5RT\x9bǧ#\xb4\x9b\x1f\x01\xc0\xa7g\x86U\xa2\xb7/\x8f\xb9\xaf\xae\xeb\xa5\x1c\xc7\xe6[-5s/ßs\x86\x19f\xdc\xe7\x8c\u007f\x0fY\xcbb~x\xcd$\x1b~r\xef*\x88\xa7`\x82\xa4jO5\xe7\xf1\xdf}p\xf5k5\xcbl'\xd47\\)L\x89\x96\x8dS\xe8s6\x85\xdd\bU\v%\x1cl\x06ag\xe6\xf5\xb7\x80p\xa4,\xacԝGŬ\x83\xce~:~~\xfdm\xb0\xef\xbf`\xb5\x17\xb8Ն\xb5`7ؙ\xa7?\x86o:Ô\x1d\xb4ԋю9\xf8\xf4T\xc1\xf9\xf3+\x9d\xfc=\u007fZ+\xba}>х}\xc6\xf7\xef\xbf\xfc\x17j\xcb0\xfeSZvz\xfa\x96\xb56\xab\x85t`^~\xe8\x8c>\x8b\xea\xe5\xff\xfc\xbf\xbf\xb5\xec\f\xff\xc2\x1a\x1e\xff!\xb8\xd1V\xd7\xee\xf1\u007f1+\xf8\x98\xfbi\xacBh\xf5\xe7\xfc\xf3\x0f\xaf\xc9朦\xae\f_I\xe5%\xd2\xe3v\xe6\xc7ߣ\xa1\xc5ZC\xf3\xe3JK13\x95\x11\xb7\xb58\xfc\x1emݮ\xb5\xb58\xac\xb4\x153S\x19\xd3\xf6 E\x975ڈ\xaf\xc3\xe6-\u007f\x87\x06?=lZa\x8c6\ty\x18\xb7\xccOY\xfe\xb0\xc9\xef\x1b\xbeȢ\x93\xe7F\xe3&\xfa{\xc8\xc3ojr\xfe\xb0\xc9\x12M\xf6Yt\xf2\xf7\x17\xa3\xb5\xdb,V\xdbÇ\xb4\xfcH$\x16\x878\xf1n\xae\xees\u0080\xf8\xc1\b[\xb2u\x8c_>nɯ\xa4\x8d\xe5\xf7^h_\xbd\xc9Rܙ0\xc5G\x03\xa6\x15U%\xa3oe\xf9\xfb\xc3\xfc\x8f\xe2=\xb5\xeb?\xf9\xaf\r\x8a,\xb1\xa3c}\xdf\xe2&x\x83\xee\xfb\xe2\x1b\x1f-'\xa1\xae`,|\xe3Zj\xf3\xf2c]\xd7c\xf2Y2k_J\xa8\xb5\x19\xf2\x94\x03\xe5^~\xf8\xff\xf5\xd3\xd3\xd3\x0f#\xa0\xed\xad\xe0$ \x9f\x00\x16\x98\xe1\r\x89(&\x04\xa8+H\xddA\xa6I\xd4vB5\xc0\x8c#\x01\xbb\x1f\xb0s\x86\xcc\u007f\x9e\xf3\x13\x9f\xd8O\x88\xde\x02]\xc3aʯ\x85l\xc9\xfc\xe3\x94\xef\x9aL2s\x06\x12sB\f\x99\xcb\xe6\x1a\x84\xa5\xbbYN\x10\xde\x00\xbf\x90\x00>\x01\f\xb4\xfa\x8am\x18E\x8bKm\x17\tN\xb4@\xcfj\x15OZ\xd6ɞ\x86\xc1\x02\xd6\nE\xe3r/$\x9d~\x03\x93\xe9\xba&A((⬘$\x11^P\xce\x10fx\xea\x97>\x93p/1\xce0\xdb\xd03\x9e{\x99itK\xceV\xfe\x1cf<!\x96\xb9\x97\x19>(\x82\x04ċ\x8dѬ\"\xf3\xbd\xd8T\xfaM\xc9\x14Ƌ\r3F\xbfe\\\x18>4h,B\xc2\x19\t\xef;\x12\xec%J\xa8R\xbf\x93\x00/Q\x83\xe2\v\x95\x91\xc0\xea\x87x3\x9f,\xe6h\xa2\ft\xc0H\xa9\xce\x01\x85\xb66`ɵQx\xf9\x18OQL\x92\xd5\x14^B\x86\xc9 \xf3\xbdHԒ\x91\"S삒\xa9\xbaF+zu\x14^*\xaeZ\xf6-\xa4\xe4\xb9\xd8/P\xa9\xa9*\x0e\v\x18=E\x85\x17\x91_\r\xd7\x15)\xa9\x85\x17\x90\x92\xa5!\xa8\\\x12}/C6\xddk/\x05\xa5\xd6\xf4\xd8Vs~\xcb\f\x8d\xf1\xd3\xdc\x19\xa1\xe8\t\xac\xfdrb-\x18F!\xb6^\x97\x8c~\r*?\xc7fHr%m\xbd\x88\b\xc7$\xbdcmQo\f\x9b\xab\xdf=)\xd8.\x82M{2\x85\xf2\xb22n\xd2\xd3\x11\x91B\xedcԴ\x95\x93\xb8C\x8c3ɦ\x1dcؗ\xde:Q\xdfH\xe0i^Sd\xbe\x17\x99\n*P\x8b\xa5\xac{\x17%-\v\x05}\x92DxQ\xba\x8a\n\xf4\xda\\{\x91\xea\x1a\xedt\xfcu1آqB'\xb8\xebMB-mQ\xea@qA\xee,;\xb4bX\x97\r\xb2K\x8f\xfe\u038b\x16\xab\x86!%\x11^\xb8\\B\xbcw^\xb4\xa0\x12\x8bќZ\x96\xd9_{\x96\xea\xc4\x0e͛f\x80\xac\"\x9f#\va\x1d\xb9\x8f\xb6\tr\xd1\xef\x0eh4A\x97\x95\x8c_ޘ!\x97\xd5\u038b\\ͬ[\a\x06E\xb5\x82a\xf3\x86C\xe6{\t\xebXoIU\xb7\xe3\xd8lM\xea\xd3]\x85J\xc4$\xdb\x00Q\x87Vp\xcfO\xd1\b\xad\xe1\xbc\xf0\xc0\x17\xe0\xa4l<\x17aڮF\xa7u\xc5\xf3v\x89Kj\x81\xe7\x1d\x8ebo\xfd\xb6M¼\xb8\x8c\xd6\xdb\x1an\x8f\xb2\xdd\xc2*\xee\x10\x8b\xdf\n\x0e\xf7\xb4\x1e\xecp\xceY\x83\x9eP\xa3\xd4z\r\xe6\xc5f8\xb6چ\tC\x8a\xf4s\xf9\xb1\x1f\xf4\xf2x\xe6\x1f{\x92@\xe2\xde\xc7\xc8=\xfe\x19bs,5\xb1\xfb\xa7\x18\x95\x9c\xd6}\x1e\xc3h{a_Ę\x94\xe9\xb1ߢ\xd6\x13\xf2Nt\x1f\x82\xaa!\vzɂ\xf7\x8e)R\xda\xf7\xa8\x83t\xdb\x19\xa0\x8f\x8e\xfb\xfd,\x9fd\xfe!\x12L\x12\x80ۜu`\x84%-\x8e\xfd\t\x9b\xca%\x9b\x1c\xa0+\x12\xb4\xf7\x12t\x16\x89)\xf2\xb2#\x81\x916ߞ\xe3y!1p^N\xe0FgC\xc8άd\xb4\x1d|\xf0b\xf2ƌ\x12\xea\x1cOX\xdcIg\x04Sg\xba\x9b\x87<\xe8WE\x03P\x171\t\xaa\xa2\xcf\xd3\a/=\x86\xa9J\x93\xe7\xe1\xc3.\bA\x9b\xb0\x04\x0e\xa8|\xd8Y\x01\x8d\xd8/\xd5\x1d-\xf0\x87\xc3\x12\x95\x12\xf9\xc3\x11\xcf\x19\xee\r\x12\x1f<\xe1F\xab\xbbN\xa8s\xc6\x13\x0e\x87\x03\xc3MDV\xb4\xc1p(c\xc4\x18\xe9$a<ކ\xb3+\x89\xa9\x16\x18Z2 \x9c\x012\xde0㲅\xd1\x14\x92\xa9\xb2G/U\xeeM8\aƛ\r$2Ǎ\x91\xc3`\xeb\xafA\x8bؚ\x1f\x06ݐ\xea\xf3\xe8E\xe9\x02\xe4v\u007f\xdc\xcd\xe7~{w\xf0'\x15\xc3\xf1y!x4\x06\xb7\xb3\xa6oK\x9b<\x1c\x1f\x0fw\xb0\x94d\x1d\x8f\x91{\xa9a\x92\xd4\x0f\xc7S\xe4Ģ\xb7\x92#\x9b\xdd \x83\x95MbP\r\tu\x81J\xa8\xb5)\xe0Q\xfb3\xc7\xe8s\xf1\x11\xf5һ\x03\xa3\x98\x1ck&\x81\x10\xb5N\x90\x03qBI2\xbak\xc8\xf9<\xe5\xa8e]ӗ+\x8d?y\xf9黔_\xe4\xb4E\x85\xdcjE\x0f\xe8i\x17\x0e\x11\xb4\xb2;\xa1cp\xc5R>헇\xdb\x04\xea\x10}j\xad_\xc7\xc5b#!\xa7\xbbUVǂ\x8f\xa9dI\x16\x8f.\x89\xf0\xe2ӫ\x94\x9f䄖\x8f\x19N*\x83\x06\xa4G\x1f\xcdh\x80\x85\xe1`\xe8\r\xff䅧\xa9*z\xfc\x98\x17\x9d\xb2\x97\xb2ц\x14/\x86\xe7{\x90\xe4Q\xaeF\xf3\x18\x8c\x13\xb5\xe0̑S\xc0Н\xccT\x95鴵\xc5v\v\\\xcavc\xcf\v\x18\xadK\xd8~\x01Ji\x12v \x9cw\xc9\x0f\x1f\tp\xba7\x94\x1b1\xd1Xʅ\x98l\xb2\x97\xa8\xb3\xd4%=\xdc^\xa0\xde\f(:\n\xc0*t?\xd9\v)=\f\x82/6\xb1fJ\x14\x1f#\xa0\xe6\x8c>\x1a\x96\xf9b#M8\x12Kt5\x1b\x1d\x86\xe7\x01c\x03d\xf3\xf8\x13Z ,\xe8\xc5\aT\xd2d\x81<x\x8e{ru\xf1\"8+iۖ\xa3\xa8\xf7\vg\x82\xe5\xc2Z\x9dhe0ɺ\xdbB\xa1\b\t\x96^\x94\xfc\x19\x0f\xdb\x1d\x18.\x05)-܋\xb6eːC-u\xd7\xdd\x12\x15\x1fb\xbdK\"\xbcp+v\x15\\\xab\xa5+y\xa2\x9d,\xed\x1b\xbaϑ\x93+\xebI\xa5\xc1Y\x04\xd14\xa4\xc4\xfd݈\v\xb8\xc6\xe8\xfeL\n2\xe7\xa8]+0R\xd0{\x0e\x0f\xd2^\xd2&:\x87`\x18\xd3^\xca*l\xb3=\xad\xc3+4\xf2\x85r`\x80vXU\xc5\x1dhe6*/ng\xad\xcf\x12&\xb7\xc2\nz\xf7\x01M\xc2\xf0\x00\xa0\x15m\xfcUh\xff3\x03.\xa9\x80\xaaC\x8c\xa25Zu\x8c1)\x85Z\x9dbTR\x93V\xe8kвo\x15ݵ\xb0\xcdZm\xdcr\x87\x1cR\xc82\xe8\xb8\xd2f\xd1ه9\x11,-\x0fUTr\xa9\xb3\xc6$\x96(\x06\xcb\xe0+\x85\x81\xa7\xa5\xb9Ib\xf2E\xe8'\x1a\xdd\a\xbf\x18H\x1d\x00\x18\xb0\x81s\x88\xf9\x8d\x05\xce\xec\n\xe4:\x04/\x85\x15\xb3M\xa9\xef<\x16\x8e\xf1F\xb7\x90\xd8\x1f`y\x1e\xa5\xb5\x12ܝ\x1d\x12\xa8\xfd\xac\x9b\x9b\x85\x12\xd2\xf4\x86\x02\xa8섃\x96\x91\xf2\t^>\xfb\xb64 %\xe9\x11\x87\x13*c\xeb\xeeb\xbb\xa2\x8b\x87cY*\xa8\xb7s\xe3\xca^\x96\x89N\x95\xc1W\xd20Eǯ\x81G\xbb\xd6j\xc8\x12\xaa\x18\x996\xe1\x01\xe6\xa8{֒\x90z\xf6\xb1\xbaF[\x9e\x90\xd3\x1a#Ƚp\xa9\xed\xbf.f\xf31a\x84\xe2\xa1U\xd75\xd0u\xec¾+\xc1\x90Z\xab\x8e\x03\xc7c\x84\x88\xfcV\x8dǊ^ȑBJ\xa3\x0e\x18\xa5\xb6\x9dp,\xd1\xee\x1a-\xc1\xb6\xec%S\x9cn\xba\x97\x9f\x16\xaa\x8b \x05\xb5F\xaf\xc6 +`\xb2/\xb4\x97\xa4.q\x1c\xe9\xb5V{9iV\xb6\x88\xba\x8a\x1c\xd4+0\xf4\x9e\xaa\xf3h\x82\xf6e\xda\x1eΑ\x8f\xb2\x00\xa7\xb4x\x8e\xe4\x94\x05\x9a\xdc=rd\xa9,\xa0\x89\xed(G\xb2\xca\x04N\xb6u\x17\xa3ҍ|\x8ea\x89\xd6헭K4\xeb\x80\xe1<{\xa1##9rX$\xebR\x88Sd\xba\xd0\rf\xb8\xa7\x97\x83\xf8\xc7\a\xef\x879\x9d,\x89䖴\xaf=G~˯\xbdv+c[Ũ\xf4آ?\xa3\x13J\x91\x92\x9c#y%\xe95Α\xb92\xfa\xd1\rt\xf2vGz\x907\xb2X\xb1p\x83\xd0'\x91\x1cY,\xe8C$1\xbb\x0f~\xc6\x04\x10]\x1dm\x8aВ#\x1b\xa56\xfa-UKP\"M\x02\x80.\b\xd6BGj\xfd\x1cI'\x17\xb8\x8d\xdbV\xa2\">\xf35\x12\x88*B\x8c\x81\x1a0@\u007f\x110^oZA3\x8c\xf2\x02皦P\xe4Ň\x99Θ\x94\x1ff;J\\\x16/\xee|\x84\x19\xb4\x9d[H˜U\xf7˚\xe7\x1cr \x90\xda\"5\x9f\x02\x00\xe3\x01\x97D\xeeB\x98\x8c\\\xe0\xc5\xf3<\bY\xadI&G\x8e\xec\x96^E\xe7ۇp\x00\xceJ\xa3/\xa4\xb7;G\xc2\v\xc6\xfdH\xccq\x0e\xf8\x91\xf9\x1f\xa3:$\f}\xa9}\a\xc6r#:r\x89!\xf3\xc5\xf6\xe5\nȋ\"\x18F\xd3\x03s$\xc0t\xfd\u05ef\x83\xda\x13\xc0i!£\x9d\x18f \xe1\x95̑\xe72\xa3\x92!\xa1\x1c9/\xb6\x11@\xb2^\xf2\xed]@\x87\x96!d\xbe\xd4\xc2@\x06\xefN\xa8s/lCw\x17\xf9/F\xf3\v\xbd\x0flCp\xe7\x9dW\xe4\x04m\xef\x82;\xebή|{ \xe1I\xed\x8e<\x98;<\xbd\x85\"\x17\xe6\x0e\x9c\xdaK\x91\x1aӸV>\x93\x00\xdcˬݒ\xf9\x18\xf5Q\xbc\xd1\xf4\bW\xb1\x9f6\xb5A \xbb\xa5쥴t`1G~\vH):+\xe8\xf8Q\x8e\xfc\x96\x80\xba\x92(/JƮ\x18m92]\"\xce \tC\x12\x95H\t\xd1nAOX\xf9\xe0\xfe#\x8e\x96r\xe4\xb4H\xb8\x82L\b\x03\xd2Y&LJ\x06v\xa7\x8fL\x1b\x12\xc7b\x02\xd2\x1a\xb0$\xe20kx\xfe\x91\x14D\xe2\xaa9\x1cO\x13\xb9s\xe4\xbb8}\xbe3!\x1ffw\b\x0ekrD\x90\r\xe3kY\xfa!\xee\xea\xa0G\x1ey2\xbe\x86\x0f\x1cһJ\x92k\x1f\xe94ЛE\x14\x14zr\xad!\xab\xe6\\ҭ\xda!GW\xca%\xf9\xb8\xb7\xa4\xc2EV\x8d\xe9;X\x18\xbfB\xd1\xdfGM\xa8\x96\xc6c[\xc6\xff\xbc\xc1bR\xbet\xa4a\x89\f\x1c\xd3\xcf\xceũ6\xfd!\x85\x8c\x10\xe5\xc8\xcdy[:]/\x864(\x90\x9eS\n\xc7\xf5\xd2\xf9]:N\x96`\xf3\xf1\x98\xcc/\xef\x8e\xcf$(\xf6\x951\xd95,\xe1\xe4ʟ\xab\x0fȄ\x1b-G\xaa\xce\x04mu\xafR\xae\xb3\x1c\xf9:14U-\x92vF\xac\xea[0\x82'\xeb-\bl\xb2\xe2\xed\">M\xaf)\xe4\xebxPj\xf1\"i\xe7\xa6{חk\xfa\x04\xa9;\x1eIB\xbc\x10\xbe\xcf\x1c\x95e\xfeq\xce_\xfb\xd2i٦\x04%0G\xe6NetG\xd3\xd8\xf3}\xf0\xe03~\xc9\xf4\x15L-i\v\x19i<BY\xc7Ά\xb5$(\x9c<\x04\xbf\x90k\x1a\xe9<\x8c6~\x90\xc8S\nW\xf6\xa9\xed\x0f\xa9:\x01\xb42V\xc8\xdaq}[J\xb2E\xc8ٙ\x10kU\xed\xf04\xa1\xce\xeb,\xb2\x1c)<\x11\x94\x96Bd\xf2D\xc0\x94\xa1\x87t\x9e\b\x9aT\xf4H\xe9a]G+\x13$\xf4\xbc\tU\xd14\xd7\x1c\xa9<LUF\vR\xa1\x1ff\xceEO\x8a\x17\xb2x*#ʲL4\x05\x15\xd1\xe5\xd6\xd1\x00\fr\xeaޤg'\x90u\fHI\xda8\x81\xa4\x03-\xa3\x9b\x82ܜd\xfe6x'\x9cXl\xe3gÜ\xe8\xe8e\x88T\x1d\xdb'|\a\xc7\x10\xef\xd1)D %\xf3F\\\xe9\xb6\x1d\xd0\xe4%U\vRq\xae\xe4\xc9\x1598o J\xba\x01\f9b\xca\xd0G\xd8c\xe0\x1f\x9fA\n\xfa\x96H~䱺Y\xf1\xa9\xe7Ǌ\xbc\xad\x93\x94\xf8#\x90\xf8\xd4b:-m\xb2\xfb\xd0̝A\x95\xac%G\vȭ\xba琳\xf3\xd6\x00H\xde0A*\"\xe4\xed\\E\vzE\x0f!y\xc7\xf5\xe6\"l\x93I\x11n\nL\x01\x1f\xd2\xfd\x9e#\xa5'\xf2,'Z\x8bq\xeb\x8eq\xc8l\xd3;G/\a\xa4\xf6XIS\xa8r\xe4\xf4\x84[\x8c+\x9dB\tԦJ\xb1cs\xa4\xf1\xe8\x0e\x14\xad\x91N\xe5\xbcK\t\xd7GN\x10\x1f\x0e_\xfaez%\xae`\xacp\xf4\x88q\\\x97\xc61\x93}\x88\xac\x9d\r\xabzO\x9f%\xc3W9\x92\x81n\xac\xd1\xf4XC\x1c\x11\xa6\x10\xc8\xff1PUdt\"G\xf6τX\x19c\x16\\m\xc3V\a}\xb7\xc6\xfb͑\r\x14\xa1I\x18\x9e\x02@\n.4\x19\xd1Α\nT\x893\xa9\x9d\x90\x05\xd4\t\xa8\xb2Nt`\xb2\x8e\x1cP\xe4\x01E\xc0\x84\v\x00I@\x95\xe9;ڱ\x89ğ/Z\xb7dd1G\xbe\x8fd\xea\xdc3ZQ!ͧf\xe4\x0e\x88$\x1f\x8cf\x91\x18<\x896\x82\xf6T!ͧc\xa4i\x86\x1c\x1f\xdbiz\x8e\x90\xdf\xc3\x13v)2{\x86|r\xfe\xca-F\xb6\x9aD\x14-/w\vȊ\x18\x96\xe1\xd21\xd0Vd\xb9\x8f\x00k\x15\xe1\x99\x0e\xf8-!\xc4%\x1aB\xbdӋ\x10\v\xaay\xb2P\xa0;\x94\xcb@\xfa\xbb \xe1,X\x1et\x1b\xca0=\x89;gy\x89v\x12\\\x05S\x8e\xe6#\xe7e8\xb2\xf5\xaaJQ\xa4\xf2\x12\x90\f\xe0XIG|s$f\x8dgɮJ\xb8ϑ\x8d5\xa2\x06Ŝ\x80\x15\x11\f\xde9\xc8\x04n\x1b\u007fT\xbf\x81\xe9\xb4HP\x0er$e\xd5>Z\xe6\xf4\x92\xb8=\xa5\xdf\xddp\x9bs\xc6\xcbp\x89\x9a\xe30\xf4W\xd1\x11\xa5\xbd\x99\x95(\xbf\x8fʏSA\xd5\xd0WB'\xca\x1f\xa2\xf2\xad\xbe\n\xaa\xfd\xd3-@\xba\xfc1*?\xc6#h\xd8\t\xad\tڗ\xce\xd9\x1cѠ9\xf89һ\xbe\xd8z|\x98\x81\xc4p<\x02Ԑ\x95\xba\xbf-i~Cb\xaf?&Zv]\x12\xd5l\xdfu3\xffg\x06\x9a\x84\xceD\xb2X\xb0\xf4\x94v$\x892G\xd6\xd8\xd2H2`\x85u\x912\xf3\xa9%ɰɑY\xb6\xbca\tmG_jɫ\x99ν\xa2\xc0\xaa9\xdaIf\xfb\x05s˸nK\xa1\x98\xd3w\xe7\xd3\xc9\x01ƉĆ\xf1\v\x98L\x01}\xb8\xab\xc2E]\xc5azJ\x8d6\xfc\x91\x8b\xf6\xeb\xafd\xee\x1e\xcf\r\xbca\x8b\xc9{\x03\xf1N\xb2\xa2r\xa4\xa5YP\vkj\xe4R&\xaf\xdf\xe4\xc8T\x1b\x8a-\x17LT\x90^\nH_k\x84u\x9a6\x8e\x03um\x12'\xd7$\xda^\xcew\xe1\xe9\b\x10\x92\xd5:f\xd8ٰ\x8e\x14\xc9\xc0K\x93\xa2\xa2\xa9\xb39r\xd0&/v\xc2\xc6A\x12Z\x00\xad\xc8\x1a\x84;\xe0-\xe9ބ\xe0a\xe3\x1cLV\xb2\x88\v4\xe9\xa5ޕ:\xa1ڑ\x80\xe6hC\x1aIf\xa5P\x9a\xf7\x92\xe6\xa4\xe60\x1fP\xc8%\x8f\xfc\xb2q\xd4R\xf7\xf0r$\x95\xb97\x91P\b\xc8)\xbb\x81$mL\xe4\x93\rkg\x92-\xba\xcf\f](5i\x16 s\x8c39\xf4\xd9\xd1\xf1,\b\xf2rK\x98\xa9\xc8\x19\xf3\xa4\xd17&\x13\x14\x13$\x8dq\x9e]\x85%\rZ$\x8dq\x9e\xb5\xe3\r\xc1\xc4-\x86\x1cic\x9cg\x95\xb0\\_iiG\xe6\x18\xe7\x19k\x814\x80\xc3\xdd\x03\xbe\xd2\xc7\xc0\x1e\xe3#\x97\x98\xf6\x03!\u007fl\xa4\xa9%㾁>\x16P\xf4\xf4!\x81l|\t\x85\x04\x1cgZx\xd2\xed\x80\xdc1F\xe7\xb2pw\xb12\xba\xeb\x12\x83\x18<'\x835T\x9a>\xd1\x1e<I\b㚊\xdd2\xce.\xf48\x05\xd7\t\xb0\xe4\xe5\xb6\x1c\x19d\x9d\x80$\xa8x\x9aY\xaek(|\x85\x84YW\xb7$\xa2\x88\x11i-U I\xcc\xfbe\xe8gD\n$\x89!J\x91 \x14\x17\x91:\x1d\x14O\x81jh\xc9l/\"B\xbf7\xda\xd2]?\x06\x8e\x19\xc8ċ\x11\x05\xb2\xc38's\x91\x90\xd1\xc0\x05\x96\xec\x96\x06~]\xa6\bI7\xb4D\xf2\x11S\x1d#\x1f\xdd(\x9e\xc2A\xf4fAJV\xd15\x85\xa0\xa8R\xc0]\x05\xa3\xe3\x86D\xc2\xcc9N\x9c+\x8b<\xbcKb\xfa\xb6\x04\x1a\x93\x87\xebê##o\x05\x92\u0086v\xdb\x1b\x89\xd8\x06\xb2\x87qV\xa8\xb2\x97\x17\x12\x87\xaeX\xd1v\xf26\x9c\xc9\xc9\t\v\x94\xb0ˍ9\xc9ȑ\xcag\x0e\xbeK1\xf9\x8b\xfc\x10\x81VC\x06Ex\xf1H\xb0V\x93\x17ȋ<\xdc\xc2%/\x9c\x14H1\x1bY\xc3\x16\xb8\x01\xbaw(-\xdai\x93\\\x1byp\xd5\x1a\x00\x97]\x05\xbc\x91\xb0*\xba\xbdY\xd2/\x19\x15H3\xbb\x02}k\xbd\x989d\x86\xceG\xc7<\x18ޓ\x16\\\x81\\\xaf\xf1҆\r{\x91\xf7\x882e\xcfヽd\xd1]\xd8\n\x10\x96\xb0\xb8\x8a\xf0\xcc\xd1\xd0\x13\xcfx%q\xfb\xb9Ck\xb0C\\]\xb2\xf7Ǩ2댾Е\x9d>\xc0H\xb6J\x81\x14\xb0\x18H\xea\f$\x81)\xe8]b\xe88\x9e\x8bƛ=\xb4߶@\x16X\xb8\xb8\xa9\xebZpA\xda\x02\x05\x92\xbc\xe6\xeb7\xa4\xb0#\xbf\xeb\xadaβ\x8e\xc6\x04\xc5ah\x03\xa6@v\u05f8dR\xab\x18Y]#(\xf5>\\\x81̮F\xbb\xa5\xd2.I\xeee\x81<\xaf\xab`\x11C`\t\x99m\x94\x04\xe0\x18Xzod\xf0\xa9\xd8\xce\xe4vѓ{3\x92\xb6n|q\xa8\x8cΜd\xa9\x12]\xf1N\xa8\xac\xd5\xca&$\x04)]\xba\x03\x95x5\xa0؆\xab\xd6\x1dT\xc2Ae--\x1a\xe12\xbfs`n\xd9n\xe9۟\x12#\x8e\xe8\xa20\xf2\xbd\x10\xb7\xa5\n\xbb\xc6\x00d\x83y\xe2\xe8\x83Y\x81\x840,QP\xd5$\xee\xba\x17\xc8\x13C\\N\x15\xf6_'\xcbo\x97埨\xf21\u007fvYz\x87\x8a\xbf\xb7\x90\x8dη\xc4g\xbc(\x8b\x8c\xf7\xc6\xd2\x02\x80\xdc2]~\x01\xee\x16\x97B\x97\xb8\xc3\x02\u05eb42\xbc\x16 \xf8\xe5\x96)M\xdeR.\x90`\x16\xc1H\v\xbf@\x86\x19\xe7\xd9\x17N\x1a\x16H-\x1bO9\n\x8c\u0378\xa4M\x90\xdd|\xc5Gѭ\n\xaf\xdd\xc8\xc9\xfd\xcd\xe9\x18r\x81\x9c\xb2F\xf7f|A\x94n<\x92\xc6f\xd8BV\xe6d\x9b\xf0\x16\x17H\x1a\x9b\xa1\x05]CJX\x91-6#\xb7t\x05@\x9b+H\x1f\v@\x12\x14\xdf%?\x1bV.\x9d\x0ec\xb2I=#Y<\xc7W̭\xd3\x1dQ:}\x84/\x9e\xe3\xcb\xe7xE8\x01=DP)\xbe\xa6\xe8\xf9\x05\xd2Ħ:\xbbt\xd3O\x11ί\xc5\x04\x92\xc5H`<!\xef\xe1\x11'\xc3*H\xbc6X<\x87\x97P\xcfbP\xd9\xf4ބ\xe40n`|\xe2w\xbc\xf9\xa7\x15=\x83\x18¤\x9c6\x05\x12\xc2\xce\xe7t\xa8\xb1@&\xd8p\xf4g\xd5U$T\x0e\x92\xc0t\xa5\xf4e\x10(%.\x82\x04n\t\xe0ʩs\x1f\x1eSqY\x97\xa2n\x17H\x05{\x13\x171\xecS,#-\xe2}\xb8\xf6]3C7/P\xb6\r\xfd\xc6j\xb1?Τ\xf3\x9adn\x15H\x02\xd3\x1d\xfdP`\x81\xec\xafQ\xb2\x14\xb8aw\x95\xda\xd0\x1a\x1fy`\ueeb0\x9bA\xc2UX\xfa&A\x81\x8c\xb0!\x8d\x91\x12\x89t\xb0秧\x8e\xee\x04\xb26Z\xf6\x95\xfe\b\x12\xc2\x02I\u007f\xa4/\x90_CZX\x80N\xb4g\x1a{\xcf\xfd\x9f^>\xa3\xb1\xdb;\xecDm\xa6\xb1\xbb\xf0\xcecoi\"F1\xbf\xef\xd4\rF.\t\xd9\xcf\x10+\xce\xf4\xc2CB\xd8\x00\xa2\x1b\x13\xce\r\xe4~\x8b\x1c0\u007f\xab\x97\x0e\xb4\x14\av\x8fJ|\xac\f\xda\xfe\xebW\x12\xc0#z\v\t\xc0-T2~ɜ W\xc6L\x06SN\xf0\x84J:.8\x13\x19\x93\x82\fp\x15\xc8\b\x83\x8a\x8c\xed\x17᭦\xf9ɗ\xd4\xc1\x10\xa9a\\W\x02:\xba\xed\xc7`\u007fU\xe4j@\x1eX=\x92g\xa7\x9f\x9f!q\xe1Mn\xd2VA&Xgt\xd5s\x975\xbd\xa2\x9b\x8c\xe2!\xdeS\xc1\xe4\x02\x89a\x96\x1bQ\xd2\b\x16\xbd;\xb9\xa2\xe3\x8f\xe5G\x1c-I\xc7\xe8\xa1ʵ\n\xab\x0f\xb0D}p\xf7xYI>\xf6[\x9c\x9e>\xe0lb+@\xe2W\xc3l\xe3\x12\xb5\xa1\xa9/{pZ\xd3N9\xa4|\x05PF\xcehx\xae\t\f\xa7\x1f\x8d+\x90\xddu\x16N2\xba\x12\x8c\xd5u%\x9cS7;\v\xe4t\xbdu\xb56-\xb9\xba\"V\x97\xa0\xb7\x1e$syz\x15\x93\x19\xe3<\xe1\x1b@N\xd7̍K-1\xe4v\xdd=\x8eIO:r\xb7J)h\xeb\x14\xa9YS\xa4\xbe\x82\xe9\x96[b\xabC\x9a\x96\u007f\x90z\xc82Z&\x9f\xcf*£M\x86\tI\x8b/\xb2\xb6\x98\xb5\u008e6\x96\x1c\f25R\xbfo\xd6\x01=\xf8\xc8\xe1bVN;{g\xc0\xdd=y\xc8Fz<SӋdH^\xa2\xe0˪\xf1\x15\t`\xb5\x9ag\xcb[҃\xbd[\x8fn\xbe\xbb\xafU\xf4\xbb\x8f\x05\x12\xc3\xceRT\xf4\b<G\x80\x8cn\xd2~~Z\xed\ueccb\xbe\x91e\x03_\xfa-K\x1b1H\x13\xbb\nV\xd1\xdb\x12;ň\x15+\x12\xd9bV\xb1.\n\x87/1\xe5\x12\x93\x9dS\x91\x05$\x8e\x05\xe4ʇ\xab{R\x1c\x89\noE\x19\xeb\xb2\xf8\xa5\xa0\x05\f\xc9d7\xcd\xe8\x86!\x99\xcc5Ђ\xb0\xb4t\x87\xb7\xa2\xa2wq\x16\xaa\xfc\xe1>w\xcd%\x88\xf4\xb3zA\xe1\x18\u007f\xa8ce\x9b\x1c\x19i\xd6dZ\xc9\x1b\xf1\xcb!\xfe'C\xbaw\xfc\x81\x92\xe1O\xfcE/\xfcŲ\x97lH\r\xf7\"\x1aQU\xa0^\xc7\x17\x9f\fp\xf7\xe9\xe9a\xe3\xff\xfb\x19\u007f\xf4\xeb)|4\xab5\xef\xed\xf8\x9a\x11\xe3\xc3\x12\u007f rƿ\xe6\xe6Yǜ\xe0\xbeq\xe3/\xcb\xf9֍\u007f\xfbF=\xcd-\x1a$\xbb\x9405i\xc0|\xff\xc3\u007f\x04\x00\x00\xff\xff\xa0\xd0\xc6\xe4\x87q\x00\x00",
@@ -143,18 +143,18 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  0,
 	},
 	"js/controlPanel.js": {
-		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec<ks\xdb8\x92\xdf\xf5+z\x98\xec\x8a\x1cK\x94\x9c\xcc\xe4\xeeƖ\xab\xe2x\xb2\xeb\x9d\xc4\xc9ľ\xbd\x0f9\xd7\x15DB\x12\x12\x12`\x00жj\xc6\xff\xfd\n\x0f\x92\x00E\xea1\x8f\xd4~ة\x9aX\x02\xfa\x85\xeeFw\xb3\x01\xea\x0eqHJ\xce1\x95\u007f\xc7d\xb9\x920\x83\xe9@\x8df\x18\xa5\x98;\x83\x03\x81\xe5%\x95\x98ߡ,,\x8b\x14I\xfc\xf7\x9b\xb7oFϧ\xd3it\xa2q\x04\xe6w\x98\xbf\xa3\x19\xa1\x18f\xb0@\x99\xc0\x83\xc9\x04\xfe[\xe0\x14$\x03\x83\x05\x82\xe5\x18\xe4\x8aХ\x80\f\v\x01\v\x8e\xbf\x94\x98\xcalm\xc8|&Eũ&3x\x1a\xde\x13\x9a\xb2\xfb(\xce\x18J\xc3\x01\x00\xc0\xa2\xa4\x89$\x8c\x86\x11\xfc\xa2\a\x00\x1a\xc9\xc2\xc8\x0e\t,oH\x8eY)\xc3\n\x01\x1c\x8c^\xbc\xc7\x11\x1c\x9b\xc5\xe9o\x83\xe8d0\xa8\t\xb8\xf0\x9a\xd4\xd3\x18}B\x0f\xe10\x9e\fG\x96\xb6(\x93\x04\v\xf1\x83#\xe7/\xb5L\x9e\xaa$/\xb1\xe12\xd2\u007f0\xe7\x8c\xef\x81gtc\xc4\x03xT\x12\x02\x90\x05\x84߸\x80\xd5Z\x9f\x86\xc1\x133>\x16\x12\xc9R\x04Q,\xf1\x83\f\x83\xd7(\x91,O\xe1\x8aI\xf8PRJ\xe820j\xe0X\x96\x9c*\xe2\x803\x81\xf7\xa6\xe4Ry\xac\xa4Rh\x84\xa6\xf8\x81\xa2\xbbq\x8e\b\r\xa2x\x85ī\f\t\x11\x06D\x8cQ\"\xc9\x1d\x0e\xa2J\xe2\xc9\x04\xde\"B\xe1\x06\xcd\a\x8e\x95\xb4W\x86\x118c/\xb3\xec=\xc6\\X\xebM&p\xc1\xb0\x00|\x87\xf9\x1a\x10er\x859$\xeb$3\xea\"\x8b\xf0\x1b\xd7\xcf\"\xdf\u007fn8\xa2\x02i\u074bƏ|\xbfll\xe6j\x06\xbaݷ6\x91\x81%\x8b\x96.\x18\xc7{\xe8\xe2\x02KD2\x9c\xfa\xfa@\x17\xea\xff2/\x8c\xa8\x8f\x83\xc1\xe3@o;\xb3\xdd\xeeW\x98\xc2=\x06qOd\xb2\x02\x89\xe6b\xf04\fb\xf5a\x9c0*9\xcb\xc6\x05\xa28\x83\x8c\x00\n\xa28\xc9H\xf29\xf4\x9d\xcf\xd9D\x03o\xe3\r\xc0\xca\xd7\xdaA\x8f#x>\x9dF\x83\xc7H\xed\xdd\xe0IZ\xe6\x85f\x87\b\xc5\x1c\x9e,\xca,\x13\tǘ\x8eY\xa1hՌ[n/\x1f\xe4K\x8e\x11\xcc\xe0\xd3\xcf%\xe6\xebP\xae\x88\x88bA\xe6\x99\n!a\x10;\xcaj\xe0cɖ\xcb\f[}6\xdc4\x8cG\xc9\x03Ds\xc1\xb2R\xe2q\x87|[\x11\x17\xe4\x01\xa7\x9dXJ\x03\xca\x1e7\xac\xd0\xda\aFA[~\xb0\xb1\x1f\xe0\xac\xd3\x00Z\xc3j\x03y\xec\xb7x\xcb\xc6f\x95\x8eC\aQ\xccq\xce\xee*\xc9W$\xc5v\xb7+Ќ%(\xdb\x01\x93Z\x8f\v\xa2\x18\xa5i\x1b\xe6\xb16\xba\xe7\xe0_kq\x1d\x12\xf9+\xeb\x05p\x96սz\xb32?\t\xb8\xdbO\vű(`\x06_\xd4j\xae%\x928\fj\xc2#\b\x82Q\xbdv\x05i#\x0f\x9b\u007f\x82\x19\xfc\xe3\xfa\xddU\\ .\xb0\x99k$+\xf3\xe2\x18\xf4\x9f\xeb\x15㲊\xb7l\xfe)\xae\xf8\x1f\xc7zJ}\xecD\xfc\x80\xee\xbb\xd1>\xa0\xfb^\xa4\xeb5Mt\x1c\xef\xe4g&\r\xb2\x87\xfd\xacf\xf9\x9e\xb3\xa4\v\xfbY7[\x8bx\x85\x1fd7\x96\x9a\xe9E{\xcf\xf1]7\x9a\x9a\xb1rz\x88Ϸ\xea\xe6y#\xa4\x87\xf5\x9d\xc1zY\xcaU\x17\xdaw\xb1\x9aa\x9cH\x82Eԅy\x99b*\xbbQ\xf5T?\xe6\xdb\xf5\x15S\xde\u0605j\xe6Z\xb2~o\xf0^1ڳ\xc8\xef\xbb-a\xf1\xae{\xbc\xed\xfbX\xcd\xe0\xb4C;/\f\xe6\x8f\x19\xae\xf7d\x8d^\rVd\xea\x81\xd6j-\x8dk\x92\x97\x19\x928ݏ\xd8\x06xTe{\x05\xfe\x86-\xaf\xb1\x94*kh\xe8W\xa6\xf2u\x86a6\x83 pk\xc3}\xf1 \x80\xa0\xce\xf2\xcd2\xfe\x03\x9e\xd8\xfaz\x9c\xb1\xe5\xf8\x0ee\xa5g\xbbݤu؉TJo՞~\x8d\xd2\x17y8N0\x95.l0:<\x04M&\xb6b\xb98?\xcfX\xf2\xd9T`\xd5B\"\xf8f\xa6\x15uA8N$\xe3k\r\x14_\x9c\x1b\xb8F\x9f\x86\xc4Ox\xfd\xf6\x83\xcd\x06\x8d_\xf9\xb8\x1a&\xf2\xd0\xceY\xba\xd6\xc3[\xd0j\x18\x1f\xf5u\x99e\u007fGb\xb5\x05\xb3\x02i\xf1Ts\xaa\xf0\x11\x12\xe9\xbcЇ^\xc3t\xe0\xfb\xdaڦ(\a\xd7\x18n\x9cV\x90\xe3\xb9\x02\u074b\x88\xa5b\xbd^W\xe4$u]@ً\x96YS\xf5\x1a?\uf00c\x17\x8c\xff\x88\x92U\x93\xafu\xb2\xf5\x9f\x9e\xc8\u008c\xc67L\xa2\xec\x92\x16\xa5\x843\x98\xc6\xd3\xe9\xf4\xb8\xfd\x9ce\xfd\xa8@\xd4r\x13p\x06*\x83?\xbc!B\xa1\xc99K\xd7\xf0$\x80#\xb0D\x1f./\xa28\xc3t)W\x8al\x9bb\xab\xfc\xae\xfeۃK\x10\xc5\x05\xc7\x05\xa6i\x18\xfco\v\xfdTr \xe9l\xe8\xcb\x01G\x10\f\xcfڰ\x06>=;E\x1ae\xa1\x1f\x81\xc6\x02#\x9e\xac\xc6\x19\xa1\x9f\x87 \xd7\x05\xb63$E\xc9\xe7\xe1\xd9&\xe1\xd3\t:;\x9dȴ\x97\xbe\x83\xd2(Z#\x1e\x88$\x0e\xc5zWʭh\xa7\x13\xc9ς\xa85Z=\xf2\xed2\xf6\x19H\x1e8&>\x9en\x18yO\x8b\u0099\xa1\x84\x84\f\xab\x1a.\xb4\x0f\xf0\xae\xc7\xf8\x0e4\xe8\xfa\xfcX\xb7\x01\xfc\xed\xf4#\x95\x9c\xe0\xbe-dg7\xb7\r\xa6\x92\xaf\xfdU\xe9G\x02\x89\xb2\x81\xbfD\xbb\xf15\xc2X*\x80\xfa\xe1:Tf\xb1j\xa8\xe4ح\xd0#\b\"\xcf6\x8e]**z\xbfi\x96\xb1\x0e\x82[\xf6\x9b\xdd\xc1\xc1Q\x03\xaeX\xc0\x93d\x85\b\xbd\xbchº\xce\v\x06Jϑ4\xeaܦ{\x90\xf2\xa9\xf4\x9a\xafY۾\xe2\x05\xaa>\xc5B\xe8\x1awO\xe9\x8ci\xf4\xbf+5\xa8(\")y\x18\xa8m\xaej|=\x17l\x97\xb3GL\x9c$L\xc8\x0e\x15\xfe\xf8\xea\x15\x13ro\x19=2\x1e\x85~\xe7\uf2a4\xbbݭ?\x8c\xbaA\xd4\x17\xb0+\x88\x9e\xcaTC\xb7\xd4;\xdc\x1dW5l\x1dU}N[\xa2j\xc5\xd0z\xc6\x1e\x8c4\xe4\n\xa3\xd4\xe5d\xbd\x12\xf6\xe4f,\xe3\x120v\xe9\t\xae]\xa1\xb5c\x03\xff渺\x0f\x9d\xddAug\f\x1dt\xc6<'\xde\xd9ܸ%\xe2\x1d\x90C\xea\x90g*\xe7\xc9\x04\x9e\xc3\xcf%\xe6\x04s\x01\x84\xc29\x92\xc9j\xa3\x99[\xb5\x15\x9dRz\xae\x00\u007fv\xea\xe9|m\xc0Fn\x83|\x94\xb0\xbc\xc8pEbdZ\xa3\t+\xa9\x1c%+D)\xce\xdeh\xc1\x0e.\xbc+v\xa0\v\xec\x8f\xd3\xdb\xd8|ד\x997w\xec\xcd)\x89\xbc\xe9g\xde\xf4\x02\xa7\xc2N<\xbf\x8d\x178գ\xa8tGQ\x99\xda\x16\xb0(^\x93;lg\xbe\xbb\xb5Z\x1e\xb4Z\xc1\v\x9c\xea%\aQ|\x87\xb2P\xb1\x88Z \xa8\xf4@\x14?[\xae\xb6O!\xb4\".\xa9\f+\r4\xa4(Kq]R+2\r\x88Q\x8b\u007ftQS\xca\\B\xc6\xe4\xef9[r,\xc49\xe2J\xc65M^\x13\xae\xbd*.\xec\xd48\xc7\x12\xf3`\xe4K8\xf2\xb8\x18\x92\x05\xe6ʓ\xf5i\x89\r\xf1\xbe(37\x996\xd0\xc7\xd3iW\x1f\xb9\x01\b=\xd6\x13\x8f3|[\xe3\xbb(o\x91\\ŋ\x8c1\x1e\xda\xc1\xc8{:\x1dn[\xec\xe6\xc8X\xedơݔ\x15\x97#\b\xfe\x02\xd7k\x9a\xe0\x14\xf4>\xf5m\xa8\xd2\x10[\x80\x9a\xf0\xd4`\xf7\xa6}\xae\xec4h\xe3\xff\xfe\xc6r\xad\xd98\xf8v\x83^\xe3\x84Ѵۢ\xfe\xae\xed7\xa9\xa5\xf1\xc7\x1a\xb6&\x1a\xfar\xec\xb6o\x8d\xb9ie3\xd5e\xeb>=\xecgl\x8b\xbdir\xdf>\xbd6wM\x0e\x17D\x14\x192\x11\x15^\x99\xf8\b6\xa6X\x90\x84Q\xc12\x1cgl\x19\x06\n\x04L\x00\xfd!\x18\xd5\xf1\xa8\xb73\xe2:\x01I\xeb\x9d;\x82\x1c=T\xed\xe60G\x0f\x9e\xe16\xb7\xdbD\x837\xfa\u007f\x1a\x924\x8a\xefI*Wap<\x9d\xfe\xc5d\x18\u05f8\x87\x11\xb1\xd0J\xa9Uwys-\x18\xf3\xcbD=@d8\u05cbH\x18\xa5\xb6\xb9U\x9d3\x9a\x93\x9d\xb0\x99\x89\x15\xdaͺ\xc0n\xbeO\x90\xc0\x10\x88\x02'\x04e\xff\x970\xba \xcb\xe0\a/\x8d[&\x1b\xdd\xef\x14Ӡ\x95\xf1+Ђ\xb3\"\f$\x91\x99\xae|\xaf\ryP\x02h\x89\x16dYr\xa4W\xb4 \x19\x8e\xdat\xe6\x1c\xa3\xcf'}B\xe6iF(\xfeӥ\xccsDS\xd0g\xa5{\xc9\xc7\xf1\xb2\xcc\x10w\xe4J\xf1\x02\x95\x99\xec\x16\xd4;p\xd8_ʠ>z~\x1c\xe8\x93\xf1\x02c\xae\xeaZ\xac\xb2\xf4\xc7 \xb8ե\xcd3[\xda\xf4W6\xcd\xe1\xa8v\x87\x8d\xa2F\xd1\xd5e\x99\x18\xa9\x8f\"\x18\x81W\xa7|@\xf7\xdbJ\x155]W\n\xef(\xae\x8b\x95z\xb0.Q\x9a3\x1b\xc5N\t\xf5\xca\xd6\x03:\xda(X[]4\xe1\xc2HVE^ˣ\xaa\xf4\xfc\xd8[\x9fVW\xd1OUʪtg\x8bF\xb8\x19\x04%M\xf1\x82P\x9c:\xcf|&\x17\xa9\xf5W\x85\xe5\x821\xfdW\xc5H=\xf1\xa5D\x19\x91\xeb\xba:\x9dں\xbc\x15\xe0\x0f\xa7\xb4`<G\xf2g3\xa8\xdb\fJ5\xf6\xfb˻e\xe4v\x06{\t\x97\x85O\xef|-\xb1\xa8\x15\xa6\xbf]c*\xb5>G\x95>\xe2\xb7X\b\xb44S\xfb\xf1I\xd9=\xdd\xc9\xe9\x03N0\xb9\xc3i\x0f\xb7j:rs\x9526\x9ag\x18\xceY\xbav\r\xdem햘\xe6A@?\x06`\xaf\xf7\x12\xb9\xcf<\xde)c\xf50\xb3\xf9Ĳ\xe1I\x1d{\xaf\xf6O@w\x8c\xa4\xb0*i\xcaUa\xcd\x16@\xf1=\xacd\x9eU{[ح\x98\xaaM\x8a\xe0KI\x92\xcf \nDG@$ܓ,\x839\x86\x8c\xe4D\xe24֤)\xbe\u05fb\xb6\xae;\x16\x8cC\xa8\x8fT\x15\x11]$9E\x05\xe60Ӄ\x1f5ȭ3a䎋R\xa8\xa4\x83\xb9\xf6.\xb7\xd9]u#\xe0H\xc3o\xed\x01%\x8c\xaa:L\x81\xbd\xaa\x13N\x13\xcfZi\xab\x83\xec\x82\xd04\f\x9e\x90\x02H\x10\xe9|\xe6\x84ú\u007fӃc+D\xdd\x17\xf1\x97\xe2=\xd9ne\xab\xd4^\xf9ob\x93\xe5\xcb4U%C\xb4'\r+FK\x82\xc9\x04\xfe\x892{-d\x17\x91\x94\b\x9b\xb0\xeb\xf6\x919\x1e\x1a\xb5\x166\xd8A\x8e\x954E\xc6\xd1\a\x9b\xcf\xe0\xbb\x14Zi\xc36\xb0t\xee\xd1\xdaU\x9ai\f|\xc5$n\xb5\xfd\xadg\xc3l\x1fm\xbb-\xd7{\x92\xac\f\xd6\n\x89\xb1\xd4\xda\xd4>[\x159\xd1\t\xf8k\x8e%c\x99\x01\xc4_B\x85\x1f\xc5jw\x85]B\x9e\xc0\xe0`=XK\xa8\x84\xd0\xf8\x97O\\\xe7\xca}\xbd\xacM\xaf\x93\xd4A\xee\xe2R\xac=\xb7Mr\xe0\x9fʸ\x9b\x14\xa7*z\xea\xdbH\x11\xfc\xa2x_as-O\x85@\xf5\x17\xd3t\xb3\xf3\xe7\xe4\x00\xdb\xeb\xd3\xf2l\x88\xd9\x11K\xbdNОv\xf0s\xa3g\t'#\xeek\x85Mj\x1b\x84:l@\x16\xe17]\"\xd6WR\xfcl\xdd&\x19E\xd1f\a\xb5Eʭ\\\xa3]\xc0u\xf5\xb8\x83o\x8f\xe2\xf7Լ\xc0u[&r\xd3-\xfc\xfa+\xec\x87U\x19\xaa.4\xf65\x93C\xa4\x85\u007f\xd0\x0e\x11Ε\n\xb7,\xf1h\xea|\xd3]\xf3\xec\xef\xa5\xdc\x16/\x87뫍\xe9鬮\x89\xf6\xd4[\x8bX\a\x9d\x83\xf4\xe7\x90\xeb\xd7aE\xdb\xd7c\xab\x9a;D\x979S\x11\xbf3\xfe\xb6j\x8c\x1b\x92\xe3\xd7Z&\xb9\xbf\x8e\xba\xc9o\xa7|\x90\xd66\x19ؾ\xc9\x16\x0e\x1bJ\xda8yqj\xbf\xfa\xe3\x11\x1c{:\xad'N\xe1\xd9\xd4\xc6\xf4\xcb\x05\xb0;\xcc\xe1\xd9TW\xa0\xfain\x04\x8cfk\xc8\x18J\xe1\xd94\x86\xffQ\xc5\xe6\x12K\xe08G\x84\x12\xba\x04\x8a\x1f$\x14H\x88\xb8}Hek\xa3ל\xe57\xac\xb8\xd17\x1e\xddD\xd2u\x9a\xb0\x993\xf6:f\xafu\xbb\xf5\x94]\x83\x93\xa2{^\xc3\x10HT\xb8\x9c\r\x17\b\x16H\x9f߀y\xee\x1e\x9e\x9dNH?\xa2\xaaT E\x12\x8dm\xb9Q\x11\xb2e\nHV\fA\x97H\xb3\xe1\xf0\xec\rC)\xa1\xcb8\x8eO'\nu\xeb\xe1\xbd9h\xaa\xbcd\xb8\x1b\xd6\xc9]{@\xb7\xbcp\x0f\f\x15-\x87\xa0+\xce\xd9p|<\xdd\x03\xa5\n\x10\xfb\xa3U'jM\xad;\xact:/\xa5d\x14$\xa1k@\x19\xe6rxvQC\xf5\x1e\xa3u\x9d\x86m\xbb\x00\xb2銨\xf8\xb7'\xfe\xdb\x13\xff$O\xdc,\xbb\x1e\xfd\xf6ƫ\f#Z\x16\xf0\x81\x95\x92P<\xf8\rM\fU\x9ezM\x8c\xee\a[\xf5H\x95de\x8aE\x18X\xff\b\xdc\xcaT\x91\xb1\xd7\xf1E\xd84\tF\xd0M\xbb\xcaˑ\x17\xf3w4S\xfa\xb2\x1bY\x84\xfb\xac@\xb7\xea\x1aߎ\x83C\x1a9\x9bAa\x0f\x96>\xb7ͅ\f\xea\x1e\xd1c\x04\xf6d\xf9e\x9aBF\x84\xc4T\xe5bɠq10\xae\xa5߷\xb0\xe1\x87\xd1p\x98\xb3R\xe0\xb2\x18\x8e\x1c\xbb\x83\xdb\x0fhN\x89m\x8a\xf5\xee\x92;p\xfe\x9a\xdc&B\xd4j\xe1\xee>j\xb6\xf7\x9b^\xeaw\x96\xb4\xeaSL\x89\xd7\"\xad\xaa \x05w\x99\xf6t2\xea^wJ\x04\x9ag\x8a\xc0\x01\xe8\xc6\f\x17\x96\xf3\xa0Ӕ\xbfS\x8cC\x04y)%\xce\vټ\x0f\xf5h\x0f\x9d\xa2\xc1\xe0\x9aqY\x15D\xe6m\xa0\xeeb\xc9\xccM&\xa0\x10\b]V\x1fa\xbe\x86\v{62\xa8\x82\xc08\xb5#m_\x01\xc7'\xf4\xfbfa\x10\vCpL\xf2e\xf7k\rd\x11\xbaR\x1aQ\xdc\xd7\xc1<\x96cE\xcf\x12\xdb\xf6\xf6G/\x92q@\xc1\x93`\x14\x90|9)\x8b\xb8\xa8\xde\x01k\xbf\xb4\xf1\xe7rN\xd9=mx\xab`\x8c8Gk۫ꊶK,u\xf8\xb8C\xd9\xcb\x1d\xa0\xbd\x95\xbf\xa1\xe10[\xaa\xa0\x802e\x83\xb0\x12\xfbR\xbc\xc1Bܬ\x10\x1d\x19\xb8Q\xcdS\xe3nr\rl\xb3\v\xd50=\x8e\xd6\xd8Z;\xa8\xe3g\x97\xef\x1b\x0f\xd3\xc1\xfck\xf9\x16)\x0e\xb2\xad\x0f~\xb0?\xfd\xa1ܾ\x86\x0f5\xf9g\xab\xef\x90\xe2w{M\xcb!\xae1\x95\x8dK\xd8\xd6\xc9\xd7r\n\xc5\xee C\xb5\x11\x0ev\x8c?\x9c\xe3\xd7p\x0ek\x95\xad\x9e\x91\x8b\xe5\xefv\x8d\xdf\x10O\xaa\x86O\xe3BN\xf7\xe8k\xb9Q\xc5\xf2 \xc3v!\x1d\xecN\u007f\x1a\xe7\xaf\xe1V\x8e\xa5\xfee\\\xab\xbe\xb0\xe0\n\x90Yޯ\xed\xa4+C\x89\x8d\x14\xd5u\x9e~w\xf1ʴ\xeamlϬ\x9b\x85\x9c\xa9\xf3\xf4\xca0\x85\x99\xc30\xae\xef)-\x18\xb7\x87\xb13\x98\x9e\x987z\xe1\xb4B\xb2\x03GG\x95\x182/\xfe\xa9_\boh\xb9\a\xb52/`f\xd6W\rWUy\xffҌ\x10\xaa\xa27\xdc\xc7p|\x02\x9f\xe0\f\xc6\xc7\xf0\u05ff\xc27m\x05\x86\x0e\xefO\xb71\xa1\x14\xf3\x1b\xfc GV\xbaf$:\x81O\xe3\xb1\xfb\xac\xe7\xa2\x1e\x1d\xdf\xfa\v\xf9t[\xc3!\x17\x04\xf9\xb3\x8f]\xf5\xfc\xd6%\xfc\x8b\xae\xc0\xd8f\x93\xa0\x11b\xb0AE\xe6\x85\xf5)s\xaf\xc0\xccz\u05fd\xbc\xdd\x16\xa2\x11\xcck߶\x17X\x90~_AH\xae\x9eF\xe0\xd7_\xc1\x8e\xcf\xdd\xf1j\xc1\x96\xcfԲ%\x8b\x10\xb5O)\xe6]\xb7*<<\x15\x18\xae\x8b\x8cH\xa5\x88X\xa8Oa\x10\xeaؤ\xdfzV\xc3z\xfe\xe3\xf1m5\rf\xda\xf8z\xc2\xe8\x1dV\xdekN\x114\xd2\xc7\xe9\xedȠ\u007f<\xbe\xd5Qd^\xf1\x98\xfb<\xe6\x96Ǽ\x9bǼ\x93Ǽ\xe61wy(\x05(\xf8S\x8d\xd6Z\xed\xb1o\x9c\xe9\xc0\xb3\x8c[|\xfda\x86\x19W<k\xbd\x9a\x86\xc3\xdc\xfd\xaa\xa6M\x00BMܙ\x9b\x91y3\xa2֦\x06O\xf5\\\xe7ڪxec\x15\x9cj\xc2'@\x8e\x8elg\x80,«2\x9fc\x1e\xce?\x92[\xd3{\xb9BWA\xfbr\x95=\x84h~\xc8\xc2b!\x1f\xab\x854u\xf7M\x1b\xe9\x14\\·1<\xf3q{ض\xefVn>\x8b9\x86E\xd78q\xfd\xca\xdc}\x15!\xd2\xf6陜G\x83\xf6\xbdU4ҤF\xc1\xaf\xc1h>Ҙ\xb6\xb61\x1cf*Ʃ}X\u007f\xeb\xf3\x91\n\xe5tf\xa8\xb4,\xac/!ٴ\xe5\xc6\xd6J\tj\xfeu\x95\xf8<=l,C\x92\x1c\xb7\xdd[\x8d\xed\xe3\xc9\xe6'\x804\x1d\x15\xf2H\x8e\x9d\xfdzbH\xda\xf9*\xf2\x9c³nj\x03\xb0\xa7Yr\x859\x06\"\x00\xc1\x14rB'+>IU\r@$\x88\x15+\xb3\x14\x84\xd4\aZ\x1c#\x89\xb9A\x94+D!c\xf7\x98C\x8a)\xcb\t\xd5\xe6\x8e\xe1e\x9a\x12\xba\x84cH\u061dn\u05ed0L\xf5\xa5N\xfd\x1b'Z\xb8\x8f\xd3ۣ#O\\\x15z\x9an\xaa\xc0I\xf3\xab,V\xec\x06\x15\xbe5[\xaev\xd7N\x1a9\xa1\xdbi\xbc\x98\xee&\xb2\xe2\xdbi<\u007f1݃J\x8a\xd6\xdb\xc9\xfc\xe7\x8b\xef\f\x9dn\xd71a\x97\xea]8\x02\xe3#\xb5\v\x99\xaf\x0e\xb7\x9f\xce7\x98\x19TsG\xba%o\x1b\xfb\xed\x0e\xec\x9d\x04\xfe\xb6\x1f\x81\xf6JM\x97|\x85\xd6B\xa2\xe4\xf3\b(\xc6iV\x97a\xca\xf1Ud\xad\xe6\xadw\x9bKm\xf7+\x92a\b\x89W\x8b\x90\x05\xd4\xd4>\x92[\x98\xcdf-\x9a\xe0\xc51U\xf4\xd5w\xe4\xdc#\x05;\xaf\xcb\xda\x13O\xea%\x96\x97\xef\xf5\xe5Z\xbe\x0e\x91\xbd\xdd\xf6\xcb\x00&\xdf\xc2SU\xf7\xff\xe3\xfa\xddU8\\IY\xfc0\x99\x90\x82\xd0\x05\x8b\t\x9b\f\xe1\b,4\x1c\xc1\xd0}~K\x91D6\xc0\xbaaN\rǉa\xe4\xfef\x14\x04\x97\xd7\xef\xf5K\x02\x1a\x82\xf1\xa5~\xf5\x03\xdeq\xb2$\xb4\x99\xb0\xa8\xe6\xbd\x10\xdd]\xfdvR\xfd\x82\x91P\x9bU\xde3\xc8ؒ\bI\x92Z\x1aѬԿ\x16\xf3Ž\"D\x16\xd5w8\x9b\xb9\xef\xbfU\"rD?\x8f\x97\xfaw\x81<\xc7q\xb0\xc6\xdf\xf7`\xb1,\rzB\xae\x81\xe0\xd8\x00xvqoU\xccտ#\xc8\xed-\x8a\xe6\xa7p\xf4\x84\xca\t\xf5Ee\x95(*8o\xa2-\xdb\x14\xc2)\xfc47\xaaT\t\vfu\x8a\xd4T'p\x8c\x8f\x9eG\xb1d\xaf\xc9\x03N\xc3\xe3\xa8b\n\xa7\xae\x8a\x14\xe2\\\xbf\xd1\xf1ӹ\xa7\x1c\b]J/\xa2M\xb4M~/Z\xfc\\\xf2o\xcf7\xd4\xd8G濶\x90\xf9\xdby\xb5\xe4\x1cf\xb5\xae\xec\xdar\xf3\xfac-eސ\xaf\xb5:1\x10m\x0e\x9a\x9a\xd1C\xe0\u05c9zT;\xf2\xdcz\xef\xe3\xe0\xff\x03\x00\x00\xff\xff)8\x16\xb5RO\x00\x00",
-		hash:  "46d8be37725d5545969ad568d4407717ae4378eb9b4ad596c62888e831cfbfa7",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xec|ms\xdb8\x92\xf0w\xfd\x8a\x1efvM\x8e%JJf\xf2\xecĖ\x9fr\x92ɮo&\x99l\x9c\xdb\xfb\x90s]A$$!\xa1\x00\x0e\x00ZVe\xfd߯\xf0F\x02\x14)Kٝ\xd4^\xd5\xce\xd6\xc66\xfa\x15ݍF\xb3\x01\xf2\x16q\xc8*\xce1\x95\x7f\xc1d\xb9\x920\x83\xc9@\x8d\x16\x18\xe5\x98{\x83\x03\x81\xe5\x15\x95\x98ߢ\"\xae\xca\x1cI\xfc\x97\xf7\xaf\x7f\x19>\x99L&ə\xa6\x11\x98\xdfb\xfe+-\b\xc50\x83\x05*\x04\x1e\x8c\xc7\xf0\x9f\x02\xe7 \x19\x18*\x10l\x8dA\xae\b]\n(\xb0\x10\xb0\xe0\xf8\xb7\nSYl\r\x9bO\xa4t\x92j6\x83o\xe3\r\xa19\xdb$i\xc1P\x1e\x0f\x00\x00\x16\x15\xcd$a4N\xe0\xb3\x1e\x00h4\x8b\x13;$\xb0|O֘U2v\x04\xe0Q\xf4\xd2\xdd\x0faj&\xa7\xff\x1a$g\x83A\xcd\xc0\xc7\u05ec\xbeM\xd1Gt\x17\x9f\xa4㓡\xe5-\xaa,\xc3B<\xf3\xf4\xfc\\\xeb\x14\x98J\xf2\n\x1b)C\xfd\x03s\xce\xf8\x01t\xc66F=\x80{\xa5!\x00Y@\xfc\x8d\x8f\xe8\xe6\xfam\x1c=2\xe3#!\x91\xacD\x94\xa4\x12\xdf\xc98z\x852\xc9\xd69\xbca\x12\xdeU\x94\x12\xba\x8c\x8c\x198\x96\x15\xa7\x8a9\xe0B\xe0\x839\xf9\\\xee\x9dV\x8a\x8c\xd0\x1c\xdfQt;Z#B\xa3$]!\xf1\xa2@B\xc4\x11\x11#\x94Ir\x8b\xa3\xc4i<\x1e\xc3kD(\xbcG\xf3\x81\xe7%\x1d\x95q\x02\xde\xd8eQ\xbcŘ\v\xeb\xbd\xf1\x18^2,\x00\xdfb\xbe\x05D\x99\\a\x0e\xd96+\x8c\xb9\xc8\"\xfeƏ\xb3$\x8c\x9f\xf7\x1cQ\x81\xb4\xedE\x13Ga\\6>\xf3-\x03\xdd\xe1[\xbb\xc8\xe0\x92E\xcb\x16\x8c\xe3\x03l\xf1\x12KD\n\x9c\x87\xf6@/\xd5\xff\xabuiT\xed\x11\x81*\xb9b\x9cH\x82\xc5\x01\x92.-\xf6\xb6e\xfaˆKl]{?\xd0k\\\x83a\xb3\xc2\x146\x18Ć\xc8l\x05\x12\xcd\xc5\xe0\xdb8J\xd5/\xa3\x8cQ\xc9Y1*\x11\xc5\x05\x14\x04P\x94\xa4YA\xb2Oq\x18\xe9ފ\x1d\x04\xab|\x00\xf0\xd9Ӧ^\xae\xf7Cx2\x99$\x83\xfbD%\x8a\xe8Q^\xadK-\x0e\x11\x8a9<ZTE!2\x8e1\x1d\xb1R\xf1\xaa\x05\xb7֘\xbc\x93\x97\x1c#\x98\xc1ǿV\x98oc\xb9\"\"I\x05\x99\x17*_\xc5Q\xea٫\xc1O%[.\vlM\xdaH\xd38\x01\xa7\x00\x11\xcd\x05+*\x89G\x1d\xfa\xed%\\\x90;\x9cwR)\v(\x7f\xbcg\xa5\xb6>0\n:\x06\x06;\x8b\x0f.:\x1d\x00\x9f\xedj\r\xc4\xef\t\x98\x9d\xcc \xbd\xd5\x13%)\xc7kv\xeb4_\x91\x1cGI\x8dZ\xb0\f\x15\x0f\xe0\xe46\xbc\xa3$Eyލ\x13Fw\a\xda}\x1d\x1b\xc1\xa2\xfbZ6\xe8U\xdc\x19\xa0\x17\xc1\x9b\xfd>#}\x89\x01<\x9a\xffSv80\n\xba\xcde\xec\x10\xee\xe3~\x06պs,J\x98\xc1oj\xd2\xd7\x12I\x1cG\xb5\xfc!DѰ6\x91´\x9b\a\x9b\x7f\x84\x19\xfc\xc7\xf5\xafo\xd2\x12q\x81\r\xac\x99@\xb5.\xa7\xa0\x7f\\\xaf\x18\x97n\xcbd\U000cfa53?M5H\xfd\xdaI\xf8\x0em\xba\xc9ޡM/\xd1\xf5\x96fz+\xee\x94g\x80\x868\xa0~\\\x8b|\xcbY\xd6E\xfd\xb8[\xac%|\x83\xefd7\x95\x82\xf4\x92\xbd\xe5\xf8\xb6\x9bLA\xac\x9e\x01ᓽ\xb6y\xd2(\x19P}o\xa8Ԇ\xd6E\xf6}\xeamuI\x17\xe5U\x8e\xa9\xec&ՠ~\xca\xd7\xdb7,\xc7ݤ\x06\xd6\xd2\xf5\aC\xf7\x82ўI\xfe\xd0\xed\tKw\xdd\x13m?\xa4\n\x82\xf3\x0e\xeb<5\x94?\x15\xb8^\xba5\xb9\x1btl\xea\x01\x91t\xf1\xb8&\xeb\xaa@\x12\xe7\x871\xdbAO\\\xc1\xa6\xd0\x7fa\xcbk,\xa5ڋ5\xf6\v\xf3\xf0\xe2\r\xc3l\x06Q\xe4\x97\xf7\x87\xd2A\x04Q]\xa85\xd3\xf8\x7f\xf0\xc8>\"\x8d\n\xb6\x1cݢ\xa2\n|\xf70\xeb\x96m\xffd#\xaf\xc0\\\x8aK\x9b>\x1b~f\\\xb32\xc0\xa4\x9f\xfa\x1d\xceZA\xe8Q\x1b\xa0\xcey\x89\xadҮu\xcd\x0e\x9b\x15\x13\x18VDHƷ@\x04d+\xa4\x02\x01\x18\x05\xb9\u0080\xea\xeaO\xa2\xb9}\xb4S\xde\xc0yS\x17*+\x9b\xfd\xa4\xc6\x1e\xcd\v\x96}R\xee\xcdV\x88.q{#\xe9*!w\x93q\x88\xa0\t\xfd<\\K{\x8b\xf9\x82\xf15\xa2\x19\x8e\x86У\x88zXM\x86Е\xae\xcd\xf3\x8b\xe8K\xd9j\u05ebQf@\xab\xa2h\x82\xaa\xa1\xfdp\xe3\x85̜\xe5\xca0\xa1.\x12\xcd\v\fR\xc1\xecV\xa5~MWr]đ\x1d\xf1M\fF\x98\xafc\xba`\xfc'\x94\xad\x1a\x83\x1a@\xa3\x10g\x1b#\xf8\\\xf2\x8b(iFST\x96\x98\xe6\xb1\x06\xe5\x17.T\f}j~\x90<\x15\xd5\\HN\xe82\x9e\xa8\xa7\xde\x04N!J\xd3Tm\xb6R\xf28\x92D\x16\xca\xcc-\xba\xe4`I\v\x9cc\xae\x965\xfc\x7f\x88^\xb9?\"x\x06\xd1e\x95\x13\x19\x1d\xc5\xca\xf8\xf7p\x12t,\xb6\xca@4ۦ\x92\xbdR\xa5v<I\x0e'\xc7l]\xa0\xe5\x17\x91\xe6sA\x96_J\xbc&B\xe0\x1c\xb3\xb58\x96\xe6Xs.PU\xc8#\xf0q\xbdA8\x92fuyj\xc3\x05L\xe0\xef\x7f\x87\x0e\xddZ \xa3\x80\x1a\xf4\x13\xbd֣\xae\r\xbd\x15\xc8Y5/p\xbd,\xeew\x95p\x01\xad\x96\xfaN\xae\xeb\x97\xe1Pk\xd6\xe0/cô%T1\xe8,\xb3Cr?Ѷt\x1c\x84=\xab\xf6#\xb9\x16\xe6~ѩ\xc6\xfa\x86\xb3M2\xf0\xa0!\xf9\xd64\x17b\xa7@\xb3o\xf4\xa4熠IE\x8dE\xdb93̉\xb9&\xed+回Ӡ\x97\xb0\xbb\xb8\xefB\x1e\x19\x85z2\x9f\xa2Z\x13ZI,v2\xb7\x1b\u05f9\x1b$\x8f<d?\x7f\xd7\xf1\xaa v\xf5\xefd\xec\x02-\x9d-\x1c\x8b\xee5ӱ\xfc\xb5\xc3r\x8e6\xb5\xed_\xa8\r;PVo\xe1#\x94}j\xb2\xb4\xdd\xe0\x87\x105\x19-:\x98\x15f\xeb.Vf~\x87\xb3\xd1)\xad\x8b\x91\xcbu\x91+N^r\xb4\x11\x80`\x8e8,\x18\a\x8c\xb2\x15\xe8\xe5\x0fl\xa1뒚Xd\xa80=e5\\ \xbe\xc4B6\x81ڡ\x18ɇ\r\xf9\x82\xe0\"7\xde\x10\xb7K\xedw\x13\t\xe2v\xe9;V\x85\xb3%r\U0006c490\x1dJ\vL\x97r\xa5 u\x1a\nBW\xed\xeakt\a3\x98\x0e\xa0\xa6ډ\f=\xc5:64\xfek$W\xe9\x1a\xdd\xc5kt746\xf8\xa0\x95\xbe\xa9\xc3aCr%[\xf5\xbd`\xdcR\xe9AiC N\xe0ʵ\xf8\xa7\x93\t|\x17Ȃ\xb1Rǔ,\x88\xc3\fr\x96UkLe\x9aq\x8c$\xfe\xa9\xc0\xea\xaf7\xd7q\xb4\x92\xb2|6\x1eo6\x9bt\xf3$e|9~<\x99L\xc6\xe2V\xb9>\xe28\x93\xae\xf6Aj\xed\xc9K)9\x99W\xaa\xa0\xbb\x8b\x86@\xe0;3\xa1>\xa4m\xa4\x9b\xf10\xb2\xfa\xf6\xe1i&Ѱ1\xa0\x1e\x80\x11L\x870M\xfa\xa8\f\xcfh\x180\xcfX\xc1Ԥ\xa3G\x8f\xa7?\xfe\x88\xff\x14\xd5e\xe17\xdaHMI\xd3\xe4\xef\x86&C\xea\x7f\x91\xdf V\xa4\x86\xd2\xeclz\x01\xa8x\xf2\a\xfd}Њ\xe9\xd8\xebT)\xbfb\x1b\xb0;\x1b\xe0[LM\xefU\xf5\xb91\x10\x01\x94A\x81\x96 \x99^\r\xbb\xfa=\x9d,\xa6O\"\xb7Mt\x1a\xdc\x18\x91\xd0ط\xfc\x10~\xfc!Iz\xe9jS\xd6\x1epd\x8e\xea\xbe\xc7\t\vR\x14\xd1Шh0u\xd1\xf9\x0f\x84\x9d\xa6\x8f<^:\xc1\xbe`Tb*\x95\x15\x9e+\x03C\x04\xa7\xd6\xd4\xf9\xdc(\v\xa7\x10=k\xc6\xedr\xf0\xb2\xb2B\x80\xb5\x88꩘T\xfebE\x8a<֢\x8cT\x95O\f(\x9e#\u07bb\xa5\x86\xc7\r}\x1d(\xae\x9f\xe7|\xdchx|+j<\xb6'\x03/\x9f\xeb\xe9\x9b\xc3\x14\xb7\xf9$\xf0\xcd\ft\x8f\x80\xa8E\xcb\xf8V#\xa5/\x9f\x1b\xbc&\x04\r\x8b\x9f\xf1\xf6\xf5;\xdb<l\xfa\v!\xad\xc6I\x02\xb2\xe7,\xdf\xea\xe1=d5NH\xfa\xaa*\x8a\xbf \xb1\xdaC\xe9PZ2\x15L\x1d+\b\x89\xd6\xe5\x1e\xf2\x1a\xa7\x83>\xb4\xd6>Cy\xb4\xc6q\xa3\xdca\x9a\xc7Ӄ\x98ԕ\xaaFӇk$\xf7C\x00\xbe1\xfbR\x12\xf6;:0w\xb7\x02ݛ\r\x0fB\xc9\u008c\xa6\xef\x99D\xc5\x15-+\xa92O:\x99L\xa6!&\xb8\x13\xa6\x12Q+M\xc0\x05\xa8\x86\xef\xdd/D(2S7=R\xeb\xc82\xbd\xbbz\x99\xb8m\xb3U\xbcw\x9c\xa4\xb9\xff\x0e\x90\x12%iɱ^h\xd1\x7f\xb7\xc8\xcf%\a\x92\xcfNB=\xd4\x12>\xb9h\xe3\x1a\xfc\xfc\xe2\x1ci\x92\x85\x12\xb9\x1e\t\x8cx\xb6\x1a\x15\x84~:\x01\xb9-\xb1\x85\x90\x1ce\x9fN.v\x19\x9f\x8f\xd1\xc5\xf9X\xe6\xbd\xfc=\x92\xc6К\xf0H\"q,կ\x95\xdcKv>\xf6;\b\x8d\xaf\xe1 g_\xe82\xb9q\xf1t\xb2\xe3\xe4\x03=\n\x17\x86\x13\x122v\xe5~l\xcf\xe2\x9b\xff\xee!\f\xa0A\xd7\xef\xf7\xbb\x0f~\xa6\xf7H%'\xb8o\tY\xe8\xee\xb2\xc1T\xf2m8+}\xe0&Q1\b\xa7h\x17\xbe&\x18I\x85P\x9f\x93\xc7\xca-\xd6\fN\x8f\x87\rz\nQ\x12\xf8\xc6\xf3\x8b\xe3\xa2כ\x16\x99\xea$\xb8g\xbd\xd9\x15\x1c\x9d6\xe8J\x04<\xcaV\x88Ы\x97\x80\x82}\xc1`i\x18ɓ\xceez\x00\xab\x90K\xaf\xfb\x9a\xb9\x1d\xaa^\xa4\xce)\xb0\x10\xfa\xac\xe3@\xed\x8ck\xf4\xbf+5\x88\x9a\x8e\u05f6T\r\xafHâ\xfdz\xf6\xa8\x89\xb3\x8c\t\xd9a\u009f^\xbc`B\x1e\xacc\xc0&\xe0\xd0\x1f\xfc]\x99\xf4\xe1p\xebO\xa3~\x12\r\x15\xecJ\xa2\xe72\xd7\xd8-\xf3\x9e<\x9cW5n\x9dUCI{\xb2\xaa\x13h#\xe3\x00A\x1as\x85Q\xeeK\xb2Q\t\aJ3\x9e\xf1\x19\x18\xbf\xf4$\u05ee\xd4ڱ\x80\xbf8\xaf\x1e\xc2\xe7\xe1\xa4\xfa`\x0e\x1dt\xe6</\xdfٽqO\xc6;b\x0f\xa9S^}\x88\xf1\x04\xd4a4\xc1\\\x00\xa1\xf0\x1c\xc9l\xb5s/\xcb\xdd\x10\xf2J\xe9\xb9B\xfc\xabWO\xaf\xb7\x06m\xe8\xdfu\x1bfl]\x16ر\x18\x9a\xb6E\xc6**\x87\xeaT\x83\xe2\xe2\x17\xad\xd8х\xb7\x13\a\xba\xc0\xfe0\xb9I\xcd\xdf\x1aX\x04\xb0i\x00S\x1a\x05\xe0\xc7\x01x\x81sa\x01On\xd4C\xa9\x1eE\x95?\x8a\xaaܶ'D\xf9\x8a\xdcb\v\xf9\xfe\xc65\fZ\xb7\xba\x168\xd7S\xb6'(JD\xd2BAU\x80\xa2\xe4\xd9r\xb5}\xa1P\x1b\xe2\x8a\xca\xd8Y\xa0aEY\x8e\xeb\x92Z\xb1iP\x8cY\xc2[\x885\xa7\xc2gd\\\xfe\x96\xb3%\xc7B<G\\鸥\xd9+\xc2uT\xa5\xa5\x05\x8d\xd6Xb\x1e\rC\r\x87\x81\x14ò\xc4<3ψ\x13w\xfa\x18\xaa2\xf37\xd3\x06{:\x99t]\tk\x10\xe2@\xf48\x90\f\xdf\xd5\xf4>\x89~\x90^\x14\x8c\xf1\xd8\x0e&\xc1)\xe5ɾ\xc9\ue38c\xd4j<\xb1\x8b\xd2I9\x85\xe8\x0f\xa0\xae\x02\xe0\x1c\xf4:\r}\xa8\xb6!\xb6\xd0\x0fā\x19\xecڴϕ\x9d\x0em\xe2?\\X\xbe7\x9b\x00\xdf\xef\xd0k\x9c1\x9aw{4\\\xb5\xfd.\xb5<\xfe\xb9\x8e\xad\x99ơ\x1e\x0f\xfb\xb7\xa6\xdc\xf5\xb2\x01u\xf9\xba\xcf\x0e\x879\xdbR\xef\xba<\xf4O\xaf\xcf}\x97\xc3K\"\xca\x02\x99\x8c\n/L~\x84\xba\t\xa9Q2F\x05+pZ\xb0e\x1c)\x140\t\xf4Y4\xac\xf3Qog\xc4\x0f\x02\xd5µq9T\x8dIw\xe4\x10랩\xe7\xb8\xdd\xe56\xd6\xe8\x8d\xfdu\xbf7ս\xc18\x9aN&\x7f\x88\x92\xf6\xe5\xa5\xe3\x98XleTw\xcbhw.\x18\xf3\xabL=@\x98\x06\x96\x8aZJ\xed%\a+\xd5ޛ\x8c\x1bH\xaa\xc8\xdeoK\xec\xef\xf7\x19\x12\x18\"Q⌠\xe2\x7f2F\x17d\x19=\v\xb6q+d\xe7\xa0$\xc74J\xce:QK\xce\xca\xe6\xa87\xba6\xecA)\xa05Z\x90eő\x9eт\x148i\xf3\x99s\x8c>\x9d\xf5)\xb9\xce\xd5]\xe4\xdf]\xcb\xf5\x1a\xd1\x1c\x94\xa8\xc3\xf4\xe3xY\x15\x88{z\xe5X\xb7\\\xbb\x15\r\x0e\xac\x0e\xd72\xaao\x91\xdf\x0f\xf4\x85\x8a\x12c\xae\xeaZ}\xec\xf4!\x8anti\xf3ؖ6\xfd\x95Ms\xcfY\x87\xc3NQ\xa3\xf8\xea\xb2L\fկ\"j]~x\x876\xfbJ\x15\x05\xae+\x85_)\xae\x8b\x95z\xb0.Q\x9a+~J\x9cRꅭ\at\xb6Q\xb8\xb6\xbah҅\xd1\xcce^+\xa3\xeb\x1c\xc5;Iq\xd9OUʪtg\x8bF\xb9\x19D\x15\xcd\xf1\x82Pu\x06\xeb\xb5\xc7u\x9d\x891w\x85\xe5\x821\xfd\x93\xab\x8aS\x01~\xabPA䶮N'\xb6.o%\xf8\xe39\xe9;)\xf2\xaffP\xb7\x19\x94i\xecߗ\xb7\xcb\xc4\xef\f\xf62\xaeʐ\xdf\xf3\xadĢ6\x98\xfe\xebZ5\x83\x95=\x87\xce\x1e\xe9k,\x04Z\x1a\xd0arr\xb6\xa1\x0fJR\x17\x89\xc8-\xce{\xa49p\xd0\xdfW\xce\xd6\x17_T\x1b\xd7wx\xb7\xb7[j6'\xae)\x0ez/^\x8f\xa6u?\xda=\xcc\xec>\xb1\xecDR\xc7ګ\xe3\x13\xd0-#9\xac*\x9as\x9c\v`\v\xa0x\x03\xeaPЭma\x97b\x0e\x84\x02\x82\xdf*\x92}\x02Q\":\x04\"aC\x8a\x02\xe6\x18\n\xb2&\x12\xe7\xa9fM\xf1F\xafں\xeeP盱\xbe\x88\xab\x98\xe8\"\xc9+*0\x87\x99\x1e\xfc\xa0Qn<\x80\xd1;-+\xa16\x1d\xccӷv\xb0\xb9Ya\xba\x11p\xaa\xf1\xf7\xf6\x802Faf\xd0^\xd4\x1b\xceY\xeb~A\xbdmu\xb0]\x10\xd5)xDJ Q\xa2\xf73/\x1d\xd6\xfd\x9b\x1e\x1aw=K\xf5E©\xc0\xe7V#\xadW\xac2\xbb\x8b\xdf\xccn\x96\x97y\xaeJ\x86\xe4@\x1eV\x8d\x96\x06\xe31\xfcM\xdd\xf4;\x88IN\x84ݰ\xeb\xf6\x91\xb9&8lMl\xf0\x00;V\xd1\x1c\x99@\x1f\xec>\x83?dPg\r\xffʖ\xb6\xae\xb2L\xe3\xe07L\xe2V\xdb\xdfF\xb6\xbd\x01\xf1\x00\x7f\xbf\xe5\xba!\xd9\xcaP\xad\x90\x18ImM\x1d\xb3\xae\xc8I\xce \x9cs*\x19+\f\"\xfe-V\xf4\x899r\xefR\xf2\f\x06G\xdb\xc1z\x02\xe7ֱ\x1d\x16\xd0{\xe5\xa1Q\xd6\xe6\xd7\xc9\xea\xa8p\xf19֑\xdbf9\bOe\xfcE\x8a\xf55%\xfdbQ\x02\x9f\x95\xec7\xd8܆P)P\xfd\xc44\xdf\xed\xfcy{\x80\xed\xf5i}v\xd4\xecȥA'\xe8@?\x84{c\xe0\toG<\xd4\v\xbb\xdcv\x18u\xf8@\xbd\xe6եb\xfd\x06C\xb8[\xb7Y&I\xb2\xdbAm\xb1\xf2+\xd7\xe4!\xe4\xbaz|@n\x8f\xe1\x0f\xb4\xbc\xc0u[&\xf1\xb7[u\xa7\xe00*稺\xd08\xd4M\x1e\x93\x16\xfdQ+Dx\xb7\x9a\xfd\xb2$\xe0\xa9\xf7\x9b\xee\x9a\xe7\xf0(\xe5\xb6x9\xde^m\xca\xc0fuMt\xa0\xddZ\xcc:\xf8\x1ce?\x8f]\xbf\r\x1d\xefЎ\xadj\xee\x18[\xae\x99\xca\xf8\x9d\xf9\xb7Uc\xa8#\xf6WZ'y\xb8\x8d\xba\xd9\xef\xe7|\x94\xd5v\x05ؾ\xc9\x1e\t;F\xda9y\xf1j\xbf\xfa\xd7S\x98\x066\xad\x01\xe7\xf0xbs\xfa\xd5\x02\xd8-\xe6\xf0x\xa2贺b\b\x8c\x16[Po@\xc3\xe3I\n\xff\xa5\x8a\xcd%\x96\xc0\xb1z\xa3\x8f\xd0%P|'\xa1DB\xa4\xedC*[\x1b\xbd\xe2l\xfd\x9e\x95\xef\xf5\xfb\x84\xfeF\xd2u\x9a\xb0\xbbg\x1ct\xcc^\xdbv\xef)\xbbF'e7\\\xe3\x10\xc8T\xbaT\xe78\xb0@\xfa\xfc\x06\xccs\xf7\xc9\xc5\xf9\x98\xf4\x13\xaaJ\x05\xd4+[#[n8F\xb6L\x01\xc9\xca\x13s)hvrr\xf1\vC9\xa1\xcb4M\xcfǊt\xef\xe1\xbd9hrQr\xf20\xae\xb7w\x1d\x80݊\xc2\x03(T\xb6<\x01]q\xceNF\xd3\xc9\x01$.A\x1cN\xe6NԚZ\xf7\xc4\xd9t^I\xc9(HB\xb7\x80\n\xcc\xe5\xc9\xc5\xcb\x1a\xab\xf7\x18\xad\xeb4l\xdf\x05\x90\xddPD\xe5\xbf#\xf1ߑ\xf8;E\xe2n\xd9u\x1f\xb67^\x14\x18Ѫ\x84w\xac\x92\x84\xe2\xc1\x1741Ty\x1a41\xba\x1fl\xd5#UVT9\x16qd\xe3#\xf2+S\xc5ƾ\xec.\xe2\xa6I0\x84n\xden_N\x82\x9c\xff@3\xa5ow#\x8b\xf8\x90\x19\xe8V]\x13\xdbitL#\xa7-\x13\xe0\x00\x91\xa1\xb4݉4oB\xdc'`O\x96/s\xd59\x16\x12S\xcc\x05H\x06M\x88\x81\t-\xfd5\x03\x9b~\x18\x8dO֬\x12\xb8*O\x86\x9e\xdf\xc1\xef\a4\xa7\xc4v\x8bU\xcd_ë\xf5\x91\x81\x01@Ʉ\xbc|{e\xba\xb8b쳁\xcfz0zf\x89\x836Cr\xefuy\xcd\a9L\x9b\xceZّԽ\xeb\x9c\b՜sﭐ\x85\xa5Rnz\xec_-\xb0\x94Ƣ\x97R\xe2u)\x9b\x8f\x83\xb4<\x13 \xbfĔ\xd4\x02\xee\xed\xf9N2\x18\xa8WN]\xeda\xbe\xa1\xd1]\x97\x18\x98\xba\xe8̸\x12\xe9~\x85\xf9\x16^\xdac\x88\x81[o\xa3\u070e\xb4\xdd\x02\x9e\xf9?\x9b\x17DRa\x18\x8e\xc8z\xd9\xfd\x06\nYľ\x96F\x15\xffE\x96@\xe4H\xf1\xb3\xcc\xf6\xbd\xa1\xdfKd<)x\x16\r#\xb2^\x8e\xab2-ݗSگ\xd3\xff\xbe\x92U7\xb8\x91=\x18\x00 Α{\xa5\xb1+\xb1-\xb1\xd4+\xf5\x16\x15\x97\x0f\xa0\xf6\x16ن\x87'l\xa9\xd6\x1f*\x94\x0fb\xa7\xf6\x95\xf8\x05\v\xf1~\xa5\xba\xac\x1aoX\xcbԴ\xbbR#\xdbWB5NO\xa05\xbe\xd6\x01\xea\xc5\xd9\xd5\xdb&\xc2H\xf9\x15c\x8b\x94G\xf9\x96\x94\xfb\xbc\xfa`<\xfdS\xa5}\x8d\x18jR\xfd\xde\xd8!\xe5?\x1c5\xad\x80PM\x86&$l\x97\xe2k\x05\x85\x12w\x94\xa3\xda\x04G\a\xc6?]\xe2\xd7\b\x0e땽\x91\xb1\x16\xcb\x7f84\xbe \x9f\xb8\xdeJ\x13B^\xa3\xe6k\x85\x91\x13y\x94c\xbb\x88\x8e\x0e\xa7\xdfM\xf2\xd7\b+\xcfS\xff2\xa1\xe5\x82$P\xa0\xb0\xb2_Y\xa0\xafC\x85\x8d\x16\xee\xe6L\x7f\xb8\xf8\x90\xfa\x1bf\x81[w\v9S\xe7\xe9\x99a\n3O`\xf3^\xe2\x82q{\xee9\x83ə\xf94\x15\x9c;\";pz\xeaԐ\xeb\xf2o\xa8\bx\xf9g\xa2r]\xc2\f\x90?\xec\n\xda\xfe\xa9\x19%>\xc2\xccJ\x1f\xc1\xf4\f>\xc2\x05\x8c\xa6\xf0\xc7?\xc27m\x03ƞ\xec\x8f7)\xa1\x14\xf3\xf7\xf8N\x0e\xadv\xcdHr\x06\x1fG\xa3F\x0e\xf8j\x7f<\x9dބ\x13\xf9xS\xe3!\x1f\x05\x85\xd0\xfb\xaeZ{\xef\x14\xfeEg`|\xb3\xcb\xd0(1\xd8\xe1\"ץ\x8d)s\x84o\xa0\xc1ͪ`\xb5\xc5h\b\xf3:\xb6\xed]\x11\xa4_\r0\x1f\xb7\x88TGߎ\xcf\xfd\xf1\xf0\xf5]\x98X\xb1d\x11\xa3\xf6\x81\xc0\xbc\xff\xb5_K7\x00@\xd7eA\xa42D*\xd4o\xea\xf6s\xa2\xc6\xd5gn`f\xe1ꞯ\x05\x83\x01\x9bX\xcf\x18\xbd\xc5*zM\xc3^\x13}\x98\xdc\f\r\xf9\x87\xe9\x8d\xce\"s'c\x1eʘ[\x19\xf3n\x19\xf3N\x19\xf3Z\xc6ܗ\xa1\f\xa0\xf0\xcf5Yk\xb6\xd3\xd09\x93A\xe0\x19R\xfe\x0e\x8e\x199\x99\xb5]ͳ\xfd\xdc\xffS\x81M\x02BMޙ\x9b\x91y3\xa2\xe6\xa6\x06\xcf5\xacsn._\xd9\\\x05\xe7\x9a\xf1\x19\x90\xd3S\xfbdM\x16\xf1\x9bj=\xc7<\x9e\x7f 7\xa6\xcd\xf1\x06\xbd\x89\xda\xf7\x98`\xea/\xe2\x86\n\x85T-\xa2\x89\xbfn\xdaD\xe7\xe0K>N\xe0EH\xdb#\xb6}\x8dq\xf7Y\xccs,\xbaƙ\x1fW暩\x88\x91\xf6O\x0fp\x9e\f\xdaWD\xd1P\xb3\x1aF\x7f\x8f\x86\U000e19b4\xb5\x8d\x910S9N\xad\xc3\xfa\xaf\xbe\x18q$\xe73å\xe5a}\xdf\xc7n[~nuFP\xf0Wn\xe3\v\xec\xb03\rIָ\x1d\xdej\xec\x90H6\x9f\xfe\xd1|`\xa6\xa9\xbc\xf5zfXZ\xb8\xcb<\xe7\xf0\xb8\x9b\xdb\x00\xec\xc1Q\xfd\x168\x82\t\xac\t\x1d\xaf\xf88W5\x00\x91 V\xac*r\x10R\x9f\x1d\xe97\xaa\xb9!\x94+D\xa1`\x1b\xcc!ǔ\xad\t\xd5\xeeNU_L\x1d-M!c\xfa\xb3Gr\x85a\xa2\xefO\x0e\xc0)\xffarsz\x1a\xa8\xabROӸ\x148\x8b\x92\x96\xda\r\xa9\xbaV\x1b|\xbb\xb4\x93ǚ\xd0\xfd<\x9eN\x1ef\xb2\xe2\xfby<y:9\x80K\x8e\xb6\xfb\xd9\xfc\xe9\xe9\xf7\x93I\x7f蘴K\xf5*\x1c\x82\x89\x91:\x84̟\x9e\xb4\x9f\x9f\xef\b3\xa4\xe6:rK\xdf6\xf5\xeb\a\xa8\x1fd\xf0\xe7\xc3\x18\xb4gj\x1a\xd2+\xb4\x15\x12\xa9oNP\x8c\xf3\xa2.\xc3T\xe0\x13\x98\x81\x83\xdb\xe8>\xd3\xc0͊\x14\x18b\x12\xd4\"\xfas\x1c\x16\xfb\x03\xb9\x81\xd9l\xd6\xe2\tA\x1eSE\xdf\xd9\x00v\xbb\xf7\x16\xae\xcbڳ@\xeb%\x96Wo\xf5=V\xbe\x8d\x91\xbdH\xf6y\x00\xe3\xef\xe0[U\xf7\xab\xeb\xb2\xf1\x89\xfd\xec\x00)\t]\xb0\x94\xb0\xf1\t\x9c\x82ņS8\xf1\x9f\xdf\xd4яM\xb0~\x9aS\xc3if\x04\xf9_\xbd\x81\xe8\xea\xfa\xad\xbe\x8f\xaf1\x18_\xea\xb7,\xe0WN\x96\x846\x00K\xaa\x81\x91\xee\xae~7v\x9f\xe2Uo@\x82\xdc0(ؒ\bI\xb2Z\x1b\xd1\xcc4\xbc\x81\xf2\x9b\x7f\x1b\x87,\xdc\xdfp1\xf3_5s*rD?\x8d\x96\xfa\x03\xb7A\xe0xT\xa3\x1fz\xa8X\x91G=)\xd7`pl\x10\x02\xbf\xf8\x17\x18\xe6\xea\xdf!\xac\xed\x85\x05\xa73\x18\x80\xda\x13\xea;\xc1j\xa3px\x01\xa0\xad\xdb\x04\xe2\t\xfc<7\xa6\x1c\x00\xccaVo\x91\x9a\xeb\x18\xa6\xf8\xf4IR\x7f\x03b\xea\xbe\x0f3\x87s\xdfD\x8ap\xae\xbc\x02??\x0f\x8c\x03\xb1\xcf\xe9i\xb2K\xb6+\xefiK\x9e\xcf\xfe\xf5\xf3\x1d3\xf6\xb1\xf9q\x0f\x9b??wS^ì\xb6\x95\x9d\xdbڼiXk\xb9n\xd8;L\x18\x1b\x8c\xb6\x04\xcd\xcd\xd8!\n\xebD=\xaa\x03yn\xa3\xf7~\xf0\xbf\x03\x00+\x7f\x06\x94\x88^\x00\x00",
+		hash:  "4fe806fd2c4b82420d1a8a8d74ee141ea6b348c223837f3cf6cdf4d618bbf0f1",
 		mime:  "application/javascript",
-		mtime: time.Unix(1542240436, 0),
-		size:  20306,
+		mtime: time.Unix(1792409814, 0),
+		size:  24200,
 	},
 	"js/factomd-ajax.js": {
-		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xdcW]o\xdb6\x14}\u05ef\xb8e\x82\x85Be9[\x06\fh\xaa\x06\xe8ڭ\x18\xb2tk:`\xaf\xb4tm1\x96I\x85\xa4b\x1b\xab\xff\xfb@\x8a\xb2%[\xfe\xc8\n\xec\xa1\x0f\x05R\xf1\xf0~\x9e{\x0f=\xaeDj\xb8\x14\xf0X\xa1Z\xde\x1bf\x90r\x83\xb3\b\x9eXQa\x04\x16\x10\xc2?\x01\xc0\x13S\xa0\xf0\x11\x12\x108\x87\xbf\u007f\xbf\xfd`L\xf9\t\x1f+Ԇ\x86A\x00\xf64\x96B!˖\xdaZJs&&\b\t4^hm\t\x80\x8f\xa9\x05;\xa8s\nI\x02?6\xa7\x00\xc3a*\x85\x96\x05ƅ\x9c\xb8\x80\xe0%\x10\x18\x00\x81\x97P\xdfԥ\x14\x1aC\u007f\xc1z\xa0\xbb\a\xab\xa0\xfe\xe7\"+QP\xf2\xeb\xfb\xcf$\x02\x12\x0f\xc7,5r\x96\xddX\xe3\x895\xdbx\xf9\xcee\xee>\xf9\x1a\x18U9{֊F\x91\xd10X\x05\xc1\xbat#f\xd2\xfc\xcf\xed\xfa}\xeb\x85{k\xb3\xbeq\xb9\xaf˷\xafT甜\xd5\xd7\x06\x1a\x99Js\x12\xc6i\xc1\xd3)\xddJ\U0001c4b8\x03\x1c\xa0RR\x910\xd6\x05\xcf\xf0\xaf\x92\xc2\xd5\xe5%\x84\xc1*\xec\xb1:\xd0\xd5h\xc6\xcd>\xe35\xe8-S\xf7\x0eF\x9d\x95]\x8f\xa9\x14\x86q\x81\xd6\xeb\x14\x97\xa5B\xad7\xa6p\xd3\xd3).!\x01\x8c\xe79Os\xf8\xf2\x05\xd0\xe2\u007f\x96\x19^\a\xb6S@_P\x87I\xe0\xfb\xab\xb0\xe9\x91BS)\xe1\xcb\xdb\x1b҆Y;\xc7kߋ}l\x02X\x9cN\xa5\xc5~\"\xb5i\xb4\xd8a\x8d\x1c=@\x02\xbf\xdd\u007f\xbc\x8bK\xa64\xf6@l\xfer\xf4\x10\u007f^\x96\xce6\xc9F\x85L\xa7\x1f\x90OrC6\x8e\x00\xe6\\dr\x1e\x172e.\xeb\x04H\x9d\xf8\r\x17ee\x1c\xbb\xac\xa5\xf5\x80\x9ae\x89Im\x8ex++\xc0Bc\xd7\xe9\x8b\x04ȝ\x14\xf8lg}t}b\x05\r7ޛ\x98\xac\xa3\xc6\xf6p\xa80\xe3\nSC\xbf\xdaf\x04\xa4\x94ڐ\bZ\x95\x85\xe1\x10\xee\xe5\fM\xce\xc5\x04Ʋ\x12Y7\xfdM\x9aG\x06靜\vzuy\x19\xae/\x1c\xee\xf7\xaa\xb3\x14,\x01\xc7R\xcd\xde1\xc3<\x0f\u007f\xf1\xff\xa5\xa1\xe5~s\x18\xb3\xb2\xb4K\x80ؘef\xf7G\x93|\x1fʟE\xfb\x8b\xe5\xb6\xe5\xc2o\xa4?>\xde\xfb\x95\xe4JUs\xdf-\x9dƲ[>\xed=Q\xc8ɑ%\x01PO̭\x9cL\xb8\x98lO\xe4֡\xbfrd\"O\x9f\xc9\xe3syJ\xb7N\x9e\xd1\ue93e\xb7\fq\xa3J\xec6\xeb|\xaaD\x86c.0ێd\x8bl\xb6\xc0\r\xd3r\x9e!\r\x8f\xa1u\x95\xa6\xa8\xb5ef.\xe7\xfd\xf8\xb3\xac\x9a\x95?\xc1YZ)\x85¸{N\x9bI\x18\x1b\\\x18\xba\xddb4\x1bƴm\xed\xcc\xc9ѠNKb=\\\xbb)\xac\x82\xee_\xab5c\x0e\x8e\xd0\xc1!\xaa\tTȉ&a?֞\xa11\\L\xba\xe3\xb4S\x9d\x86\x9e\xfb&\xaa\u007f\xa6\xce)\x19\xc9lI\xc2X\nz1\x93\x95ƪ\xbc\x88\x88\xc6zL\xb6t\xb9\xe0bJ\xa2m\r5N\x19\xe0\xc1=\x9d\xa8ɹ\x0ecf\x8c\xa2Ğ8\xef9\xd3\xf96\xc45<\xfc\xbft\xf0\xb9J\xd7+:|L\x9bg\x82}\f\x84m\xfe\x9d\"H\xae\f\x1d\x9d0-\xddو_\xdb\xcb\x0f\xddI\xf5n\xea>\x0fO\xf4\xe0\x99إ\xf1A\x99\xeb\xb7\xf3\xdfԌ\x8f\xbb\x0f\b]b\xcaY1`\xae\u007f\x831K\xa7$|\x9e\xb2\x1f,\xe4\u05cbh\xf7\xf5\xfd\x1c\x19\xbd\xe5bzPJ-\xe049\xed גj3ߋ\x9a\n9\x17$\xaa{\xfe<\x89\xb5vj\x8d\x1c\x0e\xe1\x93'\x06̹\xc9\xc1^\xb1JeP\x98\x8d\x82\xae\xc9S\xa9\"\x82:\x93\xa8\x81m\x1e\xb8\xaeg\x90\xd8\x1e\xbcv\u007f\xbf!\x9d\xed\x10\x01\xc9y\x96\xa1\xf0\xab\xac1\xe01\x82\xcd\x1c\xc6\u007f&\xed}qN/^\xdb\xf0\xdf\\D\xebf\xd7q\xbcj\xe2\xf1_k\xa6\xbd\x82J\x15\xb6gu\xfa\xbeh.(_\x10\xff:\xbf\x0eV\xd7A\xeb\xb1 pa\xee\xa4\xd5\x0f\xe7ǲ\x01\x92\xf6/m\xd2 HDZ\xfb\xd1\x02=\xb1\xed\xeanTO\xc8\f\a\xa2\x9a\x8d\xdcO\x13\xb7\x06\x1d\xb2\x0em\x15\xfc\x1b\x00\x00\xff\xff\x10\xd9\xean\xcc\x0f\x00\x00",
-		hash:  "8e9f386a3643a9ca85db2167e59ce37989a0086b660fc67142c344e25f5172e5",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xdcXk\x8f\xd3F\x17\xfe\xee_q\x18V\uf385c//\x95*\x01\x06qk\xa1\xa2@Y*\xf5\xeb\xc4>\x89g\xe3̘\x99\xf1&Q\xc9\x7f\xaf\xce\xf8\x928\x97\xddl\x91Z\xa9\x1f\x90\x82\xe7\xf1\xb9>\xe7<\xe3\x9d\xd4*sR+\xf8Z\xa3Y]:\xe1\x90K\x87\xf3\b\xaeEYc\x04\x04\b\xe1\xcf\x00\xe0Z\x180\xf8\x15RP\xb8\x80?~}\xffֹ\xea3~\xad\xd1:\x1e\x06\x01\xd0i\xac\x95A\x91\xaf,Y\xca\n\xa1\xa6\b)t^xc\t@N8\x81=\xd4;\x854\x85\x1f\xbaS\x80$ɴ\xb2\xbaĸ\xd4S\x1f\x10<\x00\x06#`\xf0\x00\x9a7m\xa5\x95Ű}\x81<\xf0\xfd\x83u\xd0\xfc\xf3\x91U\xa88\xfb\xf9\xcd\x17\x16\x01\x8b\x93\x89Ȝ\x9e\xe7\xcf\xc9xJf;/\xff\xf3\x99\xfbGm\r\x9c\xa91l\xadXT9\x0f\x83u\x10$\tTں\x17\x9f\xde\x01=\xb4 \xe0\x97ˏ\x1f\xc04%\x01\xa7A\xc0\x1c]\xa1s\xd0\x13p\x05\u008bO\xef\"\xb0\x88 *\x19Ou\xd0W\xbf5\xc4\x1bx\x04c\x9d\xaf\xfe\xad\xda\xf7\xa5$;\xb5\x8d\xe0\x94\xb2~\xfax\xd9\xd6UT2\xb9~\x98P\xf9\xbal\x86\xf5sm\xdcoQ\xe4h8{\xa5\x95C\xe5F_V\x15\x92\tQU\xa5\xcc\x04Ŝ\\Y\xadؠ\xf4T\xe2\xd8:#\xd5TNV\x9c\n\x15\xfav\xf4\xb5\x1c\v\x97\x15\xbf\xed\xd2\xf9\xbf\xce㗔\xf5s\x9f{\xcf\xe6c\xcc=\xe3\xec~\xf3\xdaȢ0Y\xc1\xc28+e6\xe3;\t\x9eq\x16\x0f\x80#4F\x1b\x16ƶ\x949\xfe^qxtq\x01a\xb0\x0e\x0fX\x1d\xd9z<\x97\xee\x98\xf1\x06\xf4R\x98K\x0f\xe3\xdeʾ\xc7L+'\xa4B\xf2:\xc3Ue\xd0ڍ)\xdc\xf4t\x86+H\x01\xe3E!\xb3\x02\xbe}\x03$\xfc+\x9d㓀:\x05\xfc\x1e\xf7\x98\x14\x1e>\n\xbb\x1e\x19t\xb5Qmy\x0f\x86\xb4a\xd6\xdeq\xef{y\x8cM\x00\xcbө\xb4<N\xa4m\x1a-\xf7X\xa3\xc7W\x90\xfa\xed\x13W\xc2X<\x00\xa1\xfc\xf5\xf8*\xa6)#\xdb,\x1f\x97:\x9b\xbdE9-\x1c\xdb8\x02XH\x95\xebE\\\xeaf\x06!\x05\xd6$\xfe\\\xaa\xaav\x9e]d\xa9ߗnUaژc\xad\x955`iq\xe8\xf4^\n\xec\x83Vxgg\x87\xe8z-J\x1en\xbcw1\x91\xa3\xcev\x92\x18̥\xc1\xcc\xf1\xef\xb6\x19\x01\xa3\x15\xcd\"ت,$\t\\jZrRMa\xa2k\x95\x0f\xd3ߤy\xcb \xbd\xd6\v\xc5\x1f]\\\x84\xfd\v7\xf7{=X\nD\xc0\x896\xf3\xd7\u0089\x96\x87?\xb5\xff\xe5!q\xbf;\x8cEU\xd1\x12`\xcdb\xa6\xfd\xd1%\x7f\b՞Eǋ\xe5\xb7\xe5rO\x02|\xa9\x1a\xee\xfb\xa5\xd3Y\xf6\xcbg{O\x94zz˒\x00h&潞N\xa5\x9a\xeeN\xe4\xcea\xfb\xca-\x13y\xfaL\xde>\x97\xa7t\xeb\xe4\x19\x1dN\xea\x1bb\x88\x1fUF\xdbl\xf0\xa8V9N\xa4\xc2|7\x92\x1d\xb2Q\x81;\xa6\x152G\x1eކ\xb6u\x96\xa1\xb5\xc4\xccB/\x0e\xe3\xef\xe7\xf5\xbc\xfa\x11\xeeg\xb51\xa4\xdc\xf4\x9e\xbf*\xb10v\xb8t|\xb7\xc5\xe86\x8cٶ\xb57'\xb7\x06uZ\x12\xfdp\xed\xa7\xb0\x0e\x86\xbf\xd6=cn\x1c\xa1\x1b\x87\xa8!P\xa9\xa7\x96\x85\x87\xb1t\x86\xceI5\x1d\x8e\xd3^u:z\x1e\x9b\xa8\xc33u\xc6\x19]\x84X\x18k\xc5\xcf纶XW\xe7\x11\xb3،Ɏ.\x97R\xcdX\xb4\xab\xa1\xce+\x03\\\xf9\xab\x13w\x85\xb4a,\x9c3\x9cщ\xf7^\b[\xecB|\xc3\xc3\x7fJ\a\xef\xaat\aEGNxwM\xa0\xcb@\xb899M\x90|\x19\x06:\xe1\xb6tg#~\xdb^\xfe\x1f\xc2\x017M\x9f\x93\x13=\xb4L\x1c\xd2\xf8F\x99;l\xe7繁\x9c\f/\x10\xb6\xc2L\x8ar$|\xffF\x13\x91\xcdXx7e\xbf\xb1\x90\xdf/\xa2\xc3\xdb\xf7]d\xf4\xbdT\xb3\x1b\xa5\x94\x00\xa7\xc9\xe9\x00\xd9K*e~\x145Sz\xa1X\xd4\xf4\xfcn\x12Kv\x1a\x8dL\x12\xf8\xdc\x12\x03\x16\xd2\x15\xfe\x1b\x13\xb2\xe6ck\xa3\xa0=yjSF\xfd\x17[\v\xdb\\p}\xcf \xa5\x1e<\xf5\xbf\x9f\xb1\xc1v\x88\x80\x152\xcfQ\xb5\xab\xac3\xd0b\x94\x98{L\xfb\x98m\xef\x8b3~\xfe\x94\xc2\x7fv\x1e\xf5\xcdn\xe2x\xdc\xc5\xd3>m\x98\xf6\x18jSRϚ\xf4ۢ\xf9\xa0ڂ\xb4\xb7\xf3'\xc1\xfaI\xb0uYP\xb8t\x1f4\xe9\x87\xf7Cl\x80t\xfb\x0f\x1f\xacC\xb0\x88m\xedG\x02\xb6Ħ\xd5ݩ\x9e\xd29\x8eT=\x1f\xfbO\x13\xbf\x06=\xb2\tm\x1d\xfc5\x00\t>`\v[\x11\x00\x00",
+		hash:  "16b0273ae348171bc617a91fe4c690ad92d0a3d394f4177a6adfbd9bd4d91606",
 		mime:  "application/javascript",
-		mtime: time.Unix(1539243717, 0),
-		size:  4044,
+		mtime: time.Unix(1792409814, 0),
+		size:  4443,
 	},
 	"js/searches/tools.js": {
 		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xb4S\xcbn\xdb0\x10<[_\xb1\x90\x03\x84\x84b\xd9\xce!\x87:2\x10\xa4(\xdcSQ\xb4?\xa0\x88+k[\x9b\f\x96\xebDF\xe1\u007f/\xa8\x87\x1d'qR\xa0\b\x0f\x82D\xcd\xcc\xce,\xb9g*\x1e\xa2\x15ގ\xb0\x16d\x9b\xafFd`\x0eC\xb4\x853d\x97\xb1N\x8b\x15\x15\xbfU\xb9\xb1\x85\x90\xb3Jß\b\xa0\xff\x0f\x19\xfc\xfa\xbeA\xde*\xa9\xc8\xebT\xb0\x16\xa5#\x00*U\x8fI\xc9\x1a\xac\xbf\x95*^`\x1dk\x98\xc3h\xaa\x83\xc8\xe0\x88Z\x925*\xce\xe3N$\xbe\xf1\x05\xd1'\x88\x83\xda\xc0\vC\x06\x85\xb3\x0f\xc8\xf2\x85\xddz\x81\xb5:\xa2\xdf\xe7\x8cVT\xaf34\xb9쵴\x8e\x06\xff\f\xf6¡\xe4\x0ep\xe5\x11\xa8\x84W\x824\xde\x0eQ\xde\x0e\xb2\xc0\x1a\x9a\x1c\xc7)~\xba\x8f\xcf\x10\xedt\x14\xf5G\xf7\xbc}\x15\xd6\xeda\x02<\xe4\f\x15\u0590\x85g*\xee\x870٥ҳ\xf1\xb8t\\`G\xf5\xe4\xec\x1e߆9?\x9f5;\xa5cPa\x9b \x83\xc9\f\b\xae\x1b\xad\x15ڥT\xe1;\xc9\xe0R7ذ\x02;ɠ-\x94\x96\xecַUηΠ\xba\xcf\xd9\xe3W+\xc1`\xea7w^X\xd1\x05\\\xea\v\x98^i\xdd\xd6c\x94\r\xdb 3\x8bv/3\xb6\xcd\rmx\x91\xf0\x89\xe3\xd6p6\x99ѵ\x17\xee\xbdR\x92\xf4\xac\xb0\x02+\t\xb4$`\x8a\xce\xe5\x8d(҇NM\xaf:_\xbb\xa7\xee*\xac\x1bwg*\x96n\xac¬\x15\xce\nZ\x19\xf9\xcdz\x9d\xf3\x16搟\x9a31\xcf'l\u007f\xec\xfdK\x83\xea/\xc1\xab\x05b\x9dVd\xf0M\xe8\x9d3\x01\xe7+\xf7\xa8\xf4x\xecWd\xf0\xb3{\xb4j:\x99\xe8\xe6\x1a\x9d\b\x11\x88\xff\x9f\xe0]c\xef\x068d=\x99\xe1o\x00\x00\x00\xff\xff+jE\xfe\xee\x04\x00\x00",
@@ -44,6 +44,13 @@ var staticFiles = map[string]*staticFilesFile{
 		mtime: time.Unix(1539243717, 0),
 		size:  269,
 	},
+	"index/authorities.html": {
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xdcV]o\xdb6\x14}\xf7\xaf8\xe0\xcb^f\xcbNӮk%\x03\t\xd6b\x0f-\x064\xfb\x03\xb4x-]\x84\"\x05\x92\xb2#\x04\xf9\xef\x83(G\xf0\x87\x1c\x1bذ\x01{1.yϥ\xccs\xee\a\x9f\x9f\x15\xad\xd9\x10\x84lBi\x1d\a&/^^&\xa9\xa7<\xb05`\x95\x1d\xf8\x90k\xe9}&JV$\x96\x13\x00H\x15o^\xb7\x9d\xdd\xeev\x8f=\xb9\xd5Me\xfc\x9e\x17\x00\xd2r\xb1\xbc\xdb\x1d\xdf\xe2\x81܆\x9cO\x93rq\x04\xab\x0f\xd7\x00\xf0\xbb݂d^bM\x8a\x9c\f\xa4 \x8d\x82l\x14\a\xf8x\x12jrk\xeb*R\xb0\xdd2\x94\x04-}89+eS7\x01\xa1\xad)\x13\xa6\xa9V\xe4\xc4\xc1\xd5\xdb\xe9J\xdb\xfc\xd1\vTl2\xb1\x10\xa8\xe4S&\x16\xf3\xb9\xc0F\xea\x862q\xf3^\xc0\x87VS&\x14\xfbZ\xcb\xf6\x13\x1b͆>c\xcb*\x94\x9f>\xce\xeb\xa7\xcf\xe2\xf4\"\xfdɰ\x8dC\xedlN\xdeC\xb3\x0f\xf0r;\x03\xfe\xe4\x8a<\xa4#\xb0A\xc5Z\xb3\xa7\xdc\x1a\xe5g\xc0\x1d\xb4,\xc0\x1e\xa5\xddB[S@\xae\xc3\xee\x9ekv\xfe\x95\x87\x9f\xfc\xc9G\xbf\xfc\xf1\x1d\xd6\xe1\xb7\xfb\a.\"\xfe\x15\t\xbb5\x90\xce\xf1\x86\xd4\fx My\x80\xdc\xf9\x11,\xf2R\xba\x00\x0e\x1e%\xfb`];;\xd4*9\x12+\rr\xa5\xe9\x88θ7BF\x1aJ\x92\xeat\xbf\xf7\xb9q\xc7.p\xd9\xe7O\x9a\x84\xf2m\xdc\x0f\xab\xe92\xea+)\xdcGm.c\xef\xaeE\xe1\x9b\fd\xf2\xf62\xb8S\xe8\x9b,.\x03{\r\xaf\x82~g\xefIu\xe2\xfb\xab\xc1\xd7R\xf0U6:\\\x81\xfb\xa2\xfb\xd6\xf2\x064MƔN\x933\xb9\x91\x86\x95U\xedh\xc0\xa9#Mb\xea\x1dmv\x8d\xea0A\x15\x05\xc9z\xac\xdf\x1d\x04\x96\xb7\xa3qӾ\\\xc42M\xcaۑ0-W\xa4\xa3\xc4]\x05\xafڮ\xad4\x81Ҥw\x8c\\q\xa4\x86\xfa\x18/\xceUKd\xab+\x9a\x8e\xf6y\xa4\xbb\xb3\x16\x83u3X\xef\x06\xebv\xb0\xde\x0fև\xc1\xfae\xb0>\x0e֯\xbd\xd5ivV\xa2=\x99\xd2\x1e\x17\x7f\xce\xeav*\xd1ۓ\xe6\x1cJKW\xd0\xf4\x16\x15)n\xaa\xe9\a\xf8Jj=]\xdc`|\x1e\x8d\x88\xd4\x15\xad\ue2f6\x13*v\xeb\xb3:\r\xb1~S\x1c\xa9\x15\xdb\xe6T\xe6\x8fCN\x1d\xf9\x046L\xdb{\xfb\x94\x899\xe6x7\x9f#Η\xdaQ̦;_S\x1e~\xc8\xc06\x13\xc6\x1a\xea\xb2\xcbo\x8as5\xa4x\xf3\xaf\x10\xb4\x97\xc5\x7f\x93\x1c\xb2\xd5\xff\x8d\x9c\xbe9\xff3\xf4\xa8\x95\xe7\xe2?%\xe8\xdcv\xe4e\xd9O\np\xc0Vz\x18\x1b \xf7\x1eg}G\x04\x9b\xf8\x98)\x1c\xb5?\xc7\a\xdbj\x88\xaa\xfa\x81c]\x8c_w\xf3\x84\xd4k\x80#5K\x93\xfeC\x937\xfe\xd3\xderg\xa6\xc9\xee1\xbb\x9c<?\x93Q//\x93\xbf\x06\x00R\x980n\xf8\n\x00\x00",
+		hash:  "f1c28e18916949eda4dfabaaaf3e6796dfa715ae2cd1477d8cbc8f0826c12549",
+		mime:  "text/html; charset=utf-8",
+		mtime: time.Unix(1792404711, 0),
+		size:  2808,
+	},
 	"index/controlPanelScripts.html": {
 		data:  "{{define \"controlPanelScripts\"}}\n\t<script src=\"js/controlPanel.js\"></script>\n{{end}}",
 		hash:  "5fc312858cfbfba3160a20303376141a6fcfb759d1291c25dcb27fe9aecffae0",
@@ -52,25 +59,25 @@ var staticFiles = map[string]*staticFilesFile{
 		size:  0,
 	},
 	"index/datadump.html": {
-		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xe4Y]o\xdb6\x14}\xb6\u007f\x05\xc7b@\x02L6Ҥ-\x1aK\x02\xb6\xb4\x18\n\xa4]Q\x0f{\xa7\xc9+\x890Ej$\xe5\xc4\b\xf2\xdf\aQ\x92E\xdbR\x92\xban\x17\xa0y1\xcd\xcb\xfb\xc1{\xcea(\xf9\xee\x8eA\xc2% ̈%\xac\xcc\v|\u007f?\x0e\rP˕D\x9cE\xce\xf0\xae2 *\x881\x11\xce8\x03\x1c\x8f\x11B(d|\xd5Nku\x83\xe3\xf1hw\x9a*Q\xe6Ҵ&g\xce\xce\xe2\xbfA\xe7\\\x12\x81\xaa\xf0\x88\xe7\x85\xd26\x9cfg\xf1x4\x1a\x85\xa5h\xdd-Y\x18\xec\x16\x05\xd5\xd0U\x04\xb7$/\x04\xb8\t\xec\x1cF\xa1\xe0\xbeG`\xb9\x15\x80\xb8\t\b\xb5|\x058\x0e\t\xca4$\x11~Qm\xf2\f#\xa29\t\f\b\xa0\x16X\x84\xad.\x01\xc7\xf32ω^\x87S\x12\x87S\xc1\x1f\x88\xbd\x1b\xf1%\x8e?kE\xc1\x18t͍= \xc2y\x15\x81K\xfb\x91\x14\ax_\xe0x\x0ez\x05\xdat\xceh\xe7\xefI\x81^\xe3\xf8\xbd\xa8\xe17\a\xd4\xf1\n\xc7WJʽ\x00\a\xd5\xf2\x06\xc7\xd7*Es\xb0\x96\xcbt\xbb\x9cpZ\x8az\xe0\x91ͅ\xa2JZ\x90\xd6cM;\xd5O\x9d]\xff\x82H\x10\x1ewj\x158\xd6\xd4\x1e\xbb\xfcD\x951x\"SG\xa3\xf0\x97 \xf0\xb6\xdf:\xa3\xa7\x10w\x9e)m\x87ț9\r5=\n\x82M\xbe\x03s}!78\xfeBn\x86A\xec\x01\xb3'\xc3\xde\x1e֒r\x99\x0e\xed\xa2\xb6nS\xafC\xbb\xc1\xab\x85\xc4!K\xb8\x04ݵ\x97穳'\xa5\x10\x86j\x00\x19\xa8\xa2\xa2\xe3\xe6\x04#\v\xa3Di!\xe8Yb4\x8d0\xcf\xd3ig\x9b\x98U\x8a\xe3p\xca\xf3\xb4J\xe2\xef\xbc\x06\xd3\u00ad%\x1a\bbܐ\x85\x00\x86L\x01B\xd0\f\xe82\xc2\t\x11\x06\xf0\xd3(V\xe3\x1b\x87\xd36\xa4\x8f\xe3\xf1\xd28h\xbd$\xc3\xc8\x1e\x96\xd3\xdbP\x03\xf6V\xb6\x06R\xc6W\x8d\x04\xbda\xaf\x1a\xbbx/\xfd\u007f$5\x02\xdf&\xc6\xc3\xc5Q\x9d\xf7\x03$\xbe*\xb5\x06i\x8f\xad\x9c\xcf\x1aV\x03\x19+ӱ\xd3}\x82ۡ\xb3\xa62u\xe9\xc6\xcfO\xa4\xc7\x17\x8c\x83\xfbǈ\xc65\xfeǤr\x94:\x968ϟ\x8f8\ax\xbb\xf5\xef\xec' \xedѠ\xbd8\xd2\xdd\xe7@D\u007f/m6\x00ieR\x9a[\x0e;\xf7\xd5ǲ\xed\xe6\xf8\xc0\xaa[c\xec>\x8e\x10\xee\xe3\xfa\x93\xaa\x1e\x94>\xaeQ5x\xbe\xb4\xdb\x12\xec1\xf9\xe7@\xdb'\xe0\xe8\x9b\x0f\xad\x06\xa9\xef\x10\xb9\x05\xedH\xaay\xf5\xff\xaa\xe6Jɯ9\n\xbf\x92\xe0swU\xfd\xcbf\xa0\u007fBr7\xbd\xfd\x1e$\x9c\xef>\x03\fQp\xef6\xf0\b\x1d_\xe3\xfe\x9bġ\xec<\xec\x9a9\xcc\xd7͋\x87\x01ʶ\xf6\xa3?\x91\xf2\xbc\x14\xc4\x02{\xac\x80\u007f\x94\xddz6\x1d\xf7\xb7\xd3ѿ\xd72\xa8\x86\xc1\x8d|_}\x1c\xf9^\xd9'\x94\xae\xa7?\xe8\x89s\x1f\xccG\x13\x0f\xe9\xe90\x99\xbd\xc1\x0f\xa0\xbf\xf5\xb6t\xb0\t\xdeJ\x93\x13!\x02\x95$\x06lp\x86\uabef\x91\xf7^\xb59v\xb2\xf3\xf8*#2\x05$T\x8a\xcc\xe6\xa5Yvޭ\xf1\xe2rY\x946H\xb5*\v\x8c\x8c]\v\x88pNt\xcae  \xb1\x97\xe8կ\xb3.\xfa(4\x05\x91=\xbe\x81 \v\x108\x0e7\x82K\bJH\x90\xf0\xea\x9c\xe8\x9e 3\xce\x18\xc8VH\xe1\x94\xc7\xe1\xb4\n\xe9epQ\x91]\x17\x10a\x03D\xd3\f#Ir\x88\xf0\xbf\xb8/s\xc2A\xb0\xba\xed\t\xa1V\xe5\x81Pi`\xc0bT\bB!S\x82\x81\x8e\xf0\x1c,\xb2Y\xdd\x16\r)\xdcN&\x13\u007fg\xfdM\t\x16\xa5\xb5Jz\vw*,\x179\xb7\xfb\xe9\x9b\xe9&`\x1d\x041\xa2\x97\x18\xad\x88(!\xc2\u007f*?{w\xaa\x0f\x1f\xec[\xdc\xc8.\xda\xf7\v\xc8{;\x1aN\xb3\x8b.L\xa1\xa1Ŵ\x9d[\x10\xba\xac6&Y@\x95P\xfa\x12\xbd\x00\x80ك\xb9\x16J3З謸EF\t\xceЋ\xb7o\xdf>\xecø)\x04Y_\xa2\x85Pt\xf9\xf0ڂ0\xc6ez\x89^\x16\xb7\xb3Y[\xe9\x1e\v]\x8fi\xbdg\xd7d\xd7H\x1c\u007f\xeeP\x9eL&\x93pZhx\xa4y\x1e\xd6\x1eh\xa0\xb5҈\x12!Ti\x11\x11\xa0-\xaa\xb8\x1bP\x90\x16\xf4F\x1fj\x05:\x11\xea\xe6\xb2f\xf3\xacݫT\x12f8~\a\x92\x03{\n\x86\xfde\x98\x92\xba_\x10\xdaB\xda\xef\a\x94r\xed\x1d\x01\xd5`\x8bf\xfe\x97\x87\x8f>\u007fq;h>\xc3i\xf3+Q<\x1e\x87\x86j^\xd8:̊hT\v%BL\xd12\ai')\xd8\xf7\x02\xaa\xe1\x1f\xeb\x0f\xecdW\xb0\xa75O\x9cۄ0\xf6~\x05\xd2^scA\x82>\xc1KX\x97\x05\xfe\r%\xa5t)O\xa0\xb2\x9f\xa2\xbbM\xddnbRh\xf7\xf9\x0e\x12R\n{rڱ\x8f'\xa8v\x9a,a}\xa5\x18\xa0(\x8a\xd0ٹ\x1fñ\xf7)\x05\xd7\x12?\x9dP\xc1\xe9\xd2\xcfr\xefF\xf7\xa7\xb3\xaa;MK\xc6ww \xd9\xfd\xfd\u007f\x01\x00\x00\xff\xff\xc7×ik\x1b\x00\x00",
-		hash:  "d59a387a6b97ac8211d59ab0614b1e34df30e21f5d0729dd347f3c265095fb6f",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xecYmo\xdb6\x10\xfel\xff\n\x8eŀ\x04\x98l\xa4I\xdfbI@\x97\x16C\x81\xb6+\xeaa\xdfi\xf2$\x11\xa6H\x8d\xa4\x9c\x18A\xfe\xfb ʲ\xe8\x17%\xa9\xa3d\x01:\x7f\x11\xcd\x13\xef\xc8{\x9e\x87:J\xd7\xd7\f\x12.\x01aF,ae^\xe0\x9b\x9bah\x80Z\xae$\xe2,r\x86\x0f\x95\x01QA\x8c\x89p\xc6\x19\xe0x\x88\x10B!㋦[\xabK\x1c\x0f\a\xdb\xddT\x892\x97\xa619sv\x12\xff\x05:\xe7\x92\bT\xb9G</\x94\xb6\xe18;\x89\x87\x83\xc1 ,E3ܒ\x99\xc1\ue9a0j\xba\x19\xc1\x15\xc9\v\x01\xae\x03\xbb\x01\x83Pp\x7fD`\xb9\x15\x80\xb8\t\b\xb5|\x018\x0e\t\xca4$\x11~Q-\xf2\x04#\xa29\t\f\b\xa0\x16X\x84\xad.\x01\xc7\xd32ω^\x86c\x12\x87c\xc1o\xf1\xbd\xed\xf1%\x8e\xbfiE\xc1\x18\xf4\x99\x1b{\x80\x87\xd3\xca\x03\x97\xf6\v)\x0e\x18}\x86\xe3)\xe8\x05h\xd3\x0eF[\xbf{9z\x8d㏢\x86\xdf\x1c0\x8fW8\xbePR\xee88h.op\xfcY\xa5h\n\xd6r\x99>\xd4\xdb[\x1c\xbf\x17\xa0\xed\xe6\xb2\xc2q)\xea\x86GZ\xe7\x84*iAZ\x8f}M\xd7~\nn\x8f/\x88\x04\xe1q\xb0V\x93c_=b\x9b\xe7\xa82\x06\xf7d\xfc`\x10\xfe\x12\x04\xde\u009b\xc1\xe8>\x02\x98fJ\xdb.\x11dN\x8b\xab\x1c\x05\xc1:ށ\xb1\xbe\x93K\x1c\x7f'\x97\xdd\xf0\xed\x81qO\x84\x9d5,%\xe52\xedZEmݤp\x8b\xf6\n\xaf\x06\x12\x87,\xe1\x12t\x9b^\x9e\xa7Ξ\x94B\x18\xaa\x01d\xa0\x8a\x8a\xd6띐̌\x12\xa5\x85`\xcf-F\xd3\b\xf3<\x1d\xb7\xb6\x91Y\xa48\x0e\xc7<O\xab \xfe\xcak0-\\Y\xa2\x81 \xc6\r\x99\t`\xc8\x14 \x04̀\xce#\x9c\x10a\x00ߏb5\xbeq8n\\\xfa8\xf6\x17\xc6A\xeb\x05\xe9F\xf6\xb0\x98ނV`oD[A\xca\xf8\"\x1en7\xf7\xaa\xb1\xf5\xf7\xd2\x7f \xd5\b<L\x8c\x87\x8b\xa3znt\x90\xf8\xa2\xd4\x1a\xa4\xed[9\xdf4,:\"V\xa6\xbe\xc3}\x85\xab\xae\xbd\xa62\xb5\xe1\x86\xcfO\xa4\xfd\v\xc6\xc1\xfd4\xa2q\x89\x7f\x9aP\x8eR}\x89\xf3\xf4\xf9\x88\xb3\x83\xb7\x1b\x8f\xb3\x9f\x80\xb4\xbdA{\xd6S\xeds \xa2\xefK\x9bu@Z\x99\x94\xe6\x96\xc3V\xdd{W\xb4\xed\x18\x9fXU5\xc6\xee҃\xbb/˯\xaa:p}Y\xa2\xaa\xf1|i\xb7!\xd8>\xf9\xe7@\xdb%\xe0\xe0\xc1\x9b\xd6\n\xa9G\xf0܀֓j^\xfd\xb7\xaa\xb9P\xf2G\xb6\xc2\x1f$\xf8ԕ\xaa\x7f\xda\f\xf4OH\xeeUn\x1f\x83\x84\xd3\xed3@\x17\x05w\xaa\x81;\xe8\xf8\x1a\xef\xaf$\x0ee\xe7aef7_\xd7/0:(\xdb\xd8{?\x91\xf2\xbc\x14\xc4\x02\xbbk\x02\x7f+\xbbq6\x1d\xeeO\xa7\xa3\xff^K\xa7\x1a:\x17\xf2\xb8\xfa蹮\xdc'\x946\xa7Ot\xe2\xdc\x05\xf3\xce\xc0]z:Lfo\xf0-\xe8o\xbcu\xedL\x82w\xa7ɉ\x10\x81J\x12\x0368A\xf5\xdf\xd7\xc8{?[\xff\xc2\xec4\xbeȈL\x01\t\x95\"\xb3~\xf9\x96\x9d\xb6\xf7x~\xb9,J\x1b\xa4Z\x95\x05F\xc6.\x05D8':\xe52\x10\x90\xd8s\xf4\xea\xd7I\xeb}\x10\x9a\x82\xc8=c\x03Af p\x1c\xae\x05\x97\x10\x94\x90 \xe1\xd5>ў 3\xce\x18\xc8FH\xe1\x98\xc7\xe1\xb8r\xe9Ep^\x91]\x16\x10a\x03D\xd3\f#Ir\x88\xf0?x_䄃`u\xda\x13B\xad\xca\x03\xa1\xd2\xc0\x80Ũ\x10\x84B\xa6\x04\x03\x1d\xe1)Xd\xb3:-\x1aR\xb8\x1a\x8dF\xfe\xca\xf6'%\x98\x95\xd6*\xe9ݸ5\xc3r\x96s\xbb\x1b~սrX;A\x8c\xe89F\v\"J\x88\xf0\x1fʏ\xde\xee\xea\xdd\x1b\xbb\xff\v\xb3\xb3\xe6\xfd\x02\xf2\u07b2\x86\xe3\xec\xacuShh0m\xfaf\x84Ϋ\x85I\x16P%\x94>G/\x00`rk\xac\x99\xd2\f\xf49:)\xae\x90Q\x823\xf4\xe2ݻw\xb7\x8fa\xdc\x14\x82,\xcf\xd1L(:\xbf\xfdނ0\xc6ez\x8e^\x16W\x93I3\xd3\x1d\x16\xba\x1c\xd3z\xcd.\xc9.\x918\xfe֢<\x1a\x8dF\xe1\xb8\xd0pG\xf2<\xac=\xd0@k\xa5\x11%B\xa8\xd2\"\"@[Tq7\xa0 -\xe8\xb5>\xd4\x02t\"\xd4\xe5y\xcd\xe6I\xb3V\xa9$Lp\xfc\x01$\av\x1f\f\xf7OÔ\xd4}\x89h&\xd2\xfc?`*\x9f\xbd-\xa0jl\xd0\xcc\xff\xd3\xef\xd6\xf7\xf6\x99W\x18\xf5\x87\x84\xf7\xb5\xad\xe3<\xe9\x8c}\x97\x18u\xe0\xef@\xddɥ\xbe\xde\x1e\xe3\xff*b\xe3,\xe9\x03\xf74\x85\xc4&d\x0f+!|\xd15\x8d\xd55\x1c\xaf\xbe\xda\xc6\xc3ah\xa8慭\xdd,\x88F\xf5\x03'BL\xd12\aiG)؏\x02\xaa\xe6\xef\xcbO\xech\xfb\xc1w\\\xef\xb7n؈0\xf6q\x01\xd2V\x1f6A\x82>\xc2sX\x96\x05\xfe\r%\xa5t!\x8f\xa0\xb2\x1f\xa3\xeb\xf5\xbc]Ǩ\xd0\xee\xfa\x01\x12R\n{t\xdc\xee\xe2<A\xf5\xa0\xd1\x1c\x96\x17\x8a\x01\x8a\xa2\b\x9d\x9c\xfa>\xaa߽&\\?*\x8fGTp:\xf7\xa3ܸ\xd6\xcd\xf1\xa4\xca\xce*%\xc3\xebk\x90\xec\xe6\xe6\xdf\x01\x00-\xa4\x1c5\xfb\x1e\x00\x00",
+		hash:  "e3f0d91a288c00fce0cd4ac45f3b32a355ef58f14af99195621ec5c81c78b700",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1539243717, 0),
-		size:  7019,
+		mtime: time.Unix(1792404364, 0),
+		size:  7931,
 	},
 	"index/index.html": {
-		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x8fA\xaa\x021\f\x86\xd7\uf762v_O .\x04\xf7\x03z\x81\xd0d\xb4\xd0&\xa5͈C\xe9݅q!:\xcc6\xf9\xbe\xe4\xff[C\x1a\x03\x93\xb1\x81\x91\x9e\x03\xdc\xc8\xf6\xfe\xffךR\xca\x11\x94\x8c\xbd\x13 \x95e|\xd89gN\x82\xb3q\xee\xf8M->\xc3c\xa5G\xf1\x10\xaf\x92\xad\xd9\xff\xae\xb4\x00W\xf0\x1a\x84\xeb\x94\x12\x94ye#(\xe0\x94\xf2\xe7\xfd\x99q#B\xf5%d\xad\xab\x1b^X\x8b\xc4\x01\x98\xe2e\x83\x19E\xf4]\xb25b\xec\xfd\x15\x00\x00\xff\xff)\xb2x\xeb\x1a\x01\x00\x00",
-		hash:  "cc3a3da9da68933167db530679efab736e844d7d525da9b7f55fe484cfc74a3f",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xfft\x90Aj\x031\fE\xd7\xed)\\\xef\xdd\x13\x94.\n\xdd\x0f$\x17\x10\x96&c\xb0%ckB\x06\xe3\xbb\a&\x8b\x90\x98\xd9\xea\xff'\x1e\xbf5\xa490\x19\x1b\x18\xe96\xc1\x85l\xef\x9f\x1f\xad)\xa5\x1cA\xc9\u0605\x00\xa9\xec\xe7\x9f/\xe7̟\xe0f\x9c\xfb}m\xed<\xc3u\xc0\xa3x\x88g\xc9\xd6|\xbfGZ\x80+x\r\xc2uM\t\xca6\xd0\b\n\xb8\xa6<\x04\xb0\xea\"%h\xa0\xfaT\xfbg<Ы\xbe\x84\xacuxㅵH\x9c\x80)\x9e\x0e:\xb3\x88>\x06h\x8d\x18{\xbf\x0f\x00\xfe\x7f\xe0\xce6\x01\x00\x00",
+		hash:  "deadd0db4d698b805272bcf23d14f110bc9fbe2c8948e3ba8f371f03b848092c",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1542240699, 0),
-		size:  282,
+		mtime: time.Unix(1792404711, 0),
+		size:  310,
 	},
 	"index/indexnav.html": {
-		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\x84\x8e\xb1j\xc40\f\x86\xf7<\x85\xd0n2ui\x1dO]:\\)\xf4\t\x94Xw\b\x14;\xd8J\x9a\x12\xf2\xee\xe5B\x0fz]nѠ\x9f\xff\xfb\xfem\x83\xc8gI\f()\xf2\x9ah\xc1}o|\x94\x05\x06\xa5Z;,\xf9\v\xa1ڷr\x87#\x95\x8b$\xd7g\xb3<>\xc3Ӵ\xbe`h\x00\x00\xfc\xac\xb7\x82Q_\xe1zܐ\x93\x95\xacn\xa2Ċ\x10\xc9\xc8\x1d\xa9\xc4\x0ey\xa5qR>\x1e\xbf\x90\x03\xa4\xf2\x17\xe4LL\x19\xa4:\x1aL\x16ƣ{\xdb\xeaF\x92\x84\xc1\x13P\x11r\x95\x95\a\xe3ء\x95\x991\x9cH\x12|\x1a\xd9\\\xe1\x83.\xec[\n\xbeUyd3\xea\xffo\xbf\xb7\xe6\xc2Wk8\xe5\xc2\xf0\xcaF\xa2\x1c\xe1=G\x86\xb7t\xcee$\x93\x9c\xeeu\xbe\x9d54\xbe\x8d\xb2\x84f\xdb8\xc5}\xff\t\x00\x00\xff\xff\xa7\xffC\x19\u007f\x01\x00\x00",
-		hash:  "dbe5cd4fb16f7f214469c016e8613fc72fd7f0bba689d5a13a5a99fac9615dc8",
+		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xac\x8e\xc1j\xf3@\f\x84\xef~\n\xa1\xfb\xe2\xd3\x7f\xf9\xbb^(\xf4\xd2CJ!O\xa0x\x95T \xef\x86]\xd9M0~\xf7b\x93@\xd3K/\xd5A \r3\xdf\xcc3D>Jb@I\x91/\x89&\\\x96\xc6G\x99\xa0W\xaa\xb5Ò?\x11\xaa]\x95;\x1c\xa8\x9c$\xb9C6\xcb\xc3\x7f\xf8w\xbe<ah\x00\x00\xfc\xa8w\x83ѡº\\\x9f\x93\x95\xac\xeeL\x89\x15!\x92\x91\xdbT\x89\x1d\U000851b3\xf2\xf6\xb8\x85\xac\xe3U\xbe\a9\x13S\x06\xa9\x8ez\x93\x89q\xf3\u07bb\xba\x81$a\xf0\x04T\x84\\e\xe5\xde8vhed\f;\x92\x04{#\x1b+\xbcӉ}K\xc1\xb7*\xbfь\x0e?\xbb?Rs\xe1\x95\x1av\xb90\xbc\xb0\x91(Gxˑ\xe15\x1ds\x19\xc8$\xa7\xbf\xc2\xd1h\x1f\xb9\x88\t\u05cd\xfa|\xbb\xaf\xb0\xe72q\xa9\x8f ߎ\x1a\x1a\xdfF\x99B3Ϝ\xe2\xb2|\r\x00\xaf-eL\xe8\x01\x00\x00",
+		hash:  "2ee5a5ab457372dd0a7bb6b12be78e7a142d87e6e4e798118427b453ba43ea83",
 		mime:  "text/html; charset=utf-8",
-		mtime: time.Unix(1539243717, 0),
-		size:  383,
+		mtime: time.Unix(1792404711, 0),
+		size:  488,
 	},
 	"index/localTop.html": {
 		data:  "\x1f\x8b\b\x00\x00\x00\x00\x00\x02\xff\xdcX\xdfo\xdb6\x10~\xcf_\xc1\x12\x18\x90\x00Sdg\xc1\x16\xa4\x12\x81\xfcX\xb3\x01kZ\xd4݀>\xd2\xe29&*\x91\x1ayrb\b\xfa\xdf\aR\x92\x13\xbb\xb6%;u\x1f\x96\x97\x98\xe4\xf1\xbb\xbb\xef>\x1e\t\x95\xa5\x80\x89T@h\xaa\x13\x9e~\xd69\xad\xaa#\xd2\xfcE\x16\x12\x94Z\x11)\xe2ڀ\xb2Ţ7\x10rF\x92\x94[\x1bS\xa3\x1fWVW-\x12\x9d\x16\x99\xb2k\xacjg\x19OS\x16ٜ\xd7\x0e-\x98\x19\x98\xc0\"\xc7\xc2R\x16\x85n\xc5\xfd\xf3v\xeb1\xa6Cbq\x9eBL\x1f\xa5\xc0\xe9\xe5p0\xf8\xe9-ee)'\xe4\xf4^\v\xb8\xe7\x19TUY.\r \xb5PU_>\xfc\xfd\x89\xdc\u007f\xb8\xfd\xbd,A\x89\xaaj#\x9a\x95\xe5\xe9?`\xacԪ\xaaZ\xf7\xf5Z\xebl\x92j\x8e\x97F>L\xf1-ew\x12\xc9u!SqI\xca\xf2\xf4N\xa2\x1f\xbc\xd8\x1bN\x87\x8c\xacO\xe0M\x104~\x89VI*\x93\xaf1U\xf0\x84.\xdc\xe3\x13\xca\"\xce\xee\xe1\t\x89\x1bG!_\xd0\xf1s\x1b\xedMa\f(\xbc|\xe61\xa9g\x02\xa5\x05\x04\xaa\xc8\xc6`(\x1b\xac\xd0I\x82`M\xf1B!g+\x15_3\xb5\x93\bRn\x1e \xf8\x85d d\x91\x05\xe7\xc4\xfb\x0f\x86g\xa4C\x1e/02@#\x93\r\x86\xde8\xe5cH\xc9D\x9b\x98\xba\xb4\xff\x00W\x1aʾ\xe8\u0090\xebT'_I=u\x19\x85\xdet\v\x94Ty\x81\x04\xe79\xc4\x14\xe1\t\xa9'\xf5\x05*Q<\x83\xe5\x19!-\x1f\xa7 b\x8a\xa6\x00Jf<- \xa6\xc1\xa6ܾ%\xf5\xd5i۹J\xdeIc\x912\xa7\x152\x9a\xab\x84\x8c\xfcY\"\xc7C\x8b$\xe7֞\xf4\xc8\xdf\x05\xe0\x8f\xe3\x02\xb0\x8d'7\xfa\xc1\x80\xb5\x94\x18\xedNA;\x1esC\t\xf2\xb1T\x02\x9eb:\xa0\x84\x1b\xc9\x03O\x82ҏ1=[\x9aʤZ1r4\xc7t8\x18\x90\x1cL\x02\n\x97\xcc\xf9\x93_\xdb\xc2C\xddN\x9c\xfeW\"\r2@0t\xb9G\x10\xd7$:\xd0<b\xeey@\xb0(\xd5\x03]\x8f\x1dx\x890\a\xe9)\aA\x8e\aDO\xc8\xe0$\n\xf3\x8e\x90\xeb#\xb9\xb9\x14[dr \x05\x8d \xd1J\xac\x93Й\x12{I\xa8A\xfcA\x1a\xba8\xff1\x12\xba8褐\xff\xa5h:/\x80M\xd6u\xef\xff\xb5\xa3\xf5o\x14\xa8\u007f L@$\xbaPH\xd9;\x10`8\x82\xe8TdGs_\x01n\x1a\xfc\xea\xec\x8eM\xbe\a뇢\x88\x17-EW\x85\x90\xf8}\xe8Y\x806\xf4\x1c\x8a\x90\xdd\x05\xbci\xfa\x9bW\xc8E\xfb\n\xf9\xedௐ\x1c\xc0\xfc%\xddm\xfc\xfc0C\x8d<\xfd\b`n\xea\xe24G\x99\xdch\xa5ꇷ\xed\xd1\\\xd1q\xee\xf1\x9e}l\xe7\x1b\xa7\xc0E\x8f\xea\xa3\xe96j\x00\x17\xfe\x03\x99S\xf6\xe7G\x12\xc9\xec\x81\xf8\xe6\xe8:\xed\xa2\xdd[m\xdc\xe5\x19\xb8թ\x14@_n\fܪ[\xa2$dQ\x88\xd3\xde\xeeY}+\xed\xb6g'\xeb\xe78Ea\xb8\xab\re\xb7ͯ=\x92mA\xf6M\xf9M\x10\xb8\x14\xda\b\xfc\xceu/\xf8\xcel\xac\xbb\x1a\xd9\b\x14\ue445ۼ\u007fўq\f$ g (\xfb\xd4\xfc\xda#\x98\x16\xe4\x15*\xba\xaa\x0f]\xbfMQ\xd8u>\x1cN\xe7I\x8bp\xacż\x13\xa8\x87\x11N\xb4\xc6\xefz\xac\x05{?\xba\x1b\x1d\xdf^}\xbe:\x89B\x14\xfd\xf7\xedd\xbd\xa8\xe1\xbf\x05O%\xce);\xb4\xb3\"\xa7l\xe0\xdeX\xef\xafOv\xdf-\xf4\xa3\xdas\u007f\xcfX{ik{\xb9\xa3\xd0_\f\xaf\xbd8W\xa6\xa2\xb0\xf9$Ď\x9a\xaf$G\xff\x05\x00\x00\xff\xff}ҷ\xe3C\x12\x00\x00",
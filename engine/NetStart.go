@@ -9,12 +9,13 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"io/ioutil"
 	"math"
 	"os"
-	"strings"
+	"path/filepath"
 	"time"
 
+	"github.com/FactomProject/factomd/activations"
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/constants/runstate"
 	. "github.com/FactomProject/factomd/common/globals"
@@ -23,15 +24,16 @@ import (
 	"github.com/FactomProject/factomd/common/messages/electionMsgs"
 	"github.com/FactomProject/factomd/common/messages/msgsupport"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/common/tracing"
 	"github.com/FactomProject/factomd/controlPanel"
 	"github.com/FactomProject/factomd/database/databaseOverlay"
 	"github.com/FactomProject/factomd/database/leveldb"
 	"github.com/FactomProject/factomd/elections"
+	"github.com/FactomProject/factomd/log"
 	"github.com/FactomProject/factomd/p2p"
 	"github.com/FactomProject/factomd/state"
 	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/factomd/wsapi"
-	log "github.com/sirupsen/logrus"
 )
 
 var _ = fmt.Print
@@ -83,22 +85,14 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 	s.FactomdVersion = FactomdVersion
 	s.EFactory = new(electionMsgs.ElectionsFactory)
 
-	log.SetOutput(os.Stdout)
-	switch strings.ToLower(p.Loglvl) {
-	case "none":
-		log.SetOutput(ioutil.Discard)
-	case "debug":
-		log.SetLevel(log.DebugLevel)
-	case "info":
-		log.SetLevel(log.InfoLevel)
-	case "warning", "warn":
-		log.SetLevel(log.WarnLevel)
-	case "error":
-		log.SetLevel(log.ErrorLevel)
-	case "fatal":
-		log.SetLevel(log.FatalLevel)
-	case "panic":
-		log.SetLevel(log.PanicLevel)
+	// Every subsystem logs at -loglvl, unless the config sets its own level
+	err := log.Configure(p.Loglvl, p.Logjson)
+	if err != nil {
+		panic(err)
+	}
+	err = log.SetSubsystemLevels(s.Cfg.(*util.FactomdConfig).App.LogLevels)
+	if err != nil {
+		panic("Encountered an error while reading the log levels from config: " + err.Error())
 	}
 
 	// Command line override if provided
@@ -111,10 +105,6 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		s.ControlPanelSetting = 2
 	}
 
-	if p.Logjson {
-		log.SetFormatter(&log.JSONFormatter{})
-	}
-
 	// Set the wait for entries flag
 	s.WaitForEntries = p.WaitEntries
 
@@ -289,6 +279,7 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "net spec", pnet))
 	os.Stderr.WriteString(fmt.Sprintf("%20s %d\n", "Msgs droped", p.DropRate))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "journal", p.Journal))
+	os.Stderr.WriteString(fmt.Sprintf("%20s %v\n", "journal debug", p.JournalDebug))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "database", p.Db))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "database for clones", p.CloneDB))
 	os.Stderr.WriteString(fmt.Sprintf("%20s \"%s\"\n", "peers", p.Peers))
@@ -387,6 +378,33 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		panic("Invalid Network choice in Config File or command line. Choose MAIN, TEST, LOCAL, or CUSTOM")
 	}
 
+	// Activation heights from the config, only allowed on LOCAL and CUSTOM networks
+	cfg := s.Cfg.(*util.FactomdConfig)
+	activationsFile := cfg.App.ActivationHeightsFile
+	if activationsFile != "" && !filepath.IsAbs(activationsFile) {
+		activationsFile = filepath.Join(filepath.Dir(FactomConfigFilename), activationsFile)
+	}
+	heights, err := activations.ParseActivationHeights(cfg.App.ActivationHeights, activationsFile)
+	if err != nil {
+		panic("Encountered an error while reading the activation heights from config: " + err.Error())
+	}
+	if len(heights) > 0 {
+		err = activations.OverrideActivationHeights(heights)
+		if err != nil {
+			panic("Encountered an error while setting the activation heights from config: " + err.Error())
+		}
+	}
+
+	// Message lifecycle tracing, exported to an OpenTelemetry collector
+	if cfg.App.TracingEndpoint != "" {
+		tracing.Enable(cfg.App.TracingEndpoint, "factomd")
+		fmt.Printf("Tracing messages to %s\n", cfg.App.TracingEndpoint)
+	}
+
+	if cfg.App.AlertWebhook != "" {
+		alerts.EnableWebhook(cfg.App.AlertWebhook)
+	}
+
 	connectionMetricsChannel := make(chan interface{}, p2p.StandardChannelSize)
 	p2p.NetworkDeadline = time.Duration(p.Deadline) * time.Millisecond
 
@@ -417,6 +435,9 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 
 		fnodes[0].Peers = append(fnodes[0].Peers, p2pProxy)
 		p2pProxy.StartProxy()
+		if cfg.App.AlertBroadcast {
+			alerts.SetBroadcaster(p2pProxy.SendAlert)
+		}
 
 		go networkHousekeeping() // This goroutine executes once a second to keep the proxy apprised of the network status.
 	}
@@ -552,7 +573,10 @@ func NetStart(s *state.State, p *FactomParams, listenToStdin bool) {
 		fnodes[0].State.SetUseTorrent(false)
 	}
 
-	if p.Journal != "" {
+	if p.Journal != "" && p.JournalDebug {
+		go DebugJournal(s, p.Journal, p.JournalExpect)
+		startServers(false)
+	} else if p.Journal != "" {
 		go LoadJournal(s, p.Journal)
 		startServers(false)
 	} else {
@@ -654,9 +678,11 @@ func startServer(i int, fnode *FactomNode, load bool) {
 		go state.LoadDatabase(fnode.State)
 	}
 	go fnode.State.GoSyncEntries()
+	go fnode.State.CatchUpAuthorityHistory()
 	go Timer(fnode.State)
 	go elections.Run(fnode.State)
 	go fnode.State.ValidatorLoop()
+	go fnode.State.WatchAlerts()
 
 	// moved StartMMR here to ensure Init goroutine only called once and not twice (removed from state.go)
 	go fnode.State.StartMMR()
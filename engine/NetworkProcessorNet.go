@@ -14,6 +14,7 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/common/tracing"
 	"github.com/FactomProject/factomd/log"
 )
 
@@ -104,6 +105,8 @@ func Peers(fnode *FactomNode) {
 				fnode.State.LogMessage("badEvents", "Nil hash from APIQueue", msg)
 				continue
 			}
+			tracing.Stage(fnode.State.FactomNodeName, msg, tracing.StageAPI)
+			fnode.State.FlightRecorder.Received("api", msg)
 
 			// TODO: Is this as intended for 'x' command? -- clay
 			if fnode.State.GetNetStateOff() { // drop received message if he is off
@@ -264,6 +267,7 @@ func Peers(fnode *FactomNode) {
 					in = "PeerIn"
 				}
 				fnode.MLog.Add2(fnode, false, peer.GetNameTo(), fmt.Sprintf("%s %d", in, i+1), true, msg)
+				fnode.State.FlightRecorder.Received(peer.GetNameTo(), msg)
 
 				// don't resend peer to peer messages or responses
 				if constants.NormallyPeer2Peer(msg.Type()) {
@@ -430,6 +434,7 @@ func NetworkOutputs(fnode *FactomNode) {
 					} else {
 						preSendTime := time.Now()
 						fnode.State.LogMessage("NetworkOutputs", "Send P2P "+peer.GetNameTo(), msg)
+						fnode.State.FlightRecorder.Sent(peer.GetNameTo(), msg)
 						peer.Send(msg)
 						sendTime := time.Since(preSendTime)
 						TotalSendTime.Add(float64(sendTime.Nanoseconds()))
@@ -446,6 +451,7 @@ func NetworkOutputs(fnode *FactomNode) {
 			}
 		} else {
 			fnode.State.LogMessage("NetworkOutputs", "Send broadcast", msg)
+			fnode.State.FlightRecorder.Sent("broadcast", msg)
 			for i, peer := range fnode.Peers {
 				wt := 1
 				if p >= 0 {
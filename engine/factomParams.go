@@ -45,6 +45,8 @@ func init() {
 	flag.IntVar(&p.DropRate, "drop", 0, "Number of messages to drop out of every thousand")
 	flag.StringVar(&p.Journal, "journal", "", "Rerun a Journal of messages")
 	flag.BoolVar(&p.Journaling, "journaling", false, "Write a journal of all messages received. Default is off.")
+	flag.BoolVar(&p.JournalDebug, "journaldebug", false, "Rerun the Journal one message at a time with the G commands of the sim control.")
+	flag.StringVar(&p.JournalExpect, "journalexpect", "", "Compare the state after each message rerun by -journaldebug with the expected values in this file.")
 	flag.BoolVar(&p.Follower, "follower", false, "If true, force node to be a follower.  Only used when replaying a journal.")
 	flag.BoolVar(&p.Leader, "leader", true, "If true, force node to be a leader.  Only used when replaying a journal.")
 	flag.StringVar(&p.Db, "db", "", "Override the Database in the Config file and use this Database implementation. Options Map, LDB, or Bolt")
@@ -26,7 +26,7 @@ import (
 	"time"
 
 	"github.com/FactomProject/factomd/common/messages/electionMsgs"
-	log "github.com/sirupsen/logrus"
+	"github.com/FactomProject/factomd/log"
 )
 
 var _ = fmt.Print
@@ -37,7 +37,7 @@ var _ = fmt.Print
 
 // packageLogger is the general logger for all engine related logs. You can add additional fields,
 // or create more context loggers off of this
-var packageLogger = log.WithFields(log.Fields{"package": "engine"})
+var packageLogger = log.Subsystem("engine")
 
 func Factomd(params *FactomParams, listenToStdin bool) interfaces.IState {
 	fmt.Printf("Go compiler version: %s\n", runtime.Version())
@@ -0,0 +1,487 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/elections"
+	"github.com/FactomProject/factomd/state"
+)
+
+// The journal debugger replays a journal one message at a time under the control of the
+// G commands of the sim control, instead of straight through as -journal does.  It can
+// stop on breakpoints, show the process list, holding and elections between messages,
+// and compare the state after each message against expected values.
+//
+// Breakpoints and expected values are lists of key:value conditions.  A breakpoint stops
+// before a message that matches all of its conditions:
+//
+//	type:EOM     the message type, by name or number
+//	hash:1a2b3c  the start of the message's hash or msg hash
+//	dbheight:12  the directory block height the message is for
+//	minute:3     the minute the message is for
+//
+// Expected values are lines of "step key:value ..." checked after that many messages are
+// replayed, with the keys of journalObservations.  The Gr command records them.
+
+// The journal debugger the sim control's G commands go to, nil if there is none
+var journalDebugger struct {
+	sync.Mutex
+	d *JournalDebugger
+}
+
+func setJournalDebugger(d *JournalDebugger) {
+	journalDebugger.Lock()
+	defer journalDebugger.Unlock()
+	journalDebugger.d = d
+}
+
+func getJournalDebugger() *JournalDebugger {
+	journalDebugger.Lock()
+	defer journalDebugger.Unlock()
+	return journalDebugger.d
+}
+
+// The values of the state compared against expected values, in the order they are recorded
+var journalObservations = []string{"dbheight", "minute", "saved", "holding", "fedservers", "auditservers", "electing"}
+
+type JournalDebugger struct {
+	state       *state.State
+	msgs        []interfaces.IMsg
+	next        int // index of the next message to replay
+	breakpoints [][]JournalCondition
+	expected    map[int][]JournalCondition // by step, the number of messages replayed
+	commands    chan []string
+
+	steps   int  // messages left to replay before stopping
+	running bool // replay until a breakpoint or the end
+	resumed bool // don't stop on a breakpoint before the first message after a resume
+	watch   bool // show the state after every message
+	record  *os.File
+	done    bool
+}
+
+// JournalCondition is a key:value condition of a breakpoint or an expected value
+type JournalCondition struct {
+	Key   string
+	Value string
+}
+
+func (c JournalCondition) String() string {
+	return c.Key + ":" + c.Value
+}
+
+// parseJournalConditions reads a list of key:value conditions
+func parseJournalConditions(fields []string, keys []string) ([]JournalCondition, error) {
+	var conditions []JournalCondition
+	for _, f := range fields {
+		parts := strings.SplitN(f, ":", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("Bad condition %q, expected key:value", f)
+		}
+		known := false
+		for _, k := range keys {
+			known = known || k == parts[0]
+		}
+		if !known {
+			return nil, fmt.Errorf("Unknown key %q, expected one of %s", parts[0], strings.Join(keys, ", "))
+		}
+		conditions = append(conditions, JournalCondition{Key: parts[0], Value: parts[1]})
+	}
+	return conditions, nil
+}
+
+// ParseJournalExpectations reads lines of "step key:value ...".  Blank lines and lines
+// starting with # are ignored.
+func ParseJournalExpectations(r *bufio.Reader) (map[int][]JournalCondition, error) {
+	expected := make(map[int][]JournalCondition)
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		step, err := strconv.Atoi(fields[0])
+		if err != nil || step <= 0 {
+			return nil, fmt.Errorf("Line %d: bad step %q", n, fields[0])
+		}
+		conditions, err := parseJournalConditions(fields[1:], journalObservations)
+		if err != nil {
+			return nil, fmt.Errorf("Line %d: %s", n, err)
+		}
+		expected[step] = append(expected[step], conditions...)
+	}
+	return expected, scanner.Err()
+}
+
+// NewJournalDebugger replays msgs into a state, with expected values by step (may be nil)
+func NewJournalDebugger(s *state.State, msgs []interfaces.IMsg, expected map[int][]JournalCondition) *JournalDebugger {
+	d := new(JournalDebugger)
+	d.state = s
+	d.msgs = msgs
+	d.expected = expected
+	d.commands = make(chan []string, 10)
+	return d
+}
+
+// DebugJournal loads a journal and its expected values, and replays it under the control
+// of the sim control
+func DebugJournal(s *state.State, journal string, expect string) {
+	f, err := os.Open(journal)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	msgs, err := ReadJournal(bufio.NewReaderSize(f, 4*1024))
+	f.Close()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var expected map[int][]JournalCondition
+	if expect != "" {
+		f, err := os.Open(expect)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		expected, err = ParseJournalExpectations(bufio.NewReader(f))
+		f.Close()
+		if err != nil {
+			fmt.Println(expect, err)
+			return
+		}
+	}
+
+	d := NewJournalDebugger(s, msgs, expected)
+	setJournalDebugger(d)
+	fmt.Printf("Journal debugger loaded %d messages from %s, G for help\n", len(msgs), journal)
+	d.Run()
+}
+
+// Command passes a G command from the sim control, without the G
+func (d *JournalDebugger) Command(cmd []string) {
+	d.commands <- cmd
+}
+
+// Run replays the journal as commanded, forever
+func (d *JournalDebugger) Run() {
+	d.state.SetIsReplaying()
+	for {
+		if !d.running && d.steps == 0 {
+			d.handle(<-d.commands)
+			continue
+		}
+		select {
+		case cmd := <-d.commands:
+			d.handle(cmd)
+			continue
+		default:
+		}
+
+		if d.next >= len(d.msgs) {
+			d.stop()
+			fmt.Printf("End of the journal after %d messages\n", len(d.msgs))
+			if !d.done {
+				d.done = true
+				d.state.SetIsDoneReplaying()
+			}
+			continue
+		}
+		msg := d.msgs[d.next]
+		if !d.resumed {
+			if i, ok := d.breakpoint(msg); ok {
+				d.stop()
+				fmt.Printf("Breakpoint %d before message %d: %s\n", i, d.next+1, msg.String())
+				continue
+			}
+		}
+		d.resumed = false
+		d.step()
+		if d.steps > 0 {
+			d.steps--
+		}
+	}
+}
+
+func (d *JournalDebugger) stop() {
+	d.running = false
+	d.steps = 0
+}
+
+// step replays the next message, and looks at the state once it is processed
+func (d *JournalDebugger) step() {
+	msg := d.msgs[d.next]
+	d.next++
+	d.state.InMsgQueue().Enqueue(msg)
+
+	var observed map[string]string
+	var inspected string
+	d.onValidator(func() {
+		observed = d.observe()
+		if d.watch {
+			inspected = d.inspect()
+		}
+	})
+	if !d.running {
+		fmt.Printf("%d/%d %s\n    %s\n", d.next, len(d.msgs), msg.String(), d.observedString(observed))
+	}
+	fmt.Print(inspected)
+	if d.record != nil {
+		fmt.Fprintf(d.record, "%d %s\n", d.next, d.observedString(observed))
+	}
+	for _, c := range d.expected[d.next] {
+		if observed[c.Key] != c.Value {
+			d.stop()
+			fmt.Printf("Unexpected %s after message %d: expected %s, got %s\n", c.Key, d.next, c.Value, observed[c.Key])
+		}
+	}
+}
+
+// onValidator runs f on the state's validator thread, where the state may be read, once
+// the messages given to the state are processed, and waits for it
+func (d *JournalDebugger) onValidator(f func()) {
+	done := make(chan struct{})
+	d.state.RunWhenIdle(func() {
+		defer close(done)
+		f()
+	})
+	<-done
+}
+
+// observe returns the state's values that expectations are compared against, on the
+// validator thread
+func (d *JournalDebugger) observe() map[string]string {
+	s := d.state
+	height := s.GetLLeaderHeight()
+	observed := map[string]string{
+		"dbheight":     fmt.Sprint(height),
+		"minute":       fmt.Sprint(s.CurrentMinute),
+		"saved":        fmt.Sprint(s.GetHighestSavedBlk()),
+		"holding":      fmt.Sprint(len(s.LoadHoldingMap())),
+		"fedservers":   fmt.Sprint(len(s.GetFedServers(height))),
+		"auditservers": fmt.Sprint(len(s.GetAuditServers(height))),
+		"electing":     "-1",
+	}
+	if e, ok := s.Elections.(*elections.Elections); ok {
+		observed["electing"] = fmt.Sprint(e.Electing)
+	}
+	return observed
+}
+
+func (d *JournalDebugger) observedString(observed map[string]string) string {
+	var list []string
+	for _, k := range journalObservations {
+		list = append(list, k+":"+observed[k])
+	}
+	return strings.Join(list, " ")
+}
+
+// breakpoint returns the first breakpoint a message matches
+func (d *JournalDebugger) breakpoint(msg interfaces.IMsg) (int, bool) {
+	for i, b := range d.breakpoints {
+		if journalMatches(msg, b) {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+func journalMatches(msg interfaces.IMsg, conditions []JournalCondition) bool {
+	for _, c := range conditions {
+		switch c.Key {
+		case "type":
+			if n, err := strconv.Atoi(c.Value); err == nil {
+				if int(msg.Type()) != n {
+					return false
+				}
+			} else if !strings.EqualFold(constants.MessageName(msg.Type()), c.Value) {
+				return false
+			}
+		case "hash":
+			value := strings.ToLower(c.Value)
+			if !strings.HasPrefix(msg.GetHash().String(), value) && !strings.HasPrefix(msg.GetMsgHash().String(), value) {
+				return false
+			}
+		case "dbheight":
+			h, ok := journalMsgDBHeight(msg)
+			if !ok || fmt.Sprint(h) != c.Value {
+				return false
+			}
+		case "minute":
+			m, ok := journalMsgMinute(msg)
+			if !ok || fmt.Sprint(m) != c.Value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// journalMsgDBHeight returns the directory block height a message is for, if it has one
+func journalMsgDBHeight(msg interfaces.IMsg) (uint32, bool) {
+	if m, ok := msg.(interface{ GetDBHeight() uint32 }); ok {
+		return m.GetDBHeight(), true
+	}
+	return journalMsgField(msg, "DBHeight")
+}
+
+// journalMsgMinute returns the minute a message is for, if it has one
+func journalMsgMinute(msg interfaces.IMsg) (uint32, bool) {
+	return journalMsgField(msg, "Minute")
+}
+
+// journalMsgField returns a numeric field of a message, as most messages keep their
+// height and minute in fields rather than behind the IMsg interface
+func journalMsgField(msg interfaces.IMsg, name string) (uint32, bool) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return 0, false
+	}
+	f := v.Elem().FieldByName(name)
+	switch f.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return uint32(f.Uint()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if f.Int() >= 0 {
+			return uint32(f.Int()), true
+		}
+	}
+	return 0, false
+}
+
+var journalBreakpointKeys = []string{"type", "hash", "dbheight", "minute"}
+
+func (d *JournalDebugger) handle(cmd []string) {
+	if len(cmd) == 0 {
+		cmd = []string{"?"}
+	}
+	args := cmd[1:]
+	count := func(def int) int {
+		if len(args) > 0 {
+			if n, err := strconv.Atoi(args[0]); err == nil && n > 0 {
+				return n
+			}
+		}
+		return def
+	}
+
+	switch cmd[0] {
+	case "s":
+		d.steps = count(1)
+		d.resumed = true
+	case "c":
+		d.running = true
+		d.resumed = true
+	case "x":
+		d.stop()
+		fmt.Printf("Stopped before message %d of %d\n", d.next+1, len(d.msgs))
+	case "b":
+		if len(args) == 0 {
+			for i, b := range d.breakpoints {
+				fmt.Printf("%3d %v\n", i+1, b)
+			}
+			break
+		}
+		conditions, err := parseJournalConditions(args, journalBreakpointKeys)
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		d.breakpoints = append(d.breakpoints, conditions)
+		fmt.Printf("Breakpoint %d: %v\n", len(d.breakpoints), conditions)
+	case "d":
+		if len(args) == 0 {
+			d.breakpoints = nil
+			fmt.Println("Deleted all breakpoints")
+			break
+		}
+		n := count(0)
+		if n < 1 || n > len(d.breakpoints) {
+			fmt.Printf("No breakpoint %s\n", args[0])
+			break
+		}
+		d.breakpoints = append(d.breakpoints[:n-1], d.breakpoints[n:]...)
+		fmt.Printf("Deleted breakpoint %d\n", n)
+	case "l":
+		for i := d.next; i < len(d.msgs) && i < d.next+count(5); i++ {
+			fmt.Printf("%d %s\n", i+1, d.msgs[i].String())
+		}
+	case "i":
+		var inspected string
+		d.onValidator(func() { inspected = d.inspect() })
+		fmt.Print(inspected)
+	case "w":
+		d.watch = !d.watch
+		fmt.Printf("Show the state after every message: %v\n", d.watch)
+	case "r":
+		if d.record != nil {
+			d.record.Close()
+			d.record = nil
+			fmt.Println("Stopped recording")
+		}
+		if len(args) == 0 {
+			break
+		}
+		f, err := os.Create(args[0])
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		d.record = f
+		fmt.Printf("Recording the state after every message to %s\n", args[0])
+	default:
+		fmt.Printf("Before message %d of %d, %d breakpoints, %d steps with expected values\n",
+			d.next+1, len(d.msgs), len(d.breakpoints), len(d.expected))
+		fmt.Println("Gs [n]        Replay the next n messages, 1 by default")
+		fmt.Println("Gc            Replay until a breakpoint, an unexpected value or the end")
+		fmt.Println("Gx            Stop replaying")
+		fmt.Println("Gb key:value  Break before messages matching all of type:, hash:, dbheight: and minute:")
+		fmt.Println("Gb            List the breakpoints")
+		fmt.Println("Gd [n]        Delete breakpoint n, or all of them")
+		fmt.Println("Gl [n]        List the next n messages, 5 by default")
+		fmt.Println("Gi            Show the process list, holding and elections")
+		fmt.Println("Gw            Toggle showing the process list, holding and elections after every message")
+		fmt.Println("Gr [file]     Record the state after every message as expected values, or stop")
+	}
+}
+
+// inspect returns the process list, holding and elections of the state, on the
+// validator thread
+func (d *JournalDebugger) inspect() string {
+	s := d.state
+	var out strings.Builder
+	fmt.Fprintf(&out, "===== %s at %d-:-%d =====\n", s.FactomNodeName, s.GetLLeaderHeight(), s.CurrentMinute)
+	if pl := s.ProcessLists.Get(s.GetLLeaderHeight()); pl != nil {
+		fmt.Fprintln(&out, pl.String())
+	}
+
+	holding := s.LoadHoldingMap()
+	var list []string
+	for _, m := range holding {
+		list = append(list, m.String())
+	}
+	sort.Strings(list)
+	fmt.Fprintf(&out, "Holding %d:\n", len(list))
+	for _, m := range list {
+		fmt.Fprintln(&out, "   ", m)
+	}
+
+	if s.Elections != nil {
+		fmt.Fprintln(&out, s.Elections.String())
+	}
+	return out.String()
+}
@@ -0,0 +1,58 @@
+package engine_test
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/engine"
+)
+
+func TestReadJournal(t *testing.T) {
+	eom := new(messages.EOM)
+	eom.Timestamp = primitives.NewTimestampNow()
+	eom.ChainID = primitives.RandomHash()
+	eom.DBHeight = 12
+	eom.Minute = 3
+	data, err := eom.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	journal := fmt.Sprintf("# a comment\nMsgHex: %x\n# OutMsgHex: %x\n\nMsgHex: %x", data, data, data)
+	msgs, err := ReadJournal(bufio.NewReader(strings.NewReader(journal)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(msgs))
+	}
+	if m, ok := msgs[1].(*messages.EOM); !ok || m.DBHeight != 12 || m.Minute != 3 {
+		t.Errorf("Wrong message %v", msgs[1])
+	}
+
+	if _, err := ReadJournal(bufio.NewReader(strings.NewReader("MsgHex: 0g\n"))); err == nil {
+		t.Error("Expected bad hex to be an error")
+	}
+}
+
+func TestParseJournalExpectations(t *testing.T) {
+	expected, err := ParseJournalExpectations(bufio.NewReader(strings.NewReader("# step values\n1 dbheight:0 minute:0\n\n7 holding:2\n7 electing:-1\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expected) != 2 || len(expected[1]) != 2 || len(expected[7]) != 2 {
+		t.Errorf("Wrong expectations %v", expected)
+	} else if expected[7][0] != (JournalCondition{Key: "holding", Value: "2"}) {
+		t.Errorf("Wrong expectation %v", expected[7][0])
+	}
+
+	for _, bad := range []string{"x dbheight:1", "0 dbheight:1", "1 dbheight", "1 colour:red"} {
+		if _, err := ParseJournalExpectations(bufio.NewReader(strings.NewReader(bad))); err == nil {
+			t.Errorf("Expected %q to be refused", bad)
+		}
+	}
+}
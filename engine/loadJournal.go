@@ -54,32 +54,13 @@ func LoadJournalFromReader(s interfaces.IState, r *bufio.Reader) {
 			break
 		}
 
-		// Get the next word.  If not MsgHex:, then go to next line.
-		adv, word, err := bufio.ScanWords(line, true)
-		if string(word) != "MsgHex:" {
-			continue // Go to next line.
-		}
-		line = line[adv:] // Remove "MsgHex:" from the line.
-
-		// Remove spaces.
-		adv, data, err := bufio.ScanWords(line, true)
+		msg, err := ParseJournalLine(line)
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-
-		// Decode the hex
-		binary, err := hex.DecodeString(string(data))
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-
-		// Unmarshal the message.
-		msg, err := msgsupport.UnmarshalMessage(binary)
-		if err != nil {
-			fmt.Println(err)
-			return
+		if msg == nil {
+			continue // Go to next line.
 		}
 
 		// Process the message.
@@ -99,3 +80,49 @@ func LoadJournalFromReader(s interfaces.IState, r *bufio.Reader) {
 		time.Sleep(time.Millisecond * 100)
 	}
 }
+
+// ParseJournalLine returns the message on a "MsgHex:" line of a journal, or nil if the line has none
+func ParseJournalLine(line []byte) (interfaces.IMsg, error) {
+	// Get the next word.  If not MsgHex:, then there is no message.
+	adv, word, err := bufio.ScanWords(line, true)
+	if string(word) != "MsgHex:" {
+		return nil, nil
+	}
+	line = line[adv:] // Remove "MsgHex:" from the line.
+
+	// Remove spaces.
+	_, data, err := bufio.ScanWords(line, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the hex
+	binary, err := hex.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal the message.
+	return msgsupport.UnmarshalMessage(binary)
+}
+
+// ReadJournal returns all the messages in a journal
+func ReadJournal(r *bufio.Reader) ([]interfaces.IMsg, error) {
+	var msgs []interfaces.IMsg
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) == 0 {
+			return msgs, nil
+		}
+		msg, perr := ParseJournalLine(line)
+		if perr != nil {
+			return msgs, perr
+		}
+		if msg != nil {
+			msgs = append(msgs, msg)
+		}
+		if err != nil {
+			return msgs, nil
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/loadgen"
+)
+
+// The load profile running against the simulation, if any
+var profileLoad struct {
+	sync.Mutex
+	generator *loadgen.Generator
+}
+
+// RunLoadProfile sends the load of a profile file, or of the default profile, to the node
+// the API points at, and prints the report when done
+func RunLoadProfile(file string) {
+	profile := loadgen.NewDefaultProfile()
+	if file != "" {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			os.Stderr.WriteString(err.Error() + "\n")
+			return
+		}
+		profile, err = loadgen.ParseProfile(data)
+		if err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("%s: %s\n", file, err))
+			return
+		}
+	}
+
+	profileLoad.Lock()
+	defer profileLoad.Unlock()
+	if profileLoad.generator != nil {
+		os.Stderr.WriteString("A load profile is already running, stop it with Rs\n")
+		return
+	}
+	target := loadgen.NewStateTarget(func() interfaces.IState { return fnodes[wsapiNode].State })
+	g, err := loadgen.NewGenerator(profile, target)
+	if err != nil {
+		os.Stderr.WriteString(err.Error() + "\n")
+		return
+	}
+	g.Progress = os.Stderr
+	profileLoad.generator = g
+
+	os.Stderr.WriteString(fmt.Sprintf("Running load profile %s for %s\n", profile.Name, profile.Duration()))
+	go func() {
+		report, err := g.Run()
+		profileLoad.Lock()
+		profileLoad.generator = nil
+		profileLoad.Unlock()
+		if err != nil {
+			os.Stderr.WriteString(fmt.Sprintf("Load profile %s failed: %s\n", profile.Name, err))
+			return
+		}
+		os.Stderr.WriteString(report.String())
+	}()
+}
+
+// StopLoadProfile ends the running load profile early, which then reports
+func StopLoadProfile() {
+	profileLoad.Lock()
+	defer profileLoad.Unlock()
+	if profileLoad.generator == nil {
+		os.Stderr.WriteString("No load profile is running\n")
+		return
+	}
+	profileLoad.generator.Stop()
+}
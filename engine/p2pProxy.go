@@ -11,9 +11,11 @@ import (
 
 	// "github.com/FactomProject/factomd/common/constants"
 
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/common/tracing"
 	"github.com/FactomProject/factomd/p2p"
 
 	"github.com/FactomProject/factomd/common/messages/msgsupport"
@@ -50,6 +52,8 @@ type FactomMessage struct {
 	PeerHash string
 	AppHash  string
 	AppType  string
+
+	TraceParent string
 }
 
 func (e *FactomMessage) JSONByte() ([]byte, error) {
@@ -120,6 +124,7 @@ func (f *P2PProxy) Send(msg interfaces.IMsg) error {
 		hash := fmt.Sprintf("%x", msg.GetMsgHash().Bytes())
 		appType := fmt.Sprintf("%d", msg.Type())
 		message := FactomMessage{Message: data, PeerHash: msg.GetNetworkOrigin(), AppHash: hash, AppType: appType}
+		message.TraceParent = tracing.TraceParent(f.FromName, msg)
 		switch {
 		case !msg.IsPeer2Peer() && msg.IsFullBroadcast():
 			msgLogger.Debug("Sending full broadcast message")
@@ -159,6 +164,7 @@ func (f *P2PProxy) Receive() (interfaces.IMsg, error) {
 
 				if nil == err {
 					msg.SetNetworkOrigin(fmessage.PeerHash)
+					tracing.Received(f.FromName, msg, fmessage.TraceParent)
 				}
 				f.bytesIn += len(fmessage.Message)
 				return msg, err
@@ -221,6 +227,7 @@ func (f *P2PProxy) ManageOutChannel() {
 				parcel.Header.TargetPeer = fmessage.PeerHash
 				parcel.Header.AppHash = fmessage.AppHash
 				parcel.Header.AppType = fmessage.AppType
+				parcel.Header.TraceParent = fmessage.TraceParent
 				p2p.BlockFreeChannelSend(f.ToNetwork, parcel)
 			}
 		default:
@@ -229,13 +236,29 @@ func (f *P2PProxy) ManageOutChannel() {
 	}
 }
 
+// SendAlert sends an alert raised on this node to all of our peers
+func (f *P2PProxy) SendAlert(payload []byte) {
+	parcel := p2p.NewParcel(p2p.CurrentNetwork, payload)
+	parcel.Header.Type = p2p.TypeAlert
+	parcel.Header.TargetPeer = p2p.FullBroadcastFlag
+	p2p.BlockFreeChannelSend(f.ToNetwork, *parcel)
+}
+
 // manageInChannel takes messages from the network and stuffs it in the f.BroadcastIn channel
 func (f *P2PProxy) ManageInChannel() {
 	for data := range f.FromNetwork {
 		switch data.(type) {
 		case p2p.Parcel:
 			parcel := data.(p2p.Parcel)
+			if parcel.Header.Type == p2p.TypeAlert {
+				err := alerts.Received(parcel.Header.TargetPeer, parcel.Payload)
+				if err != nil {
+					f.logger.Warnf("Bad alert from %s: %v", parcel.Header.TargetPeer, err)
+				}
+				continue
+			}
 			message := FactomMessage{Message: parcel.Payload, PeerHash: parcel.Header.TargetPeer, AppHash: parcel.Header.AppHash, AppType: parcel.Header.AppType}
+			message.TraceParent = parcel.Header.TraceParent
 			removed := p2p.BlockFreeChannelSend(f.BroadcastIn, message)
 			BroadInCastQueue.Inc()
 			BroadInCastQueue.Add(float64(-1 * removed))
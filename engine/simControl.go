@@ -1166,6 +1166,13 @@ func SimControl(listenTo int, listenStdin bool) {
 						}
 					}
 				}
+			case 'G' == b[0]:
+				d := getJournalDebugger()
+				if d == nil {
+					os.Stderr.WriteString("Run with -journal and -journaldebug to step through a journal\n")
+					break
+				}
+				d.Command(append([]string{b[1:]}, cmd[1:]...))
 			case 'J' == b[0]:
 				elect := fnodes[listenTo].State.Elections.(*elections2.Elections)
 				flist := elect.Federated
@@ -1227,6 +1234,18 @@ func SimControl(listenTo int, listenStdin bool) {
 				}
 			case 'R' == b[0]:
 				// load generation
+				if b == "Rp" {
+					file := ""
+					if len(cmd) > 1 {
+						file = cmd[1]
+					}
+					RunLoadProfile(file)
+					break
+				}
+				if b == "Rs" {
+					StopLoadProfile()
+					break
+				}
 				if loadGenerator == nil {
 					os.Stderr.WriteString("Currently no default State we can use for the load generator\n")
 					continue
@@ -1460,6 +1479,9 @@ func SimControl(listenTo int, listenStdin bool) {
 				os.Stderr.WriteString("Rnnn          Set load generator to write entries at nnn per second\n")
 				os.Stderr.WriteString("Re            Turn on 'tight' mode, that buys ECs in only small amounts when running Rnnn\n")
 				os.Stderr.WriteString("Rtnnn         Add a signed constant to the timestamp of load generator FCT TXs.\n")
+				os.Stderr.WriteString("Rp [file]     Run a YAML load profile, the default one without a file, against the API node\n")
+				os.Stderr.WriteString("Rs            Stop the running load profile, and report what it sent\n")
+				os.Stderr.WriteString("G             Step through the journal given with -journaldebug. G alone for help\n")
 
 				//os.Stderr.WriteString("i[m/b/a][N]   Shows only the Mhash, block signing key, or anchor key up to the Nth identity\n")
 				//os.Stderr.WriteString("isN           Shows only Nth identity\n")
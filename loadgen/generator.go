@@ -0,0 +1,600 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/util"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+// The keys funded on the LOCAL network
+const (
+	localFctSecret = "FB3B471B1DCDADFEB856BD0B02D8BF49ACE0EDD372A3D9F2A95B78EC12A324D6"
+	localECSecret  = "a75ca76c169bb7776ce281a2ec3e6f5b2782938e6801f6bedc3d3e94747c32ed"
+)
+
+// How often the generator schedules operations, and asks about the pending ones
+const (
+	tick         = 100 * time.Millisecond
+	pollInterval = time.Second
+)
+
+type OpKind int
+
+const (
+	OpChain OpKind = iota
+	OpEntry
+	OpTransfer
+	OpPurchase
+)
+
+func (k OpKind) String() string {
+	switch k {
+	case OpChain:
+		return "chain"
+	case OpEntry:
+		return "entry"
+	case OpTransfer:
+		return "transfer"
+	case OpPurchase:
+		return "purchase"
+	}
+	return fmt.Sprintf("OpKind(%d)", int(k))
+}
+
+// work is an operation a phase scheduled, for a worker to submit
+type work struct {
+	kind  OpKind
+	phase *PhaseReport // credited with it, even if submitted after the phase ends
+}
+
+// operation is something submitted, waiting to be acknowledged and confirmed
+type operation struct {
+	kind      OpKind
+	chainID   string // of an entry, or "f" for a transaction
+	hash      string // entry hash or transaction id
+	submitted time.Time
+	acked     bool
+}
+
+// Generator sends the load of a profile to a target
+type Generator struct {
+	Progress io.Writer // where progress is printed every ten seconds, if set
+
+	profile   *Profile
+	target    Target
+	fctKey    *primitives.PrivateKey
+	ecKey     *primitives.PrivateKey
+	ecAddress string
+	ecRate    uint64
+
+	mutex   sync.Mutex
+	report  *Report
+	chains  []string     // chains entries can go to, once their creation is acknowledged
+	pending []*operation // submitted and not yet confirmed
+	stop    chan struct{}
+	once    sync.Once
+}
+
+func NewGenerator(p *Profile, target Target) (*Generator, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	g := new(Generator)
+	g.profile = p
+	g.target = target
+	g.stop = make(chan struct{})
+
+	var err error
+	g.fctKey, err = parseSecret(p.FctSecret, localFctSecret, primitives.HumanReadableFactoidPrivateKeyToPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("fctsecret: %s", err)
+	}
+	g.ecKey, err = parseSecret(p.ECSecret, localECSecret, primitives.HumanReadableECPrivateKeyToPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("ecsecret: %s", err)
+	}
+	g.ecAddress = primitives.ConvertECAddressToUserStr(factoid.NewAddress(g.ecKey.Pub[:]))
+	return g, nil
+}
+
+// parseSecret reads a human readable private key, or a hex one
+func parseSecret(secret string, def string, human func(string) ([]byte, error)) (*primitives.PrivateKey, error) {
+	if secret == "" {
+		secret = def
+	}
+	if len(secret) == 64 {
+		return primitives.NewPrivateKeyFromHex(secret)
+	}
+	key, err := human(secret)
+	if err != nil {
+		return nil, err
+	}
+	return primitives.NewPrivateKeyFromHexBytes(key), nil
+}
+
+// Stop ends the run early.  The report covers what was sent.
+func (g *Generator) Stop() {
+	g.once.Do(func() { close(g.stop) })
+}
+
+func (g *Generator) stopped() bool {
+	select {
+	case <-g.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *Generator) progressf(format string, args ...interface{}) {
+	if g.Progress != nil {
+		fmt.Fprintf(g.Progress, format+"\n", args...)
+	}
+}
+
+// Run funds the entry credit key, sends the load of each phase and waits for the
+// operations to be confirmed, then reports
+func (g *Generator) Run() (*Report, error) {
+	p := g.profile
+	g.report = newReport(p)
+	start := time.Now()
+
+	rate := new(wsapi.EntryCreditRateResponse)
+	if err := g.target.Call("entry-credit-rate", nil, rate); err != nil {
+		return nil, err
+	}
+	g.ecRate = uint64(rate.Rate)
+	if err := g.fund(); err != nil {
+		return nil, err
+	}
+
+	go g.poll()
+
+	queue := make(chan work, p.Workers)
+	var workers sync.WaitGroup
+	for i := 0; i < p.Workers; i++ {
+		workers.Add(1)
+		go func(r *rand.Rand) {
+			defer workers.Done()
+			for w := range queue {
+				g.submit(r, w)
+			}
+		}(rand.New(rand.NewSource(p.Seed + int64(i) + 1)))
+	}
+
+	pick := rand.New(rand.NewSource(p.Seed))
+	for _, ph := range p.Phases {
+		if g.stopped() {
+			break
+		}
+		g.runPhase(ph, pick, queue)
+	}
+	close(queue)
+	workers.Wait()
+
+	// The last operations need a block or two to be confirmed
+	wait := time.Duration(p.ConfirmSeconds) * time.Second
+	for end := time.Now().Add(wait); time.Now().Before(end) && g.pendingCount() > 0 && !g.stopped(); {
+		time.Sleep(pollInterval)
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.report.Seconds = time.Since(start).Seconds()
+	g.report.Stopped = g.stopped()
+	g.report.summarize()
+	g.Stop()
+	return g.report, nil
+}
+
+// runPhase schedules the operations of a phase at its rate
+func (g *Generator) runPhase(ph Phase, pick *rand.Rand, queue chan work) {
+	pr := &PhaseReport{Name: ph.Name}
+	g.mutex.Lock()
+	g.report.Phases = append(g.report.Phases, pr)
+	g.mutex.Unlock()
+
+	mix := g.profile.phaseMix(ph)
+	start := time.Now()
+	length := time.Duration(ph.Seconds) * time.Second
+	lastProgress := start
+	due := 0.0 // operations owed, the fraction carries over to the next tick
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		at := now.Sub(start)
+		if at >= length || g.stopped() {
+			break
+		}
+		due += ph.RateAt(at) * tick.Seconds()
+		for ; due >= 1; due-- {
+			g.mutex.Lock()
+			pr.Planned++
+			g.mutex.Unlock()
+			select {
+			case queue <- work{kind: mix.pick(pick), phase: pr}:
+			default:
+				g.mutex.Lock()
+				pr.Behind++
+				g.mutex.Unlock()
+			}
+		}
+		if now.Sub(lastProgress) >= 10*time.Second {
+			lastProgress = now
+			g.mutex.Lock()
+			g.progressf("%s %3.0fs rate %6.2f submitted %6d failed %4d behind %4d pending %6d",
+				ph.Name, at.Seconds(), ph.RateAt(at), pr.Submitted, pr.Failed, pr.Behind, len(g.pending))
+			g.mutex.Unlock()
+		}
+	}
+
+	g.mutex.Lock()
+	pr.Seconds = time.Since(start).Seconds()
+	g.mutex.Unlock()
+}
+
+// fund buys the entry credits the profile needs, and waits for the purchase to be acknowledged
+func (g *Generator) fund() error {
+	need := g.profile.FundEC
+	if need == 0 {
+		ops := g.profile.Operations()
+		entryCost := float64(g.profile.EntrySize.mean()/1024 + 1)
+		need = int((ops[OpChain]*(10+entryCost)+ops[OpEntry]*entryCost)*1.2) + 100
+	}
+	g.progressf("Buying %d entry credits for %s", need, g.ecAddress)
+	op, err := g.purchase(uint64(need))
+	if err != nil {
+		return err
+	}
+	for end := time.Now().Add(2 * time.Minute); time.Now().Before(end); time.Sleep(pollInterval) {
+		status, err := g.status(op)
+		if err == nil && acked(status) {
+			return nil
+		}
+		if g.stopped() {
+			return fmt.Errorf("Stopped while funding")
+		}
+	}
+	return fmt.Errorf("The purchase of entry credits was not acknowledged")
+}
+
+// submit builds an operation and sends it
+func (g *Generator) submit(r *rand.Rand, w work) {
+	kind := w.kind
+	if kind == OpEntry {
+		g.mutex.Lock()
+		if len(g.chains) == 0 {
+			kind = OpChain // nothing to add entries to yet
+		}
+		g.mutex.Unlock()
+	}
+
+	var op *operation
+	var err error
+	switch kind {
+	case OpChain:
+		op, err = g.newChain(r)
+	case OpEntry:
+		op, err = g.newEntry(r)
+	case OpTransfer:
+		op, err = g.transfer(r)
+	case OpPurchase:
+		op, err = g.purchase(uint64(g.profile.Purchase.Pick(r)))
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	pr := w.phase
+	or := g.report.byKind[kind]
+	if err != nil {
+		pr.Failed++
+		or.Failed++
+		g.report.Errors[err.Error()]++
+		return
+	}
+	pr.Submitted++
+	or.Submitted++
+	g.pending = append(g.pending, op)
+}
+
+// randomEntry makes an entry of the profile's sizes, with at least minExtIDs external ids
+func (g *Generator) randomEntry(r *rand.Rand, minExtIDs int) *entryBlock.Entry {
+	e := entryBlock.NewEntry()
+	n := g.profile.ExtIDs.Pick(r)
+	if n < minExtIDs {
+		n = minExtIDs
+	}
+	for i := 0; i < n; i++ {
+		e.ExtIDs = append(e.ExtIDs, primitives.ByteSlice{Bytes: randomBytes(r, g.profile.ExtIDSize.Pick(r))})
+	}
+	e.Content = primitives.ByteSlice{Bytes: randomBytes(r, g.profile.EntrySize.Pick(r))}
+	return e
+}
+
+func randomBytes(r *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	r.Read(b)
+	return b
+}
+
+func (g *Generator) newChain(r *rand.Rand) (*operation, error) {
+	e := g.randomEntry(r, 1)
+	e.ChainID = entryBlock.NewChainID(e)
+	data, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	commit := entryCreditBlock.NewCommitChain()
+	commit.Credits, err = util.EntryCost(data)
+	if err != nil {
+		return nil, err
+	}
+	commit.Credits += 10
+	commit.EntryHash = e.GetHash()
+	commit.MilliTime = milliTime()
+	commit.ECPubKey = g.ecPubKey()
+	commit.Weld = e.GetWeldHash()
+	commit.ChainIDHash = primitives.Shad(e.ChainID.Bytes())
+	if err := commit.Sign(g.ecKey.Key[:]); err != nil {
+		return nil, err
+	}
+	message, err := commit.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	op := &operation{kind: OpChain, chainID: e.ChainID.String(), hash: e.GetHash().String(), submitted: time.Now()}
+	if err := g.target.Call("commit-chain", wsapi.MessageRequest{Message: hex.EncodeToString(message)}, nil); err != nil {
+		return nil, err
+	}
+	if err := g.target.Call("reveal-chain", wsapi.EntryRequest{Entry: hex.EncodeToString(data)}, nil); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (g *Generator) newEntry(r *rand.Rand) (*operation, error) {
+	e := g.randomEntry(r, 0)
+	g.mutex.Lock()
+	chain := g.chains[r.Intn(len(g.chains))]
+	g.mutex.Unlock()
+	var err error
+	if e.ChainID, err = primitives.HexToHash(chain); err != nil {
+		return nil, err
+	}
+	data, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	commit := entryCreditBlock.NewCommitEntry()
+	commit.Credits, err = util.EntryCost(data)
+	if err != nil {
+		return nil, err
+	}
+	commit.EntryHash = e.GetHash()
+	commit.MilliTime = milliTime()
+	commit.ECPubKey = g.ecPubKey()
+	if err := commit.Sign(g.ecKey.Key[:]); err != nil {
+		return nil, err
+	}
+	message, err := commit.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	op := &operation{kind: OpEntry, chainID: chain, hash: e.GetHash().String(), submitted: time.Now()}
+	if err := g.target.Call("commit-entry", wsapi.MessageRequest{Message: hex.EncodeToString(message)}, nil); err != nil {
+		return nil, err
+	}
+	if err := g.target.Call("reveal-entry", wsapi.EntryRequest{Entry: hex.EncodeToString(data)}, nil); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (g *Generator) ecPubKey() *primitives.ByteSlice32 {
+	var pub primitives.ByteSlice32
+	copy(pub[:], g.ecKey.Pub[:])
+	return &pub
+}
+
+// milliTime is the time of a commit
+func milliTime() *primitives.ByteSlice6 {
+	var b primitives.ByteSlice6
+	ms := time.Now().UnixNano() / 1e6
+	for i := 5; i >= 0; i-- {
+		b[i] = byte(ms)
+		ms >>= 8
+	}
+	return &b
+}
+
+// transfer sends factoids to a new address
+func (g *Generator) transfer(r *rand.Rand) (*operation, error) {
+	to := factoid.NewAddress(randomBytes(r, 32))
+	return g.submitTransaction(OpTransfer, uint64(g.profile.Transfer.Pick(r)), func(tx *factoid.Transaction, amount uint64) {
+		tx.AddOutput(to, amount)
+	})
+}
+
+// purchase buys entry credits for the key paying for chains and entries
+func (g *Generator) purchase(credits uint64) (*operation, error) {
+	to := factoid.NewAddress(g.ecKey.Pub[:])
+	return g.submitTransaction(OpPurchase, credits*g.ecRate, func(tx *factoid.Transaction, amount uint64) {
+		tx.AddECOutput(to, amount)
+	})
+}
+
+// submitTransaction pays an amount and the fee from the factoid key to an output
+func (g *Generator) submitTransaction(kind OpKind, amount uint64, output func(*factoid.Transaction, uint64)) (*operation, error) {
+	rcd := factoid.NewRCD_1(g.fctKey.Pub[:])
+	from, err := rcd.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	tx := new(factoid.Transaction)
+	tx.AddInput(from, amount)
+	output(tx, amount)
+	tx.AddAuthorization(rcd)
+	tx.SetTimestamp(primitives.NewTimestampNow())
+
+	fee, err := tx.CalculateFee(g.ecRate)
+	if err != nil {
+		return nil, err
+	}
+	input, err := tx.GetInput(0)
+	if err != nil {
+		return nil, err
+	}
+	input.SetAmount(amount + fee)
+	sigData, err := tx.MarshalBinarySig()
+	if err != nil {
+		return nil, err
+	}
+	tx.SetSignatureBlock(0, factoid.NewSingleSignatureBlock(g.fctKey.Key[:32], sigData))
+	data, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	op := &operation{kind: kind, chainID: "f", hash: tx.GetSigHash().String(), submitted: time.Now()}
+	resp := new(wsapi.FactoidSubmitResponse)
+	if err := g.target.Call("factoid-submit", wsapi.TransactionRequest{Transaction: hex.EncodeToString(data)}, resp); err != nil {
+		return nil, err
+	}
+	if resp.TxID != "" {
+		op.hash = resp.TxID
+	}
+	return op, nil
+}
+
+// status asks the target how far an operation got
+func (g *Generator) status(op *operation) (string, error) {
+	req := wsapi.EntryAckWithChainRequest{Hash: op.hash, ChainID: op.chainID}
+	if op.chainID == "f" {
+		resp := new(wsapi.FactoidTxStatus)
+		err := g.target.Call("ack", req, resp)
+		return resp.Status, err
+	}
+	resp := new(wsapi.EntryStatus)
+	err := g.target.Call("ack", req, resp)
+	return resp.EntryData.Status, err
+}
+
+// acked is whether a status is at least acknowledged by the leader
+func acked(status string) bool {
+	switch status {
+	case constants.AckStatusACKString, constants.AckStatus1MinuteString, constants.AckStatusDBlockConfirmedString:
+		return true
+	}
+	return false
+}
+
+func (g *Generator) pendingCount() int {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return len(g.pending)
+}
+
+// poll asks about the pending operations until the run is over, timing when they are
+// acknowledged and confirmed.  Each pass asks with as many workers as submit, so a
+// long queue does not stretch the time between asking about an operation.
+func (g *Generator) poll() {
+	for !g.stopped() {
+		time.Sleep(pollInterval)
+		g.mutex.Lock()
+		pending := append([]*operation{}, g.pending...)
+		g.mutex.Unlock()
+
+		confirmed := make(map[*operation]bool) // under the mutex
+		ops := make(chan *operation)
+		var pollers sync.WaitGroup
+		for i := 0; i < g.profile.Workers; i++ {
+			pollers.Add(1)
+			go func() {
+				defer pollers.Done()
+				for op := range ops {
+					g.check(op, confirmed)
+				}
+			}()
+		}
+	feed:
+		for _, op := range pending {
+			select {
+			case ops <- op:
+			case <-g.stop:
+				break feed
+			}
+		}
+		close(ops)
+		pollers.Wait()
+		if g.stopped() {
+			return
+		}
+
+		g.mutex.Lock()
+		var still []*operation
+		for _, op := range g.pending {
+			if !confirmed[op] {
+				still = append(still, op)
+			}
+		}
+		g.pending = still
+		g.mutex.Unlock()
+	}
+}
+
+// check asks about an operation, and adds it to confirmed once it is
+func (g *Generator) check(op *operation, confirmed map[*operation]bool) {
+	status, err := g.status(op)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	or := g.report.byKind[op.kind]
+	if !op.acked && acked(status) {
+		op.acked = true
+		or.Acked++
+		or.ack = append(or.ack, now.Sub(op.submitted))
+		if op.kind == OpChain {
+			g.chains = append(g.chains, op.chainID)
+		}
+	}
+	if status == constants.AckStatusDBlockConfirmedString {
+		or.Confirmed++
+		or.confirm = append(or.confirm, now.Sub(op.submitted))
+		confirmed[op] = true
+	}
+}
+
+// mean is about the average of the distribution
+func (d Distribution) mean() int {
+	if len(d.Buckets) == 0 {
+		return (d.Min + d.Max) / 2
+	}
+	sum, total := 0, 0
+	for _, b := range d.Buckets {
+		sum += (b.Min + b.Max) / 2 * b.Weight
+		total += b.Weight
+	}
+	return sum / total
+}
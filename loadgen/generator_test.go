@@ -0,0 +1,126 @@
+package loadgen_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/loadgen"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+// fakeTarget acknowledges what is submitted the first time it is asked about it, and
+// confirms it the next time
+type fakeTarget struct {
+	mutex   sync.Mutex
+	delay   time.Duration // of every call
+	asked   map[string]int
+	entries int
+	txs     int
+}
+
+func newFakeTarget(delay time.Duration) *fakeTarget {
+	return &fakeTarget{delay: delay, asked: make(map[string]int)}
+}
+
+func (t *fakeTarget) Call(method string, params interface{}, result interface{}) error {
+	time.Sleep(t.delay)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	switch method {
+	case "entry-credit-rate":
+		result.(*wsapi.EntryCreditRateResponse).Rate = 1000
+	case "commit-chain", "reveal-chain", "commit-entry":
+	case "reveal-entry":
+		t.entries++
+	case "factoid-submit":
+		t.txs++
+		result.(*wsapi.FactoidSubmitResponse).TxID = fmt.Sprintf("%064x", t.txs)
+	case "ack":
+		req := params.(wsapi.EntryAckWithChainRequest)
+		t.asked[req.Hash]++
+		status := constants.AckStatusACKString
+		if t.asked[req.Hash] > 1 {
+			status = constants.AckStatusDBlockConfirmedString
+		}
+		switch r := result.(type) {
+		case *wsapi.FactoidTxStatus:
+			r.Status = status
+		case *wsapi.EntryStatus:
+			r.EntryData.Status = status
+		}
+	default:
+		return fmt.Errorf("Unexpected %s", method)
+	}
+	return nil
+}
+
+func testProfile(phases ...Phase) *Profile {
+	p := NewDefaultProfile()
+	p.Name = "test"
+	p.Mix = Mix{Entries: 1}
+	p.EntrySize = Distribution{Min: 10, Max: 20}
+	p.Workers = 2
+	p.ConfirmSeconds = 10
+	p.Seed = 1
+	p.Phases = phases
+	return p
+}
+
+func TestGenerator(t *testing.T) {
+	// Entries need a chain, so the first phase makes chains instead
+	p := testProfile(Phase{Name: "first", Seconds: 1, Rate: 20}, Phase{Name: "then", Seconds: 2, Rate: 20})
+	target := newFakeTarget(5 * time.Millisecond)
+	g, err := NewGenerator(p, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := g.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Stopped || len(report.Phases) != 2 {
+		t.Fatalf("Wrong report\n%s", report)
+	}
+	for _, ph := range report.Phases {
+		if ph.Planned == 0 || ph.Submitted+ph.Failed+ph.Behind != ph.Planned {
+			t.Errorf("Expected every operation phase %s planned to be credited to it\n%s", ph.Name, report)
+		}
+	}
+	kinds := make(map[string]*OpReport)
+	for _, op := range report.Ops {
+		kinds[op.Kind] = op
+		if op.Failed != 0 || op.Acked != op.Submitted || op.Confirmed != op.Submitted {
+			t.Errorf("Expected every %s to be acknowledged and confirmed\n%s", op.Kind, report)
+		}
+	}
+	if kinds["chain"].Submitted == 0 || kinds["entry"].Submitted == 0 || kinds["entry"].Submitted != target.entries {
+		t.Errorf("Expected chains until there were some, then entries\n%s", report)
+	}
+	if target.txs != 1 {
+		t.Errorf("Expected only the purchase funding the run, got %d transactions", target.txs)
+	}
+}
+
+func TestGeneratorStop(t *testing.T) {
+	g, err := NewGenerator(testProfile(Phase{Seconds: 600, Rate: 1}), newFakeTarget(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		g.Stop()
+	}()
+	start := time.Now()
+	report, err := g.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Stopped || time.Since(start) > 10*time.Second {
+		t.Errorf("Expected the run to stop early, it took %s\n%s", time.Since(start), report)
+	}
+	g.Stop() // again
+}
@@ -0,0 +1,301 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// The most bytes of content and external ids an entry may have, counting the two byte
+// length of each external id
+const maxEntryPayload = 10240
+
+// Profile is the traffic the load generator sends, read from YAML.  The phases run one
+// after the other, each sending operations at its rate, picked at random by the weights
+// of its mix.
+type Profile struct {
+	Name string
+
+	// Keys paying for the load, as Fs/Es strings or hex.  The LOCAL network's by default.
+	FctSecret string
+	ECSecret  string
+
+	Mix       Mix          // for phases without one
+	EntrySize Distribution // bytes of content of chains and entries
+	ExtIDs    Distribution // number of external ids of entries, chains always have at least one
+	ExtIDSize Distribution // bytes of each external id
+	Transfer  Distribution // factoshis of each factoid transfer
+	Purchase  Distribution // entry credits of each purchase
+
+	FundEC         int // entry credits bought before starting, 0 for an estimate of what the profile needs
+	Workers        int // operations submitted at once, 8 by default
+	ConfirmSeconds int // how long to wait for the last operations to be confirmed in a block, 120 by default
+	Seed           int64
+
+	Phases []Phase
+}
+
+// Mix is the relative weight of each kind of operation
+type Mix struct {
+	Chains    int
+	Entries   int
+	Transfers int
+	Purchases int
+}
+
+type Phase struct {
+	Name    string
+	Seconds int
+	Rate    float64 // operations per second
+	EndRate float64 // if set, the rate ramps from Rate to EndRate over the phase
+	Burst   Burst
+	Mix     *Mix
+}
+
+// Burst raises the rate for Seconds out of every Every seconds of a phase
+type Burst struct {
+	Every   int
+	Seconds int
+	Rate    float64
+}
+
+// Distribution picks numbers between Min and Max, or if it has buckets, from one of
+// the buckets by their weights
+type Distribution struct {
+	Min     int
+	Max     int
+	Buckets []Bucket
+}
+
+type Bucket struct {
+	Min    int
+	Max    int
+	Weight int
+}
+
+// NewDefaultProfile is a few minutes of mostly entries, ramping up and with bursts
+func NewDefaultProfile() *Profile {
+	p := new(Profile)
+	p.Name = "mixed"
+	p.Mix = Mix{Chains: 5, Entries: 80, Transfers: 10, Purchases: 5}
+	p.EntrySize = Distribution{Buckets: []Bucket{{Min: 100, Max: 500, Weight: 80}, {Min: 1000, Max: 10000, Weight: 20}}}
+	p.ExtIDs = Distribution{Min: 0, Max: 3}
+	p.ExtIDSize = Distribution{Min: 8, Max: 32}
+	p.Transfer = Distribution{Min: 1e6, Max: 1e8}
+	p.Purchase = Distribution{Min: 100, Max: 1000}
+	p.Workers = 8
+	p.ConfirmSeconds = 120
+	p.Phases = []Phase{
+		{Name: "ramp", Seconds: 60, Rate: 1, EndRate: 10},
+		{Name: "steady", Seconds: 120, Rate: 10, Burst: Burst{Every: 30, Seconds: 5, Rate: 50}},
+	}
+	return p
+}
+
+// ParseProfile reads a YAML profile.  The mix and distributions it leaves out are the
+// default profile's.
+func ParseProfile(data []byte) (*Profile, error) {
+	p := new(Profile)
+	if err := yaml.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	def := NewDefaultProfile()
+	if p.Mix.total() == 0 {
+		p.Mix = def.Mix
+	}
+	if p.ConfirmSeconds == 0 {
+		p.ConfirmSeconds = def.ConfirmSeconds
+	}
+	for _, d := range []struct{ set, def *Distribution }{
+		{&p.EntrySize, &def.EntrySize},
+		{&p.ExtIDs, &def.ExtIDs},
+		{&p.ExtIDSize, &def.ExtIDSize},
+		{&p.Transfer, &def.Transfer},
+		{&p.Purchase, &def.Purchase},
+	} {
+		if d.set.Min == 0 && d.set.Max == 0 && len(d.set.Buckets) == 0 {
+			*d.set = *d.def
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Profile) Validate() error {
+	if len(p.Phases) == 0 {
+		return fmt.Errorf("The profile has no phases")
+	}
+	for i, ph := range p.Phases {
+		if ph.Name == "" {
+			p.Phases[i].Name = fmt.Sprintf("phase%d", i+1)
+		}
+		if ph.Seconds <= 0 {
+			return fmt.Errorf("Phase %d needs a number of seconds", i+1)
+		}
+		if ph.Rate < 0 || ph.EndRate < 0 || ph.Burst.Rate < 0 {
+			return fmt.Errorf("Phase %d has a negative rate", i+1)
+		}
+		if ph.Burst.Rate > 0 && (ph.Burst.Every <= 0 || ph.Burst.Seconds <= 0 || ph.Burst.Seconds > ph.Burst.Every) {
+			return fmt.Errorf("Phase %d has a burst without seconds out of every some seconds", i+1)
+		}
+		if err := p.phaseMix(ph).validate(); err != nil {
+			return fmt.Errorf("Phase %d: %s", i+1, err)
+		}
+		if p.phaseMix(ph).total() <= 0 {
+			return fmt.Errorf("Phase %d has no operations in its mix", i+1)
+		}
+	}
+	for name, d := range map[string]Distribution{"entrysize": p.EntrySize, "extids": p.ExtIDs, "extidsize": p.ExtIDSize, "transfer": p.Transfer, "purchase": p.Purchase} {
+		if err := d.validate(); err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+	}
+	if err := p.Mix.validate(); err != nil {
+		return fmt.Errorf("mix: %s", err)
+	}
+	extIDs := p.ExtIDs.max()
+	if extIDs < 1 {
+		extIDs = 1 // chains have at least one
+	}
+	if size := p.EntrySize.max() + extIDs*(2+p.ExtIDSize.max()); size > maxEntryPayload {
+		return fmt.Errorf("Entries could have %d bytes of content and external ids, more than the %d allowed", size, maxEntryPayload)
+	}
+	if p.Workers <= 0 {
+		p.Workers = 8
+	}
+	return nil
+}
+
+func (p *Profile) phaseMix(ph Phase) Mix {
+	if ph.Mix != nil {
+		return *ph.Mix
+	}
+	return p.Mix
+}
+
+// Duration is how long the phases run
+func (p *Profile) Duration() time.Duration {
+	seconds := 0
+	for _, ph := range p.Phases {
+		seconds += ph.Seconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Operations is about how many operations of each kind the profile sends
+func (p *Profile) Operations() map[OpKind]float64 {
+	ops := make(map[OpKind]float64)
+	for _, ph := range p.Phases {
+		count := 0.0
+		for s := 0; s < ph.Seconds; s++ {
+			count += ph.RateAt(time.Duration(s) * time.Second)
+		}
+		mix := p.phaseMix(ph)
+		for kind, weight := range mix.weights() {
+			ops[kind] += count * float64(weight) / float64(mix.total())
+		}
+	}
+	return ops
+}
+
+// RateAt is the operations per second a phase sends at a time into it
+func (ph Phase) RateAt(at time.Duration) float64 {
+	if b := ph.Burst; b.Rate > 0 && int(at/time.Second)%b.Every < b.Seconds {
+		return b.Rate
+	}
+	if ph.EndRate > 0 {
+		return ph.Rate + (ph.EndRate-ph.Rate)*at.Seconds()/float64(ph.Seconds)
+	}
+	return ph.Rate
+}
+
+func (m Mix) weights() map[OpKind]int {
+	return map[OpKind]int{OpChain: m.Chains, OpEntry: m.Entries, OpTransfer: m.Transfers, OpPurchase: m.Purchases}
+}
+
+func (m Mix) validate() error {
+	for kind, weight := range m.weights() {
+		if weight < 0 {
+			return fmt.Errorf("The %ss weigh %d", kind, weight)
+		}
+	}
+	return nil
+}
+
+func (m Mix) total() int {
+	return m.Chains + m.Entries + m.Transfers + m.Purchases
+}
+
+// pick returns a kind of operation by the weights of the mix
+func (m Mix) pick(r *rand.Rand) OpKind {
+	n := r.Intn(m.total())
+	for _, kind := range []OpKind{OpChain, OpEntry, OpTransfer, OpPurchase} {
+		n -= m.weights()[kind]
+		if n < 0 {
+			return kind
+		}
+	}
+	return OpEntry
+}
+
+func (d Distribution) validate() error {
+	if len(d.Buckets) == 0 {
+		if d.Min < 0 || d.Max < d.Min {
+			return fmt.Errorf("Bad range %d to %d", d.Min, d.Max)
+		}
+		return nil
+	}
+	total := 0
+	for _, b := range d.Buckets {
+		if b.Min < 0 || b.Max < b.Min || b.Weight < 0 {
+			return fmt.Errorf("Bad bucket %d to %d weighing %d", b.Min, b.Max, b.Weight)
+		}
+		total += b.Weight
+	}
+	if total == 0 {
+		return fmt.Errorf("The buckets weigh nothing")
+	}
+	return nil
+}
+
+// max is the largest number the distribution picks
+func (d Distribution) max() int {
+	if len(d.Buckets) == 0 {
+		return d.Max
+	}
+	max := 0
+	for _, b := range d.Buckets {
+		if b.Weight > 0 && b.Max > max {
+			max = b.Max
+		}
+	}
+	return max
+}
+
+// Pick returns a number of the distribution
+func (d Distribution) Pick(r *rand.Rand) int {
+	min, max := d.Min, d.Max
+	if len(d.Buckets) > 0 {
+		total := 0
+		for _, b := range d.Buckets {
+			total += b.Weight
+		}
+		n := r.Intn(total)
+		for _, b := range d.Buckets {
+			n -= b.Weight
+			if n < 0 {
+				min, max = b.Min, b.Max
+				break
+			}
+		}
+	}
+	return min + r.Intn(max-min+1)
+}
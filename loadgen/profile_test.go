@@ -0,0 +1,105 @@
+package loadgen_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	. "github.com/FactomProject/factomd/loadgen"
+)
+
+func TestParseProfile(t *testing.T) {
+	p, err := ParseProfile([]byte(`
+name: chains
+mix:
+  chains: 1
+entrysize:
+  min: 10
+  max: 20
+phases:
+  - seconds: 10
+    rate: 2
+    endrate: 4
+  - name: entries
+    seconds: 5
+    rate: 1
+    mix:
+      entries: 1
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "chains" || p.Mix.Chains != 1 || p.EntrySize.Max != 20 || len(p.Phases) != 2 {
+		t.Errorf("Wrong profile %+v", p)
+	}
+	if p.Phases[0].Name != "phase1" || p.Phases[1].Mix == nil || p.Phases[1].Mix.Entries != 1 {
+		t.Errorf("Wrong phases %+v", p.Phases)
+	}
+	if p.Transfer.Max != NewDefaultProfile().Transfer.Max || p.Workers != 8 || p.ConfirmSeconds != 120 {
+		t.Errorf("Expected the defaults for what the profile leaves out")
+	}
+	if p.Duration() != 15*time.Second {
+		t.Errorf("Wrong duration %s", p.Duration())
+	}
+
+	for _, bad := range []string{
+		"phases: []",
+		"phases: [{rate: 1}]",
+		"phases: [{seconds: 1, rate: -1}]",
+		"phases: [{seconds: 1, rate: 1, burst: {rate: 5}}]",
+		"entrysize: {min: 5, max: 1}\nphases: [{seconds: 1, rate: 1}]",
+		"entrysize: {min: 5, max: 10241}\nphases: [{seconds: 1, rate: 1}]",
+		"extids: {min: 1, max: 10}\nextidsize: {min: 1, max: 1000}\nphases: [{seconds: 1, rate: 1}]",
+		"mix: {chains: 2, entries: -1}\nphases: [{seconds: 1, rate: 1}]",
+		"phases: [{seconds: 1, rate: 1, mix: {entries: 2, transfers: -1}}]",
+	} {
+		if _, err := ParseProfile([]byte(bad)); err == nil {
+			t.Errorf("Expected %q to be refused", bad)
+		}
+	}
+}
+
+func TestPhaseRate(t *testing.T) {
+	ramp := Phase{Seconds: 10, Rate: 1, EndRate: 11}
+	if r := ramp.RateAt(5 * time.Second); r != 6 {
+		t.Errorf("Expected a rate of 6 half way up the ramp, got %f", r)
+	}
+
+	burst := Phase{Seconds: 60, Rate: 10, Burst: Burst{Every: 30, Seconds: 5, Rate: 50}}
+	for at, rate := range map[time.Duration]float64{0: 50, 4 * time.Second: 50, 5 * time.Second: 10, 31 * time.Second: 50, 40 * time.Second: 10} {
+		if r := burst.RateAt(at); r != rate {
+			t.Errorf("Expected a rate of %f at %s, got %f", rate, at, r)
+		}
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := Distribution{Buckets: []Bucket{{Min: 1, Max: 2, Weight: 1}, {Min: 100, Max: 100, Weight: 3}}}
+	counts := make(map[int]int)
+	for i := 0; i < 4000; i++ {
+		n := d.Pick(r)
+		if n != 1 && n != 2 && n != 100 {
+			t.Fatalf("Picked %d, outside the buckets", n)
+		}
+		counts[n]++
+	}
+	if counts[100] < 2700 || counts[100] > 3300 {
+		t.Errorf("Expected about 3000 picks from the heavier bucket, got %d", counts[100])
+	}
+
+	d = Distribution{Min: 5, Max: 5}
+	if n := d.Pick(r); n != 5 {
+		t.Errorf("Expected 5, got %d", n)
+	}
+}
+
+func TestOperations(t *testing.T) {
+	p := NewDefaultProfile()
+	p.Mix = Mix{Chains: 1, Entries: 3}
+	p.Phases = []Phase{{Seconds: 10, Rate: 4}}
+	ops := p.Operations()
+	if ops[OpChain] != 10 || ops[OpEntry] != 30 || ops[OpTransfer] != 0 {
+		t.Errorf("Wrong operations %v", ops)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Report is what a run of a profile achieved
+type Report struct {
+	Profile string         `json:"profile"`
+	Seconds float64        `json:"seconds"`
+	Phases  []*PhaseReport `json:"phases"`
+	Ops     []*OpReport    `json:"ops"`
+	Ack     LatencySummary `json:"ack"`     // of all operations
+	Confirm LatencySummary `json:"confirm"` // of all operations
+	Stopped bool           `json:"stopped,omitempty"`
+	Errors  map[string]int `json:"errors,omitempty"` // by message
+	byKind  map[OpKind]*OpReport
+}
+
+type PhaseReport struct {
+	Name      string  `json:"name"`
+	Seconds   float64 `json:"seconds"`
+	Planned   int     `json:"planned"`   // operations the rate asked for
+	Submitted int     `json:"submitted"` // accepted by the node
+	Failed    int     `json:"failed"`    // refused by the node
+	Behind    int     `json:"behind"`    // not sent because the workers could not keep up
+	TPS       float64 `json:"tps"`       // submitted per second
+}
+
+type OpReport struct {
+	Kind      string         `json:"kind"`
+	Submitted int            `json:"submitted"`
+	Failed    int            `json:"failed"`
+	Acked     int            `json:"acked"`
+	Confirmed int            `json:"confirmed"`
+	Ack       LatencySummary `json:"ack"`
+	Confirm   LatencySummary `json:"confirm"`
+
+	ack, confirm latencies
+}
+
+// LatencySummary is the percentiles of some latencies, in milliseconds
+type LatencySummary struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+	Max   float64 `json:"max"`
+}
+
+type latencies []time.Duration
+
+// Percentile returns the latency that p percent of them are at or under
+func (l latencies) Percentile(p float64) time.Duration {
+	if len(l) == 0 {
+		return 0
+	}
+	sorted := append(latencies{}, l...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	i := int(p/100*float64(len(sorted))+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+func (l latencies) Summary() LatencySummary {
+	ms := func(d time.Duration) float64 { return float64(d) / float64(time.Millisecond) }
+	return LatencySummary{
+		Count: len(l),
+		P50:   ms(l.Percentile(50)),
+		P90:   ms(l.Percentile(90)),
+		P99:   ms(l.Percentile(99)),
+		Max:   ms(l.Percentile(100)),
+	}
+}
+
+func newReport(p *Profile) *Report {
+	r := new(Report)
+	r.Profile = p.Name
+	r.Errors = make(map[string]int)
+	r.byKind = make(map[OpKind]*OpReport)
+	for _, kind := range []OpKind{OpChain, OpEntry, OpTransfer, OpPurchase} {
+		op := &OpReport{Kind: kind.String()}
+		r.Ops = append(r.Ops, op)
+		r.byKind[kind] = op
+	}
+	return r
+}
+
+// summarize works out the rates and latency percentiles once the run is over, and the
+// operations phases scheduled near their end are submitted
+func (r *Report) summarize() {
+	for _, ph := range r.Phases {
+		if ph.Seconds > 0 {
+			ph.TPS = float64(ph.Submitted) / ph.Seconds
+		}
+	}
+	var ack, confirm latencies
+	for _, op := range r.Ops {
+		op.Ack = op.ack.Summary()
+		op.Confirm = op.confirm.Summary()
+		ack = append(ack, op.ack...)
+		confirm = append(confirm, op.confirm...)
+	}
+	r.Ack = ack.Summary()
+	r.Confirm = confirm.Summary()
+}
+
+func (r *Report) String() string {
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "Load profile %s ran for %.0f seconds", r.Profile, r.Seconds)
+	if r.Stopped {
+		fmt.Fprintf(&out, ", stopped")
+	}
+	fmt.Fprintf(&out, "\n\n%-12s %8s %10s %10s %8s %8s %8s\n", "Phase", "Seconds", "Planned", "Submitted", "Failed", "Behind", "TPS")
+	for _, ph := range r.Phases {
+		fmt.Fprintf(&out, "%-12s %8.0f %10d %10d %8d %8d %8.2f\n", ph.Name, ph.Seconds, ph.Planned, ph.Submitted, ph.Failed, ph.Behind, ph.TPS)
+	}
+
+	fmt.Fprintf(&out, "\n%-12s %10s %8s %8s %10s   %-31s   %-31s\n", "Operation", "Submitted", "Failed", "Acked", "Confirmed", "Ack ms p50/p90/p99/max", "Confirm ms p50/p90/p99/max")
+	row := func(name string, submitted, failed, acked, confirmed int, ack, confirm LatencySummary) {
+		fmt.Fprintf(&out, "%-12s %10d %8d %8d %10d   %-31s   %-31s\n", name, submitted, failed, acked, confirmed, ack, confirm)
+	}
+	var submitted, failed, acked, confirmed int
+	for _, op := range r.Ops {
+		row(op.Kind, op.Submitted, op.Failed, op.Acked, op.Confirmed, op.Ack, op.Confirm)
+		submitted += op.Submitted
+		failed += op.Failed
+		acked += op.Acked
+		confirmed += op.Confirmed
+	}
+	row("all", submitted, failed, acked, confirmed, r.Ack, r.Confirm)
+
+	if len(r.Errors) > 0 {
+		fmt.Fprintf(&out, "\nErrors:\n")
+		var list []string
+		for e := range r.Errors {
+			list = append(list, e)
+		}
+		sort.Strings(list)
+		for _, e := range list {
+			fmt.Fprintf(&out, "%8d %s\n", r.Errors[e], e)
+		}
+	}
+	return out.String()
+}
+
+func (l LatencySummary) String() string {
+	if l.Count == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f/%.0f/%.0f/%.0f", l.P50, l.P90, l.P99, l.Max)
+}
@@ -0,0 +1,41 @@
+package loadgen
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLatencies(t *testing.T) {
+	var l latencies
+	for i := 100; i > 0; i-- {
+		l = append(l, time.Duration(i)*time.Millisecond)
+	}
+	s := l.Summary()
+	if s.Count != 100 || s.P50 != 50 || s.P90 != 90 || s.P99 != 99 || s.Max != 100 {
+		t.Errorf("Wrong summary %+v", s)
+	}
+	if (latencies{}).Summary().Max != 0 {
+		t.Error("Expected no latencies to summarize to zero")
+	}
+}
+
+func TestReport(t *testing.T) {
+	r := newReport(NewDefaultProfile())
+	r.Phases = append(r.Phases, &PhaseReport{Name: "ramp", Seconds: 10, Planned: 20, Submitted: 18, Failed: 2, TPS: 1.8})
+	r.byKind[OpEntry].Submitted = 18
+	r.byKind[OpEntry].Acked = 2
+	r.byKind[OpEntry].ack = latencies{time.Second, 3 * time.Second}
+	r.Errors["Insufficient balance"] = 2
+	r.summarize()
+
+	if r.Ack.Count != 2 || r.Ack.Max != 3000 || r.Confirm.Count != 0 {
+		t.Errorf("Wrong summaries %+v %+v", r.Ack, r.Confirm)
+	}
+	out := r.String()
+	for _, want := range []string{"ramp", "entry", "1000/3000/3000/3000", "Insufficient balance"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in the report:\n%s", want, out)
+		}
+	}
+}
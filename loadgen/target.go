@@ -0,0 +1,108 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package loadgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/wsapi"
+)
+
+// Target is a node the load is sent to, through the V2 API
+type Target interface {
+	// Call makes a V2 API call and decodes its result into result
+	Call(method string, params interface{}, result interface{}) error
+}
+
+// HTTPTarget sends the load to a node's wsapi
+type HTTPTarget struct {
+	URL      string // such as http://localhost:8088/v2
+	User     string
+	Password string
+	client   *http.Client
+}
+
+func NewHTTPTarget(url string, user string, password string) *HTTPTarget {
+	t := new(HTTPTarget)
+	t.URL = url
+	t.User = user
+	t.Password = password
+	t.client = &http.Client{Timeout: 30 * time.Second}
+	return t
+}
+
+func (t *HTTPTarget) Call(method string, params interface{}, result interface{}) error {
+	data, err := json.Marshal(primitives.NewJSON2Request(method, 0, params))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", t.URL, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if t.User != "" {
+		req.SetBasicAuth(t.User, t.Password)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	r := new(struct {
+		Result json.RawMessage       `json:"result"`
+		Error  *primitives.JSONError `json:"error"`
+	})
+	if err := json.Unmarshal(body, r); err != nil {
+		return fmt.Errorf("%s: %s", resp.Status, err)
+	}
+	if r.Error != nil {
+		return jsonError(r.Error)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(r.Result, result)
+}
+
+// StateTarget sends the load to a node in this process, such as one of the simulator's
+type StateTarget struct {
+	GetState func() interfaces.IState // the node to call, looked up for every call
+}
+
+func NewStateTarget(getState func() interfaces.IState) *StateTarget {
+	return &StateTarget{GetState: getState}
+}
+
+func (t *StateTarget) Call(method string, params interface{}, result interface{}) error {
+	resp, jerr := wsapi.HandleV2JSONRequest(t.GetState(), primitives.NewJSON2Request(method, 0, params))
+	if jerr != nil {
+		return jsonError(jerr)
+	}
+	if result == nil {
+		return nil
+	}
+	return wsapi.MapToObject(resp.Result, result)
+}
+
+// jsonError gives the message of an API error, with its details if it has some
+func jsonError(e *primitives.JSONError) error {
+	if e.Data != nil {
+		return fmt.Errorf("%s: %v", e.Message, e.Data)
+	}
+	return fmt.Errorf("%s", e.Message)
+}
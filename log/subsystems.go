@@ -0,0 +1,235 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Each subsystem logs through its own logrus logger, so its level can be changed on its
+// own while the node runs.  All the loggers share the output format, and every entry
+// carries the subsystem's name in the "subsystem" field.  The "general" subsystem is the
+// logrus standard logger, used by code that logs through logrus directly.
+//
+// A subsystem's message logs are the debug files written by messages.LogPrintf and
+// messages.LogMessage that belong to it.  They are turned on by adding them to the
+// -debuglog regex.
+
+const (
+	LevelNone = "none" // discard the subsystem's logs
+	General   = "general"
+	All       = "all" // every subsystem, and the default for ones not yet created
+)
+
+// Names are the subsystems factomd logs through, so their levels can be set before they
+// first log
+var Names = []string{General, "alerts", "engine", "messages", "p2p", "state", "wsapi"}
+
+// MessageLogs are the names of each subsystem's message logs, as a regex to add to the
+// -debuglog regex.  The database and elections code only write message logs, so they
+// have no level.
+var MessageLogs = map[string]string{
+	"engine":    "networkinputs|networkoutputs|inmsgqueue|msgqueue|prioritizedmsgqueue|apilog",
+	"state":     "executemsg|processlist|process|holding|dependentholding|ackqueue|ackchange|mmr|missing_messages",
+	"elections": "election|elections|faulting|dbsig-eom",
+	"messages":  "badevents",
+	"database":  "dbstateprocess|dbstatecatchup|database|savestate|entrysync|entrysyncing",
+}
+
+// SubsystemLevel is a subsystem's current level
+type SubsystemLevel struct {
+	Name        string `json:"name"`
+	Level       string `json:"level,omitempty"` // none for the subsystems with only message logs
+	MessageLogs string `json:"messagelogs,omitempty"`
+}
+
+type subsystem struct {
+	logger *logrus.Logger
+	level  string
+	out    io.Writer // nil for the shared output
+}
+
+var (
+	subsMutex    sync.Mutex
+	subsystems             = make(map[string]*subsystem)
+	sharedOut    io.Writer = os.Stderr
+	defaultLevel           = "info"
+	jsonFormat   bool
+)
+
+func init() {
+	subsystems[General] = &subsystem{logger: logrus.StandardLogger(), level: defaultLevel}
+	for _, name := range Names {
+		get(name)
+	}
+}
+
+// Subsystem returns the logger of the named subsystem, creating it at the default level
+func Subsystem(name string) *logrus.Entry {
+	subsMutex.Lock()
+	defer subsMutex.Unlock()
+	return get(name).logger.WithField("subsystem", name)
+}
+
+// assumes subsMutex is locked already
+func get(name string) *subsystem {
+	sub, ok := subsystems[name]
+	if !ok {
+		sub = &subsystem{logger: logrus.New()}
+		subsystems[name] = sub
+		sub.apply(defaultLevel)
+	}
+	return sub
+}
+
+// apply sets the subsystem's level, output and format.  The level has been checked.
+func (sub *subsystem) apply(level string) {
+	sub.level = level
+	if jsonFormat {
+		sub.logger.SetFormatter(&logrus.JSONFormatter{})
+	} else {
+		sub.logger.SetFormatter(&logrus.TextFormatter{})
+	}
+	if level == LevelNone {
+		sub.logger.SetOutput(ioutil.Discard)
+		sub.logger.SetLevel(logrus.PanicLevel)
+		return
+	}
+	if sub.out != nil {
+		sub.logger.SetOutput(sub.out)
+	} else {
+		sub.logger.SetOutput(sharedOut)
+	}
+	lvl, _ := logrus.ParseLevel(level)
+	sub.logger.SetLevel(lvl)
+}
+
+// checkLevel returns the level in the form it is kept in, or an error if it is not a level
+func checkLevel(level string) (string, error) {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if level == LevelNone {
+		return level, nil
+	}
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return "", fmt.Errorf("Unknown log level %q, use none, debug, info, warning, error, fatal or panic", level)
+	}
+	return lvl.String(), nil
+}
+
+// Configure sets the level of every subsystem, and whether they log JSON.  The logs go
+// to stdout.
+func Configure(level string, json bool) error {
+	level, err := checkLevel(level)
+	if err != nil {
+		return err
+	}
+	subsMutex.Lock()
+	defer subsMutex.Unlock()
+	sharedOut = os.Stdout
+	jsonFormat = json
+	defaultLevel = level
+	for _, sub := range subsystems {
+		sub.apply(level)
+	}
+	return nil
+}
+
+// SetSubsystemLevel sets the level of the named subsystem, or of every subsystem if the
+// name is "all".  Only subsystems that have been created have a level.
+func SetSubsystemLevel(name string, level string) error {
+	level, err := checkLevel(level)
+	if err != nil {
+		return err
+	}
+	subsMutex.Lock()
+	defer subsMutex.Unlock()
+	if name == All {
+		defaultLevel = level
+		for _, sub := range subsystems {
+			sub.apply(level)
+		}
+		return nil
+	}
+	sub, ok := subsystems[name]
+	if !ok {
+		return fmt.Errorf("Unknown log subsystem %q", name)
+	}
+	sub.apply(level)
+	return nil
+}
+
+// SetSubsystemLevels sets levels from a list of subsystem=level pairs, such as
+// "p2p=warning,state=debug"
+func SetSubsystemLevels(levels string) error {
+	for _, pair := range strings.Split(levels, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		parts := strings.Split(pair, "=")
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return fmt.Errorf("Bad log level %q, expected subsystem=level", pair)
+		}
+		err := SetSubsystemLevel(strings.TrimSpace(parts[0]), parts[1])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetSubsystemOutput sends the named subsystem's logs somewhere other than the shared output
+func SetSubsystemOutput(name string, out io.Writer) {
+	subsMutex.Lock()
+	defer subsMutex.Unlock()
+	sub := get(name)
+	sub.out = out
+	sub.apply(sub.level)
+}
+
+// SubsystemLevels returns the levels and message logs of the subsystems, sorted by name
+func SubsystemLevels() []SubsystemLevel {
+	subsMutex.Lock()
+	defer subsMutex.Unlock()
+	var levels []SubsystemLevel
+	for name, sub := range subsystems {
+		levels = append(levels, SubsystemLevel{Name: name, Level: sub.level, MessageLogs: MessageLogs[name]})
+	}
+	for name, logs := range MessageLogs {
+		if _, ok := subsystems[name]; !ok {
+			levels = append(levels, SubsystemLevel{Name: name, MessageLogs: logs})
+		}
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i].Name < levels[j].Name })
+	return levels
+}
+
+// AddMessageLogs returns the -debuglog regex with the named subsystem's message logs
+// added to it.  Any directory at the start of the regex is kept.
+func AddMessageLogs(regex string, name string) (string, error) {
+	logs, ok := MessageLogs[name]
+	if !ok {
+		return "", fmt.Errorf("The %s subsystem has no message logs", name)
+	}
+	dir, current := "", regex
+	if i := strings.LastIndex(regex, string(os.PathSeparator)); i >= 0 {
+		dir, current = regex[:i+1], regex[i+1:]
+	}
+	if current == "" || current == "." || current == ".*" {
+		if current != "" {
+			return regex, nil // already logging everything
+		}
+		return dir + "(" + logs + ")", nil
+	}
+	if _, err := regexp.Compile(current); err != nil {
+		return "", err
+	}
+	return dir + current + "|(" + logs + ")", nil
+}
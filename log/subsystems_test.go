@@ -0,0 +1,109 @@
+package log_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/FactomProject/factomd/log"
+)
+
+func level(name string) string {
+	for _, l := range SubsystemLevels() {
+		if l.Name == name {
+			return l.Level
+		}
+	}
+	return ""
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	if err := Configure("warn", false); err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	SetSubsystemOutput("testing", buf)
+	logger := Subsystem("testing")
+
+	logger.Info("hidden")
+	logger.Warn("shown")
+	if strings.Contains(buf.String(), "hidden") || !strings.Contains(buf.String(), "subsystem=testing") {
+		t.Errorf("Expected only the warning, got %q", buf.String())
+	}
+
+	// The level changes under the existing logger
+	if err := SetSubsystemLevels("testing=debug, p2p=error"); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	logger.Debug("debugging")
+	if !strings.Contains(buf.String(), "debugging") {
+		t.Errorf("Expected the debug line, got %q", buf.String())
+	}
+	if level("p2p") != "error" || level("state") != "warning" {
+		t.Errorf("Wrong levels %v", SubsystemLevels())
+	}
+
+	if err := SetSubsystemLevel("testing", "none"); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	logger.Error("discarded")
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing logged, got %q", buf.String())
+	}
+
+	// The database only writes message logs
+	if err := SetSubsystemLevel("database", "debug"); err == nil {
+		t.Error("Expected a subsystem without a logger to be refused")
+	}
+	for _, l := range SubsystemLevels() {
+		if l.Name == "database" && (l.Level != "" || l.MessageLogs == "") {
+			t.Errorf("Expected only message logs for the database, got %v", l)
+		}
+	}
+
+	for _, bad := range []string{"testing=loud", "testing", "=debug", "nosuch=debug"} {
+		if err := SetSubsystemLevels(bad); err == nil {
+			t.Errorf("Expected %q to be refused", bad)
+		}
+	}
+
+	// JSON output, and "all" sets every subsystem
+	if err := Configure("info", true); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	logger.Info("json")
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil || fields["subsystem"] != "testing" {
+		t.Errorf("Expected a JSON line, got %q", buf.String())
+	}
+	SetSubsystemLevel(All, "panic")
+	if level("testing") != "panic" || level(General) != "panic" {
+		t.Errorf("Wrong levels %v", SubsystemLevels())
+	}
+	Configure("none", false)
+}
+
+func TestAddMessageLogs(t *testing.T) {
+	tests := []struct{ regex, result string }{
+		{"", "(" + MessageLogs["elections"] + ")"},
+		{".*", ".*"},
+		{"holding", "holding|(" + MessageLogs["elections"] + ")"},
+		{"logs/holding", "logs/holding|(" + MessageLogs["elections"] + ")"},
+	}
+	for _, test := range tests {
+		r, err := AddMessageLogs(test.regex, "elections")
+		if err != nil || r != test.result {
+			t.Errorf("Expected %q from %q, got %q %v", test.result, test.regex, r, err)
+		}
+	}
+	if _, err := AddMessageLogs("holding", "nosuch"); err == nil {
+		t.Error("Expected an error for a subsystem without message logs")
+	}
+	if _, err := AddMessageLogs("(holding", "state"); err == nil {
+		t.Error("Expected an error for a bad regex")
+	}
+}
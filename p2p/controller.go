@@ -20,14 +20,13 @@ import (
 
 	"github.com/FactomProject/factomd/common/primitives"
 
+	flog "github.com/FactomProject/factomd/log"
 	log "github.com/sirupsen/logrus"
 )
 
 // packageLogger is the general logger for all p2p related logs. You can add additional fields,
 // or create more context loggers off of this
-var packageLogger = log.WithFields(log.Fields{
-	"package":   "p2p",
-	"component": "networking"})
+var packageLogger = flog.Subsystem("p2p").WithField("component", "networking")
 
 var controllerLogger = packageLogger.WithField("subpack", "controller")
 
@@ -486,6 +485,8 @@ func (c *Controller) handleParcelReceive(message interface{}, peerHash string, c
 			ApplicationMessagesReceived++
 			BlockFreeChannelSend(c.FromNetwork, *assembled)
 		}
+	case TypeAlert: // An alert raised on the peer, send it on.
+		BlockFreeChannelSend(c.FromNetwork, parcel)
 	case TypePeerRequest: // send a response to the connection over its connection.SendChannel
 		// Get selection of peers from discovery
 		response := NewParcel(CurrentNetwork, c.discovery.SharePeers())
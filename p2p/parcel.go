@@ -38,6 +38,7 @@ type ParcelHeader struct {
 	PeerPort    string // port of the peer , or we are listening on
 	AppHash     string // Application specific message hash, for tracing
 	AppType     string // Application specific message type, for tracing
+	TraceParent string // W3C trace context of the message, for distributed tracing
 }
 
 type ParcelCommandType uint16
@@ -120,6 +121,7 @@ func ReassembleParcel(parcels []*Parcel) *Parcel {
 	assembledParcel.Header.TargetPeer = origHeader.TargetPeer
 	assembledParcel.Header.PeerAddress = origHeader.PeerAddress
 	assembledParcel.Header.PeerPort = origHeader.PeerPort
+	assembledParcel.Header.TraceParent = origHeader.TraceParent
 
 	return assembledParcel
 }
@@ -0,0 +1,34 @@
+package p2p_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/FactomProject/factomd/p2p"
+)
+
+func TestReassembleParcel(t *testing.T) {
+	payload := bytes.Repeat([]byte{1, 2, 3}, MaxPayloadSize)
+	traceParent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+	var parts []*Parcel
+	for _, p := range ParcelsForPayload(TestNet, payload) {
+		p := p
+		p.Header.TraceParent = traceParent
+		parts = append(parts, &p)
+	}
+	if len(parts) < 2 {
+		t.Fatalf("Exp the payload split in parts, got %d", len(parts))
+	}
+
+	assembled := ReassembleParcel(parts)
+	if !bytes.Equal(assembled.Payload, payload) {
+		t.Errorf("Exp the payload reassembled")
+	}
+	if assembled.Header.Type != TypeMessage {
+		t.Errorf("Exp a message, got type %d", assembled.Header.Type)
+	}
+	if assembled.Header.TraceParent != traceParent {
+		t.Errorf("Exp the trace parent %s, got %s", traceParent, assembled.Header.TraceParent)
+	}
+}
@@ -145,12 +145,14 @@ func (s *State) makeMMRs(asks <-chan askRef, adds <-chan plRef, dbheights <-chan
 	pending := make(map[plRef]*int64)
 	ticker := make(chan int64, 50)               // this should deep enough you know that the reading thread is dead if it fills up
 	mmrs := make(map[dbhvm]*messages.MissingMsg) // an MMR per DBH/VM
+	refs := make(map[dbhvm][]plRef)              // the slots asked for by each MMR
 	logname := "missing_messages"
 	var now int64
 
 	// Delete any pending ask for a message that was just added to the processlist
 	deletePendingAsk := func(add plRef) {
 		delete(pending, add) // Delete request that was just added to the process list in the map
+		s.MMRTracker.Filled(add)
 		s.LogPrintf(logname, "Add %d/%d/%d %d", add.DBH, add.VM, add.H, len(pending))
 	}
 
@@ -260,6 +262,8 @@ func (s *State) makeMMRs(asks <-chan askRef, adds <-chan plRef, dbheights <-chan
 			if askDelay < time.Millisecond*500 { // Don't go below 500ms. That is just too much
 				askDelay = time.Millisecond * 500
 			}
+			// Tick as often as we re-ask, which speeds up when the network answers quickly
+			askDelay = s.MMRTracker.AskDelay(askDelay)
 
 			time.Sleep(askDelay)
 		}
@@ -277,6 +281,8 @@ func (s *State) makeMMRs(asks <-chan askRef, adds <-chan plRef, dbheights <-chan
 		if askDelay < 500 { // Don't go below 500ms. That is just too much
 			askDelay = 500
 		}
+		// Re-ask sooner if the network has been answering faster than that
+		askDelay = int64(s.MMRTracker.AskDelay(time.Duration(askDelay)*time.Millisecond) / time.Millisecond)
 
 		if askDelay != lastAskDelay {
 			s.LogPrintf(logname, "AskDelay %d BlockTime %d", askDelay, s.DirectoryBlockInSeconds)
@@ -302,6 +308,7 @@ func (s *State) makeMMRs(asks <-chan askRef, adds <-chan plRef, dbheights <-chan
 					delete(pending, ask)
 				}
 			}
+			s.MMRTracker.Expire(dbheight)
 		case ask := <-asks:
 			s.LogPrintf("mmr", "start ask handling")
 			addAsk(ask)  // add this ask
@@ -329,7 +336,8 @@ func (s *State) makeMMRs(asks <-chan askRef, adds <-chan plRef, dbheights <-chan
 					var index dbhvm = dbhvm{ref.DBH, ref.VM}
 					// Drop any MMR request that are before the current height
 					if ref.DBH < int(s.LLeaderHeight) {
-						deletePendingAsk(ref)
+						s.LogPrintf(logname, "Expire %d/%d/%d %d", ref.DBH, ref.VM, ref.H, len(pending))
+						delete(pending, ref)
 						continue
 					}
 					// if ask is expired or we have an MMR for this DBH/VM and it's not a brand new ask
@@ -342,6 +350,7 @@ func (s *State) makeMMRs(asks <-chan askRef, adds <-chan plRef, dbheights <-chan
 							// Add an ask for each msg we ask for, even if we bundle the asks.
 							// This is so the accounting adds upp.
 						}
+						refs[index] = append(refs[index], ref)
 						s.MissingRequestAskCnt++
 						*when = now + askDelay // update when we asked
 						// Maybe when asking for past the end of the list we should not ask again?
@@ -350,12 +359,20 @@ func (s *State) makeMMRs(asks <-chan askRef, adds <-chan plRef, dbheights <-chan
 
 			}
 			for index, mmr := range mmrs {
+				// Aim the ask at the peer most likely to answer it quickly
+				if origin, networkOrigin, ok := s.MMRTracker.Asked(refs[index]); ok {
+					mmr.SetOrigin(origin)
+					mmr.SetNetworkOrigin(networkOrigin)
+				}
 				s.LogMessage(logname, "sendout", mmr)
 				if MMR_enable {
 					mmr.SendOut(s, mmr)
 				}
 				delete(mmrs, index)
+				delete(refs, index)
 			} // Send MMRs that were built
+			MMRAskDelay.Set(float64(askDelay) / 1000)
+			MMRPending.Set(float64(s.MMRTracker.Pending()))
 		} // select across all the channels, block till something happens
 		s.LogPrintf("mmr", "done")
 	} // forever ...
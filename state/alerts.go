@@ -0,0 +1,61 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"time"
+
+	"github.com/FactomProject/factomd/common/alerts"
+)
+
+// alertWatch is what the polled alert detectors remember between checks
+type alertWatch struct {
+	entryHeight   uint32    // entries complete to this block
+	entryProgress time.Time // when the entries last progressed, or were caught up
+}
+
+// WatchAlerts runs the alert detectors that poll the state, once a second
+func (s *State) WatchAlerts() {
+	for {
+		time.Sleep(time.Second)
+		if s.RunState.IsTerminating() {
+			return
+		}
+		s.checkAlerts(time.Now())
+	}
+}
+
+func (s *State) checkAlerts(now time.Time) {
+	t := s.HealthThresholds
+	node := s.FactomNodeName
+	height := s.GetLLeaderHeight()
+
+	// Minutes only progress once the node has caught up
+	synced := s.DBFinished && int64(s.GetHighestKnownBlock())-int64(s.GetDBHeightComplete()) <= int64(t.MaxBlockLag)
+	limit := time.Duration(t.MaxMinuteSeconds) * time.Second
+	if limit <= 0 {
+		// Three times the expected minute, a tenth of a block
+		limit = 3 * time.Duration(s.DirectoryBlockInSeconds) * time.Second / 10
+	}
+	elapsed := time.Duration(s.GetCurrentTime() - s.GetCurrentMinuteStartTime())
+	alerts.Set(node, alerts.MinuteStalled, synced && elapsed > limit, height,
+		"minute %d started %d seconds ago", s.CurrentMinute, int64(elapsed/time.Second))
+
+	// Entries are stalled if they are behind, and have not progressed for three blocks
+	complete := s.GetEntryDBHeightComplete()
+	behind := int64(s.GetHighestSavedBlk()) - int64(complete)
+	w := &s.alertWatch
+	if complete != w.entryHeight || behind <= int64(t.MaxEntryLag) || w.entryProgress.IsZero() {
+		w.entryHeight = complete
+		w.entryProgress = now
+	}
+	stall := now.Sub(w.entryProgress)
+	alerts.Set(node, alerts.EntrySyncStalled, stall > 3*time.Duration(s.DirectoryBlockInSeconds)*time.Second, height,
+		"entries complete to block %d, %d blocks behind, no progress for %d seconds", complete, behind, int64(stall/time.Second))
+
+	peers := s.GetNumberOfPeers()
+	alerts.Set(node, alerts.LowPeers, peers >= 0 && peers < t.MinPeers, height,
+		"%d connections, fewer than %d", peers, t.MinPeers)
+}
@@ -0,0 +1,58 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"github.com/FactomProject/factomd/activations"
+	"github.com/FactomProject/factomd/common/constants"
+	. "github.com/FactomProject/factomd/common/identityEntries"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// ProcessAuthorityChanges records the authority set changes scheduled for this block, that
+// have votes from a majority of the federated servers, in its admin block.  Like AddServer
+// and RemoveServer messages, the change takes effect in the next block.  Nothing is changed
+// before the AuthorityChangeVotes activation.
+func (st *State) ProcessAuthorityChanges(d *DBState) {
+	height := d.DirectoryBlock.GetDatabaseHeight()
+	if !activations.IsActive(activations.AUTHORITY_CHANGE_VOTES, int(height)) {
+		return
+	}
+	for _, c := range st.IdentityControl.ChangeManager.ApprovedChanges(height) {
+		ok := false
+		switch c.Change {
+		case AuthorityChangeAddFederated:
+			ok = addIdentityToAdminBlock(st, d.AdminBlock, c.TargetChainID, 0)
+		case AuthorityChangeAddAudit:
+			ok = addIdentityToAdminBlock(st, d.AdminBlock, c.TargetChainID, 1)
+		case AuthorityChangeRemove:
+			ok = st.removeAuthorityFromAdminBlock(d, c.TargetChainID)
+		}
+		st.LogPrintf("executeMsg", "Scheduled authority change %d for %x at %d, applied %v", c.Change, c.TargetChainID.Bytes()[3:6], height, ok)
+
+		// If it could not be applied it never will be, so it is only tried once
+		st.IdentityControl.ChangeManager.MarkAdminBlockRecorded(height, c.TargetChainID, c.Change)
+	}
+}
+
+// removeAuthorityFromAdminBlock removes a federated or audit server, with the same checks
+// as ProcessRemoveServer
+func (st *State) removeAuthorityFromAdminBlock(d *DBState, chainID interfaces.IHash) bool {
+	auth := st.IdentityControl.GetAuthority(chainID)
+	if auth == nil {
+		return false
+	}
+	if auth.Status == constants.IDENTITY_FEDERATED_SERVER && st.IdentityControl.FedServerCount() < 2 {
+		return false
+	}
+	return d.AdminBlock.RemoveFederatedServer(chainID) == nil
+}
+
+// GetAuthorityChangeProposals returns the scheduled authority set changes that have not
+// reached their height.  Returned as an interface for the same import issues as
+// GetAuthorityInterface.
+func (st *State) GetAuthorityChangeProposals() interface{} {
+	return st.IdentityControl.ChangeManager.PendingProposals()
+}
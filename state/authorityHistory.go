@@ -0,0 +1,314 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+var authorityHistoryKey = []byte("AuthorityHistory")
+
+// How many blocks are caught up between saves of the index
+const authorityHistorySaveEvery = 1000
+
+// AuthorityHistory is an index of the directory blocks whose admin blocks changed the
+// authorities.  Only the index is saved in the database, the changes themselves are
+// read back from the admin blocks.
+//
+// Blocks processed before the history was kept are caught up in the background at boot,
+// while the blocks processed since are recorded apart, and joined on once the catch up
+// reaches them.
+type AuthorityHistory struct {
+	Next    uint32   // The next directory block to check for changes
+	Heights []uint32 // Directory blocks with changes, in order
+
+	mutex    sync.Mutex
+	loaded   bool
+	caughtUp bool  // Next has reached the blocks processed since boot
+	err      error // Why the catch up failed
+	changes  []AuthorityChange
+
+	// Blocks processed while catching up
+	recording   bool
+	liveFrom    uint32
+	liveNext    uint32
+	liveHeights []uint32
+	live        []AuthorityChange
+}
+
+var _ interfaces.BinaryMarshallable = (*AuthorityHistory)(nil)
+
+func (h *AuthorityHistory) MarshalBinary() ([]byte, error) {
+	buf := primitives.NewBuffer(nil)
+
+	err := buf.PushUInt32(h.Next)
+	if err != nil {
+		return nil, err
+	}
+	err = buf.PushInt(len(h.Heights))
+	if err != nil {
+		return nil, err
+	}
+	for _, ht := range h.Heights {
+		err = buf.PushUInt32(ht)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.DeepCopyBytes(), nil
+}
+
+func (h *AuthorityHistory) UnmarshalBinaryData(p []byte) (newData []byte, err error) {
+	buf := primitives.NewBuffer(p)
+	newData = p
+
+	h.Next, err = buf.PopUInt32()
+	if err != nil {
+		return
+	}
+	l, err := buf.PopInt()
+	if err != nil {
+		return
+	}
+	h.Heights = make([]uint32, l)
+	for i := range h.Heights {
+		h.Heights[i], err = buf.PopUInt32()
+		if err != nil {
+			return
+		}
+	}
+
+	newData = buf.DeepCopyBytes()
+	return
+}
+
+func (h *AuthorityHistory) UnmarshalBinary(p []byte) error {
+	_, err := h.UnmarshalBinaryData(p)
+	return err
+}
+
+// load reads the index, and the changes it points to, the first time the history is used.
+// The mutex must be held.
+func (h *AuthorityHistory) load(db interfaces.DBOverlaySimple) error {
+	if h.loaded || db == nil {
+		return nil
+	}
+
+	saved := new(AuthorityHistory)
+	_, err := db.FetchKeyValueStore(authorityHistoryKey, saved)
+	if err != nil {
+		return err
+	}
+
+	var changes []AuthorityChange
+	for _, ht := range saved.Heights {
+		ablock, err := db.FetchABlockByHeight(ht)
+		if err != nil {
+			return err
+		}
+		if ablock == nil {
+			return fmt.Errorf("Admin block %d of the authority history is missing", ht)
+		}
+		changes = append(changes, authorityChanges(ablock)...)
+	}
+
+	h.Next = saved.Next
+	h.Heights = saved.Heights
+	h.changes = changes
+	h.loaded = true
+	return nil
+}
+
+// save writes the index.  The mutex must be held.
+func (h *AuthorityHistory) save(db interfaces.DBOverlaySimple) error {
+	if db == nil {
+		return nil
+	}
+	return db.SaveKeyValueStore(h, authorityHistoryKey)
+}
+
+// truncate forgets the changes from the directory block at dbheight on, so the block
+// can be processed again.  The mutex must be held.
+func (h *AuthorityHistory) truncate(dbheight uint32) {
+	for len(h.Heights) > 0 && h.Heights[len(h.Heights)-1] >= dbheight {
+		h.Heights = h.Heights[:len(h.Heights)-1]
+	}
+	for len(h.changes) > 0 && h.changes[len(h.changes)-1].DBHeight >= dbheight {
+		h.changes = h.changes[:len(h.changes)-1]
+	}
+	if h.Next > dbheight {
+		h.Next = dbheight
+	}
+}
+
+func authorityChanges(ablock interfaces.IAdminBlock) []AuthorityChange {
+	var changes []AuthorityChange
+	for _, e := range ablock.GetABEntries() {
+		if c := NewAuthorityChange(ablock.GetDBHeight(), e); c != nil {
+			changes = append(changes, *c)
+		}
+	}
+	return changes
+}
+
+// join adds the blocks processed since boot to the history, once the catch up has
+// reached them.  The mutex must be held.
+func (h *AuthorityHistory) join() bool {
+	if !h.loaded || !h.recording || h.Next < h.liveFrom {
+		return false
+	}
+	h.truncate(h.liveFrom)
+	h.Heights = append(h.Heights, h.liveHeights...)
+	h.changes = append(h.changes, h.live...)
+	h.Next = h.liveNext
+	h.recording = false
+	h.liveHeights, h.live = nil, nil
+	h.caughtUp = true
+	return true
+}
+
+// RecordAuthorityChanges adds the changes an admin block makes to the authorities to the
+// history, as the block is processed.
+func (s *State) RecordAuthorityChanges(ablock interfaces.IAdminBlock) {
+	h := &s.AuthorityHistory
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	dbheight := ablock.GetDBHeight()
+	changes := authorityChanges(ablock)
+
+	if !h.caughtUp {
+		// Keep it apart until the catch up gets here.  A block processed again replaces
+		// the blocks after it.
+		if !h.recording || dbheight < h.liveFrom {
+			h.recording = true
+			h.liveFrom = dbheight
+		}
+		for len(h.liveHeights) > 0 && h.liveHeights[len(h.liveHeights)-1] >= dbheight {
+			h.liveHeights = h.liveHeights[:len(h.liveHeights)-1]
+		}
+		for len(h.live) > 0 && h.live[len(h.live)-1].DBHeight >= dbheight {
+			h.live = h.live[:len(h.live)-1]
+		}
+		if len(changes) > 0 {
+			h.liveHeights = append(h.liveHeights, dbheight)
+			h.live = append(h.live, changes...)
+		}
+		h.liveNext = dbheight + 1
+		if !h.join() {
+			return
+		}
+	} else {
+		h.truncate(dbheight)
+		if len(changes) > 0 {
+			h.Heights = append(h.Heights, dbheight)
+			h.changes = append(h.changes, changes...)
+		}
+		h.Next = dbheight + 1
+	}
+
+	err := h.save(s.DB)
+	if err != nil {
+		s.LogPrintf("authorityhistory", "Failed to save the authority history: %v", err)
+	}
+}
+
+// CatchUpAuthorityHistory loads the history and adds the blocks processed before it was
+// kept, reading them from the database.  Run once at boot, it returns when the history
+// is caught up.
+func (s *State) CatchUpAuthorityHistory() {
+	h := &s.AuthorityHistory
+	if s.DB == nil {
+		return
+	}
+
+	h.mutex.Lock()
+	err := h.load(s.DB)
+	h.mutex.Unlock()
+	if err != nil {
+		s.failAuthorityHistory(err)
+		return
+	}
+
+	for scanned := 0; ; scanned++ {
+		h.mutex.Lock()
+		if h.caughtUp || h.join() {
+			err = h.save(s.DB)
+			h.mutex.Unlock()
+			if err != nil {
+				s.LogPrintf("authorityhistory", "Failed to save the authority history: %v", err)
+			}
+			return
+		}
+		next, recording := h.Next, h.recording
+		if !recording && next > s.GetHighestSavedBlk() && next > 0 {
+			// Every saved block is in the history, and none has been processed since boot
+			h.caughtUp = true
+			h.mutex.Unlock()
+			continue
+		}
+		h.mutex.Unlock()
+
+		ablock, err := s.DB.FetchABlockByHeight(next)
+		if err != nil {
+			s.failAuthorityHistory(err)
+			return
+		}
+		if ablock == nil {
+			time.Sleep(time.Second) // Not saved yet
+			continue
+		}
+
+		h.mutex.Lock()
+		if h.Next == next && !h.caughtUp && (!h.recording || next < h.liveFrom) {
+			if c := authorityChanges(ablock); len(c) > 0 {
+				h.Heights = append(h.Heights, next)
+				h.changes = append(h.changes, c...)
+			}
+			h.Next = next + 1
+			if scanned%authorityHistorySaveEvery == 0 {
+				err = h.save(s.DB)
+				if err != nil {
+					s.LogPrintf("authorityhistory", "Failed to save the authority history: %v", err)
+				}
+			}
+		}
+		h.mutex.Unlock()
+	}
+}
+
+func (s *State) failAuthorityHistory(err error) {
+	s.LogPrintf("authorityhistory", "Failed to catch up the authority history: %v", err)
+	h := &s.AuthorityHistory
+	h.mutex.Lock()
+	h.err = err
+	h.mutex.Unlock()
+}
+
+// GetAuthorityHistory returns every change made to the authorities up to the highest
+// processed block, in height order.  Returned as an interface for the same import issues
+// as GetAuthorityInterface.
+func (s *State) GetAuthorityHistory() (interface{}, error) {
+	h := &s.AuthorityHistory
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.err != nil {
+		return nil, h.err
+	}
+	if !h.caughtUp {
+		return nil, fmt.Errorf("The authority history is catching up, at block %d", h.Next)
+	}
+
+	list := make([]AuthorityChange, len(h.changes))
+	copy(list, h.changes)
+	return list, nil
+}
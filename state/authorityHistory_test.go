@@ -0,0 +1,133 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestAuthorityHistoryMarshal(t *testing.T) {
+	h := new(AuthorityHistory)
+	h.Next = 1000
+	h.Heights = []uint32{0, 10, 999}
+
+	data, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2 := new(AuthorityHistory)
+	err = h2.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h2.Next != h.Next || len(h2.Heights) != 3 || h2.Heights[2] != 999 {
+		t.Errorf("History does not match after unmarshal, %d %v", h2.Next, h2.Heights)
+	}
+}
+
+func TestAuthorityHistoryCatchUpAndRecord(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	top := s.GetHighestSavedBlk()
+
+	// As if the history was not kept as the blocks were made, so it is caught up from the database
+	err := s.DB.SaveKeyValueStore(new(AuthorityHistory), []byte("AuthorityHistory"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.AuthorityHistory = AuthorityHistory{}
+	if _, err := s.GetAuthorityHistory(); err == nil {
+		t.Error("Expected an error before the history is caught up")
+	}
+	s.CatchUpAuthorityHistory()
+	history, err := s.GetAuthorityHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := history.([]identity.AuthorityChange)
+	if len(changes) == 0 {
+		t.Fatal("The genesis block should have added a federated server")
+	}
+	if s.AuthorityHistory.Next != top+1 {
+		t.Errorf("History should be caught up to %d, is at %d", top+1, s.AuthorityHistory.Next)
+	}
+
+	saved := new(AuthorityHistory)
+	_, err = s.DB.FetchKeyValueStore([]byte("AuthorityHistory"), saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Next != top+1 {
+		t.Errorf("Saved history should be at %d, is at %d", top+1, saved.Next)
+	}
+
+	// A processed block is added to the history
+	ab := adminBlock.NewAdminBlock(nil)
+	ab.GetHeader().SetDBHeight(top + 1)
+	id := primitives.RandomHash()
+	ab.AddAuditServer(id)
+	s.RecordAuthorityChanges(ab)
+	if s.AuthorityHistory.Next != top+2 {
+		t.Errorf("History should be at %d, is at %d", top+2, s.AuthorityHistory.Next)
+	}
+	history, _ = s.GetAuthorityHistory()
+	if l := len(history.([]identity.AuthorityChange)); l != len(changes)+1 {
+		t.Errorf("Expected %d changes, found %d", len(changes)+1, l)
+	}
+
+	// Processing the block again replaces its changes
+	s.RecordAuthorityChanges(adminBlock.NewAdminBlock(ab))
+	ab2 := adminBlock.NewAdminBlock(nil)
+	ab2.GetHeader().SetDBHeight(top + 1)
+	s.RecordAuthorityChanges(ab2)
+	history, _ = s.GetAuthorityHistory()
+	if l := len(history.([]identity.AuthorityChange)); l != len(changes) {
+		t.Errorf("Expected %d changes, found %d", len(changes), l)
+	}
+}
+
+func TestAuthorityHistoryRecordWhileCatchingUp(t *testing.T) {
+	s := testHelper.CreateAndPopulateTestState()
+	top := s.GetHighestSavedBlk()
+	history, err := s.GetAuthorityHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := history.([]identity.AuthorityChange)
+
+	err = s.DB.SaveKeyValueStore(new(AuthorityHistory), []byte("AuthorityHistory"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.AuthorityHistory = AuthorityHistory{}
+
+	// Blocks processed before the catch up reaches them are kept, and joined on after
+	ab := adminBlock.NewAdminBlock(nil)
+	ab.GetHeader().SetDBHeight(top + 1)
+	ab.AddAuditServer(primitives.RandomHash())
+	s.RecordAuthorityChanges(ab)
+	ab2 := adminBlock.NewAdminBlock(nil)
+	ab2.GetHeader().SetDBHeight(top + 2)
+	ab2.AddAuditServer(primitives.RandomHash())
+	s.RecordAuthorityChanges(ab2)
+
+	s.CatchUpAuthorityHistory()
+	history, err = s.GetAuthorityHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := history.([]identity.AuthorityChange)
+	if len(list) != len(changes)+2 || list[len(list)-1].DBHeight != top+2 {
+		t.Errorf("Expected %d changes ending at %d, found %d", len(changes)+2, top+2, len(list))
+	}
+	if s.AuthorityHistory.Next != top+3 {
+		t.Errorf("History should be at %d, is at %d", top+3, s.AuthorityHistory.Next)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"sort"
+)
+
+// ServerPerformance is how an authority server performed over the last blocks of the
+// process list history.  Times are in milliseconds.  A lag is how long after the first
+// server's EOM or DBSig the server's own made it into our process list.
+type ServerPerformance struct {
+	ServerID     string              `json:"serverid"`
+	Federated    bool                `json:"federated"`  // a federated server in the last block
+	FedBlocks    int                 `json:"fedblocks"`  // blocks it was a federated server in
+	Acks         int                 `json:"acks"`       // messages it acked as a leader
+	AckLatency   float64             `json:"acklatency"` // average from its ack's timestamp to us adding the message
+	EOMLag       float64             `json:"eomlag"`
+	MinuteEOMLag [10]float64         `json:"minuteeomlag"`
+	DBSigLag     float64             `json:"dbsiglag"`
+	MissedEOMs   int                 `json:"missedeoms"`
+	MissedBlocks int                 `json:"missedblocks"` // blocks it sent no DBSig in as a federated server
+	Faults       int                 `json:"faults"`
+	Elections    int                 `json:"elections"` // elections that demoted or promoted it
+	History      []*BlockPerformance `json:"history"`   // each block, oldest first
+
+	ackLatency, dbsigLag average
+	minuteEOMLag         [10]average
+}
+
+// BlockPerformance is how an authority server performed in one block
+type BlockPerformance struct {
+	DBHeight    uint32  `json:"dbheight"`
+	Federated   bool    `json:"federated"`
+	Acks        int     `json:"acks"`
+	AckLatency  float64 `json:"acklatency"`
+	EOMLag      float64 `json:"eomlag"`
+	DBSigLag    float64 `json:"dbsiglag"`
+	MissedEOMs  int     `json:"missedeoms"`
+	MissedDBSig bool    `json:"misseddbsig"`
+	Faults      int     `json:"faults"`
+	Elections   int     `json:"elections"`
+}
+
+type average struct {
+	total float64
+	count int
+}
+
+func (a *average) add(v int64) {
+	a.total += float64(v)
+	a.count++
+}
+
+func (a *average) value() float64 {
+	if a.count == 0 {
+		return 0
+	}
+	return a.total / float64(a.count)
+}
+
+// AuthorityPerformance computes the performance of every server that was an authority in
+// the last few completed blocks of the records, or all of them if blocks is 0.  Federated
+// servers in the last block come first.  Blocks our process list saw no EOMs or DBSigs
+// in, such as those we loaded as DBStates while syncing, are left out.
+//
+// A federated server misses an EOM or a DBSig when others made it into our process list
+// but its own did not, so a server promoted part way through a block is counted as
+// missing the EOMs before it was promoted.
+func AuthorityPerformance(records []*ProcessListRecord, blocks int) []*ServerPerformance {
+	var complete []*ProcessListRecord
+	for _, r := range records {
+		if r.Complete && (len(r.EOMs) > 0 || len(r.DBSigs) > 0) {
+			complete = append(complete, r)
+		}
+	}
+	if blocks > 0 && len(complete) > blocks {
+		complete = complete[len(complete)-blocks:]
+	}
+
+	servers := make(map[string]*ServerPerformance)
+	server := func(id string) *ServerPerformance {
+		sp, ok := servers[id]
+		if !ok {
+			sp = &ServerPerformance{ServerID: id}
+			servers[id] = sp
+		}
+		return sp
+	}
+
+	for _, r := range complete {
+		for _, id := range r.FedServers {
+			server(id)
+		}
+		for _, id := range r.AuditServers {
+			server(id)
+		}
+	}
+
+	for _, r := range complete {
+		federated := make(map[string]bool)
+		for _, id := range r.FedServers {
+			federated[id] = true
+		}
+
+		block := make(map[string]*BlockPerformance)
+		ackLatency, eomLag, dbsigLag := make(map[string]*average), make(map[string]*average), make(map[string]*average)
+		for id := range servers {
+			block[id] = &BlockPerformance{DBHeight: r.DBHeight, Federated: federated[id]}
+			ackLatency[id], eomLag[id], dbsigLag[id] = new(average), new(average), new(average)
+		}
+
+		// The leader of a VM changes each minute
+		serverMap := MakeMap(len(r.FedServers), r.DBHeight)
+		for _, vm := range r.VMs {
+			for _, m := range vm.Messages {
+				if m.Minute < 0 || m.Minute >= 10 || vm.VMIndex < 0 || vm.VMIndex >= len(r.FedServers) || m.AckTimestamp == 0 {
+					continue
+				}
+				id := r.FedServers[serverMap[m.Minute][vm.VMIndex]]
+				ackLatency[id].add(m.Added - m.AckTimestamp)
+				servers[id].ackLatency.add(m.Added - m.AckTimestamp)
+				block[id].Acks++
+			}
+		}
+
+		var eoms [10][]*ServerArrival
+		for _, a := range r.EOMs {
+			if a.Minute >= 0 && a.Minute < 10 {
+				eoms[a.Minute] = append(eoms[a.Minute], a)
+			}
+		}
+		for minute, arrivals := range eoms {
+			if len(arrivals) == 0 {
+				continue
+			}
+			sent := make(map[string]bool)
+			first := firstArrival(arrivals)
+			for _, a := range arrivals {
+				sp, ok := servers[a.ServerID]
+				if !ok || sent[a.ServerID] {
+					continue
+				}
+				sent[a.ServerID] = true
+				eomLag[a.ServerID].add(a.Added - first)
+				sp.minuteEOMLag[minute].add(a.Added - first)
+			}
+			for _, id := range r.FedServers {
+				if !sent[id] {
+					block[id].MissedEOMs++
+				}
+			}
+		}
+
+		if len(r.DBSigs) > 0 {
+			sent := make(map[string]bool)
+			first := firstArrival(r.DBSigs)
+			for _, a := range r.DBSigs {
+				sp, ok := servers[a.ServerID]
+				if !ok || sent[a.ServerID] {
+					continue
+				}
+				sent[a.ServerID] = true
+				dbsigLag[a.ServerID].add(a.Added - first)
+				sp.dbsigLag.add(a.Added - first)
+			}
+			for _, id := range r.FedServers {
+				block[id].MissedDBSig = !sent[id]
+			}
+		}
+
+		for _, f := range r.Faults {
+			if bp, ok := block[f.ServerID]; ok {
+				bp.Faults++
+			}
+		}
+		for _, e := range r.Elections {
+			if bp, ok := block[e.Demoted]; ok {
+				bp.Elections++
+			}
+			if bp, ok := block[e.Promoted]; ok && e.Promoted != e.Demoted {
+				bp.Elections++
+			}
+		}
+
+		for id, sp := range servers {
+			bp := block[id]
+			bp.AckLatency = ackLatency[id].value()
+			bp.EOMLag = eomLag[id].value()
+			bp.DBSigLag = dbsigLag[id].value()
+			sp.History = append(sp.History, bp)
+			if bp.Federated {
+				sp.FedBlocks++
+			}
+			sp.Acks += bp.Acks
+			sp.MissedEOMs += bp.MissedEOMs
+			if bp.MissedDBSig {
+				sp.MissedBlocks++
+			}
+			sp.Faults += bp.Faults
+			sp.Elections += bp.Elections
+		}
+	}
+
+	var last map[string]bool
+	if len(complete) > 0 {
+		last = make(map[string]bool)
+		for _, id := range complete[len(complete)-1].FedServers {
+			last[id] = true
+		}
+	}
+
+	var list []*ServerPerformance
+	for id, sp := range servers {
+		sp.Federated = last[id]
+		sp.AckLatency = sp.ackLatency.value()
+		sp.DBSigLag = sp.dbsigLag.value()
+		var all average
+		for minute, lag := range sp.minuteEOMLag {
+			sp.MinuteEOMLag[minute] = lag.value()
+			all.total += lag.total
+			all.count += lag.count
+		}
+		sp.EOMLag = all.value()
+		list = append(list, sp)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Federated != list[j].Federated {
+			return list[i].Federated
+		}
+		return list[i].ServerID < list[j].ServerID
+	})
+	return list
+}
+
+// firstArrival returns when the first of the arrivals was added to our process list
+func firstArrival(arrivals []*ServerArrival) int64 {
+	first := arrivals[0].Added
+	for _, a := range arrivals {
+		if a.Added < first {
+			first = a.Added
+		}
+	}
+	return first
+}
+
+// GetAuthorityPerformance computes the performance of the authority servers over the
+// last few completed blocks in the process list history
+func (s *State) GetAuthorityPerformance(blocks int) []*ServerPerformance {
+	return AuthorityPerformance(s.ProcessListHistory.Get(0, 0), blocks)
+}
@@ -0,0 +1,74 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestAuthorityPerformance(t *testing.T) {
+	records := []*ProcessListRecord{
+		{
+			DBHeight:     10,
+			Complete:     true,
+			FedServers:   []string{"A", "B"},
+			AuditServers: []string{"C"},
+			VMs: []*VMRecord{{VMIndex: 0, Messages: []*PLMessageRecord{
+				{Minute: 0, AckTimestamp: 1000, Added: 1100},
+			}}},
+			EOMs: []*ServerArrival{
+				{ServerID: "B", Minute: 0, Added: 2300},
+				{ServerID: "A", Minute: 0, Added: 2000},
+			},
+			DBSigs:    []*ServerArrival{{ServerID: "A", Added: 500}},
+			Faults:    []*FaultRecord{{ServerID: "B"}},
+			Elections: []*ElectionRecord{{Demoted: "B", Promoted: "C"}},
+		},
+		{
+			DBHeight:     11,
+			Complete:     true,
+			FedServers:   []string{"A", "C"},
+			AuditServers: []string{"B"},
+			EOMs: []*ServerArrival{
+				{ServerID: "A", Minute: 0, Added: 100},
+				{ServerID: "C", Minute: 0, Added: 150},
+			},
+			DBSigs: []*ServerArrival{{ServerID: "A", Added: 10}, {ServerID: "C", Added: 10}},
+		},
+		{DBHeight: 12, FedServers: []string{"A", "C"}}, // still under construction
+	}
+
+	list := AuthorityPerformance(records, 0)
+	if len(list) != 3 || list[0].ServerID != "A" || list[1].ServerID != "C" || list[2].ServerID != "B" {
+		t.Fatalf("Expected the federated servers first, got %v", list)
+	}
+	a, c, b := list[0], list[1], list[2]
+	if b.Federated || b.FedBlocks != 1 || b.MissedBlocks != 1 || b.Faults != 1 || b.Elections != 1 {
+		t.Errorf("Wrong performance for B %+v", b)
+	}
+	if b.EOMLag != 300 || b.MinuteEOMLag[0] != 300 || a.EOMLag != 0 || c.EOMLag != 50 {
+		t.Errorf("Wrong EOM lags A %v B %v C %v", a.EOMLag, b.EOMLag, c.EOMLag)
+	}
+	if c.Elections != 1 || c.MissedEOMs != 0 || c.MissedBlocks != 0 || a.MissedBlocks != 0 {
+		t.Errorf("Wrong performance for C %+v", c)
+	}
+	if len(a.History) != 2 || a.History[0].DBHeight != 10 || !b.History[0].MissedDBSig || b.History[1].Federated {
+		t.Errorf("Wrong history %+v", b.History)
+	}
+
+	leader := records[0].FedServers[MakeMap(2, 10)[0][0]]
+	for _, sp := range list {
+		if sp.ServerID == leader && (sp.Acks != 1 || sp.AckLatency != 100) {
+			t.Errorf("Expected the leader of VM 0 to ack the message, got %+v", sp)
+		}
+	}
+
+	list = AuthorityPerformance(records, 1)
+	if len(list) != 3 || len(list[2].History) != 1 || list[2].History[0].DBHeight != 11 {
+		t.Errorf("Expected only the last block, got %+v", list[2])
+	}
+}
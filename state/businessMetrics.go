@@ -0,0 +1,174 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// BlockBusiness is what the users of the chain did in one block, for the business
+// metrics.  Amounts of factoids are in factoshis.
+type BlockBusiness struct {
+	DBHeight        uint32
+	NewChains       int
+	Entries         int
+	EntryBytes      int    // of the entries we had when the block was saved
+	ECSpent         uint64 // on chain and entry commits
+	ECPurchased     uint64
+	FCTTransferred  uint64 // by transactions, not counting the coinbase
+	Fees            uint64
+	ActiveAddresses int // distinct factoid and entry credit addresses in transactions
+	CoinbasePaid    uint64
+	GrantsPaid      uint64
+	ChainEntries    map[[32]byte]int // entries by chain
+}
+
+// ChainEntries is the number of entries a chain got in a block
+type ChainEntries struct {
+	ChainID string
+	Entries int
+}
+
+// NewBlockBusiness counts what happened in a block.  The entry blocks and entries are
+// those of the block we have, the first transaction of the factoid block is the coinbase.
+func NewBlockBusiness(dbheight uint32, fblock interfaces.IFBlock, ecblock interfaces.IEntryCreditBlock, eblocks []interfaces.IEntryBlock, entries map[[32]byte]interfaces.IEBEntry) *BlockBusiness {
+	b := new(BlockBusiness)
+	b.DBHeight = dbheight
+	b.ChainEntries = make(map[[32]byte]int)
+
+	for _, eb := range eblocks {
+		if eb.GetHeader().GetEBSequence() == 0 {
+			b.NewChains++
+		}
+		chain := eb.GetHeader().GetChainID().Fixed()
+		for _, h := range eb.GetEntryHashes() {
+			if h.IsMinuteMarker() {
+				continue
+			}
+			b.Entries++
+			b.ChainEntries[chain]++
+			if e, ok := entries[h.Fixed()]; ok {
+				if data, err := e.MarshalBinary(); err == nil {
+					b.EntryBytes += len(data)
+				}
+			}
+		}
+	}
+
+	if ecblock != nil {
+		for _, en := range ecblock.GetEntries() {
+			switch en.ECID() {
+			case constants.ECIDChainCommit:
+				if c, ok := en.(*entryCreditBlock.CommitChain); ok {
+					b.ECSpent += uint64(c.Credits)
+				}
+			case constants.ECIDEntryCommit:
+				if c, ok := en.(*entryCreditBlock.CommitEntry); ok {
+					b.ECSpent += uint64(c.Credits)
+				}
+			case constants.ECIDBalanceIncrease:
+				if ib, ok := en.(*entryCreditBlock.IncreaseBalance); ok {
+					b.ECPurchased += ib.NumEC
+				}
+			}
+		}
+	}
+
+	if fblock != nil {
+		addresses := make(map[[32]byte]bool)
+		for i, tx := range fblock.GetTransactions() {
+			for _, list := range [][]interfaces.ITransAddress{tx.GetInputs(), tx.GetOutputs(), tx.GetECOutputs()} {
+				for _, a := range list {
+					addresses[a.GetAddress().Fixed()] = true
+				}
+			}
+			if i == 0 {
+				outputs, _ := tx.TotalOutputs()
+				if IsGrantPayout(dbheight) {
+					b.GrantsPaid += outputs
+				} else {
+					b.CoinbasePaid += outputs
+				}
+				continue
+			}
+			inputs, _ := tx.TotalInputs()
+			outputs, _ := tx.TotalOutputs()
+			ecs, _ := tx.TotalECs()
+			b.FCTTransferred += inputs
+			if inputs > outputs+ecs {
+				b.Fees += inputs - outputs - ecs
+			}
+		}
+		b.ActiveAddresses = len(addresses)
+	}
+	return b
+}
+
+// IsGrantPayout is true if the coinbase of the block pays grants rather than the authority
+// servers.  Authority payouts are declared a multiple of the payout frequency, and grants one
+// block after, so either is paid a declaration period later.
+func IsGrantPayout(dbheight uint32) bool {
+	if dbheight < constants.COINBASE_DECLARATION {
+		return false
+	}
+	return (dbheight-constants.COINBASE_DECLARATION)%constants.COINBASE_PAYOUT_FREQUENCY == 1
+}
+
+// TopChains returns the n chains with the most entries in the block, most first
+func (b *BlockBusiness) TopChains(n int) []ChainEntries {
+	var list []ChainEntries
+	for chain, count := range b.ChainEntries {
+		list = append(list, ChainEntries{ChainID: fmt.Sprintf("%x", chain), Entries: count})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Entries != list[j].Entries {
+			return list[i].Entries > list[j].Entries
+		}
+		return list[i].ChainID < list[j].ChainID
+	})
+	if len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
+
+// Export adds the block to the business metrics, with the entries of the top chains
+// labeled by chain if topChains is more than 0
+func (b *BlockBusiness) Export(topChains int) {
+	BusinessBlocks.Inc()
+	BusinessDBHeight.Set(float64(b.DBHeight))
+	BusinessNewChains.Add(float64(b.NewChains))
+	BusinessEntries.Add(float64(b.Entries))
+	BusinessEntryBytes.Add(float64(b.EntryBytes))
+	BusinessECSpent.Add(float64(b.ECSpent))
+	BusinessECPurchased.Add(float64(b.ECPurchased))
+	BusinessFCTTransferred.Add(float64(b.FCTTransferred))
+	BusinessFees.Add(float64(b.Fees))
+	BusinessActiveAddresses.Set(float64(b.ActiveAddresses))
+	BusinessCoinbasePaid.Add(float64(b.CoinbasePaid))
+	BusinessGrantsPaid.Add(float64(b.GrantsPaid))
+
+	if topChains > 0 {
+		BusinessTopChainEntries.Reset()
+		for _, c := range b.TopChains(topChains) {
+			BusinessTopChainEntries.WithLabelValues(c.ChainID).Set(float64(c.Entries))
+		}
+	}
+}
+
+// exportBlockBusiness adds a block being saved to the business metrics
+func (list *DBStateList) exportBlockBusiness(d *DBState, eblocks map[[32]byte]interfaces.IEntryBlock, entries map[[32]byte]interfaces.IEBEntry) {
+	var ebs []interfaces.IEntryBlock
+	for _, eb := range eblocks {
+		ebs = append(ebs, eb)
+	}
+	dbheight := d.DirectoryBlock.GetHeader().GetDBHeight()
+	NewBlockBusiness(dbheight, d.FactoidBlock, d.EntryCreditBlock, ebs, entries).Export(list.State.MetricsTopChains)
+}
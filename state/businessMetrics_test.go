@@ -0,0 +1,95 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/entryCreditBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func businessEBlock(chain interfaces.IHash, sequence uint32, entries map[[32]byte]interfaces.IEBEntry, count int) interfaces.IEntryBlock {
+	eb := entryBlock.NewEBlock()
+	eb.GetHeader().SetChainID(chain)
+	eb.GetHeader().SetEBSequence(sequence)
+	for i := 0; i < count; i++ {
+		e := entryBlock.NewEntry()
+		e.ChainID = chain
+		e.Content = primitives.ByteSlice{Bytes: []byte(fmt.Sprintf("entry %d", i))}
+		eb.AddEBEntry(e)
+		entries[e.GetHash().Fixed()] = e
+	}
+	eb.AddEndOfMinuteMarker(1)
+	return eb
+}
+
+func businessAddress() interfaces.IAddress {
+	return factoid.NewAddress(primitives.RandomHash().Bytes())
+}
+
+func TestBlockBusiness(t *testing.T) {
+	entries := make(map[[32]byte]interfaces.IEBEntry)
+	newChain, oldChain := primitives.RandomHash(), primitives.RandomHash()
+	eblocks := []interfaces.IEntryBlock{
+		businessEBlock(newChain, 0, entries, 1),
+		businessEBlock(oldChain, 3, entries, 2),
+	}
+	bytes := 0
+	for _, e := range entries {
+		data, _ := e.MarshalBinary()
+		bytes += len(data)
+	}
+
+	ecblock := entryCreditBlock.NewECBlock().(*entryCreditBlock.ECBlock)
+	commitChain := entryCreditBlock.NewCommitChain()
+	commitChain.Credits = 11
+	commitEntry := entryCreditBlock.NewCommitEntry()
+	commitEntry.Credits = 1
+	purchase := entryCreditBlock.NewIncreaseBalance()
+	purchase.NumEC = 100
+	ecblock.AddEntry(commitChain, commitEntry, purchase, entryCreditBlock.NewMinuteNumber(1))
+
+	coinbase := new(factoid.Transaction)
+	coinbase.AddOutput(businessAddress(), 500)
+	spender := businessAddress()
+	tx := new(factoid.Transaction)
+	tx.AddInput(spender, 1000)
+	tx.AddOutput(businessAddress(), 600)
+	tx.AddECOutput(businessAddress(), 300)
+	tx2 := new(factoid.Transaction)
+	tx2.AddInput(spender, 50)
+	tx2.AddOutput(businessAddress(), 50)
+	fblock := &factoid.FBlock{Transactions: []interfaces.ITransaction{coinbase, tx, tx2}}
+
+	height := constants.COINBASE_DECLARATION + 2*constants.COINBASE_PAYOUT_FREQUENCY
+	b := NewBlockBusiness(height, fblock, ecblock, eblocks, entries)
+	if b.NewChains != 1 || b.Entries != 3 || b.EntryBytes != bytes {
+		t.Errorf("Wrong entries %+v", b)
+	}
+	if b.ECSpent != 12 || b.ECPurchased != 100 {
+		t.Errorf("Wrong entry credits %+v", b)
+	}
+	if b.FCTTransferred != 1050 || b.Fees != 100 || b.ActiveAddresses != 5 {
+		t.Errorf("Wrong transactions %+v", b)
+	}
+	if b.CoinbasePaid != 500 || b.GrantsPaid != 0 {
+		t.Errorf("Wrong coinbase %+v", b)
+	}
+	if b = NewBlockBusiness(height+1, fblock, nil, nil, nil); b.GrantsPaid != 500 || b.CoinbasePaid != 0 {
+		t.Errorf("Expected grants to be paid a block after the authority servers %+v", b)
+	}
+
+	top := NewBlockBusiness(height, nil, nil, eblocks, entries).TopChains(1)
+	if len(top) != 1 || top[0].ChainID != oldChain.String() || top[0].Entries != 2 {
+		t.Errorf("Wrong top chains %v", top)
+	}
+}
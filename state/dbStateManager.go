@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/FactomProject/factomd/common/adminBlock"
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/globals"
 	"github.com/FactomProject/factomd/util/atomic"
 
@@ -813,6 +814,18 @@ func (list *DBStateList) FixupLinks(p *DBState, d *DBState) (progress bool) {
 
 		return false
 	}
+
+	// every 25 blocks +1 we add grant payouts.  Get them before the admin block is changed, so
+	// if the governance entries they are read from are not synced yet we can wait for them.
+	var grantPayouts []interfaces.ITransAddress
+	if currentDBHeight > constants.COINBASE_ACTIVATION && currentDBHeight%constants.COINBASE_PAYOUT_FREQUENCY == 1 {
+		grantPayouts, err = list.State.GetGrantPayouts(currentDBHeight)
+		if err != nil {
+			list.State.LogPrintf("dbstateprocess", "FixupLinks(%d) waiting on grant payouts: %v", currentDBHeight, err)
+			return false
+		}
+	}
+
 	//list.State.AddStatus(fmt.Sprintf("FIXUPLINKS: Adding the first %d dbsigs",
 	//	majority))
 
@@ -848,6 +861,9 @@ func (list *DBStateList) FixupLinks(p *DBState, d *DBState) (progress bool) {
 	// Additional Admin block changed can be made from identity changes
 	list.State.SyncIdentities(d)
 
+	// Scheduled authority set changes that were voted in
+	list.State.ProcessAuthorityChanges(d)
+
 	// every 25 blocks +0 we add grant payouts
 	// If this is a coinbase descriptor block, add that now
 	if currentDBHeight > constants.COINBASE_ACTIVATION && currentDBHeight%constants.COINBASE_PAYOUT_FREQUENCY == 0 {
@@ -874,15 +890,11 @@ func (list *DBStateList) FixupLinks(p *DBState, d *DBState) (progress bool) {
 		}
 	}
 
-	// every 25 blocks +1 we add grant payouts
-	if currentDBHeight > constants.COINBASE_ACTIVATION && currentDBHeight%constants.COINBASE_PAYOUT_FREQUENCY == 1 {
-		// Add the grants to the list
-		grantPayouts := GetGrantPayoutsFor(currentDBHeight)
-		if len(grantPayouts) > 0 {
-			err := d.AdminBlock.AddCoinbaseDescriptor(grantPayouts)
-			if err != nil {
-				panic(err)
-			}
+	// Add the grant payouts found above to the list
+	if len(grantPayouts) > 0 {
+		err = d.AdminBlock.AddCoinbaseDescriptor(grantPayouts)
+		if err != nil {
+			panic(err)
 		}
 	}
 
@@ -1030,6 +1042,8 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 	if err != nil {
 		panic(err)
 	}
+	list.State.RecordAuthorityChanges(d.AdminBlock)
+
 	err = d.EntryCreditBlock.UpdateState(list.State)
 	if err != nil {
 		panic(err)
@@ -1155,6 +1169,9 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 	// Canceling Coinbase Descriptors
 	list.State.IdentityControl.CancelManager.GC(d.DirectoryBlock.GetDatabaseHeight()) // garbage collect
 
+	// Scheduled authority set changes
+	list.State.IdentityControl.ChangeManager.GC(d.DirectoryBlock.GetDatabaseHeight()) // garbage collect
+
 	///////////////////////////////
 	// Cleanup Tasks
 	///////////////////////////////
@@ -1212,6 +1229,7 @@ func (list *DBStateList) ProcessBlocks(d *DBState) (progress bool) {
 		err := list.State.StateSaverStruct.SaveDBStateList(list.State, list.State.DBStates, list.State.Network)
 		if err != nil {
 			list.State.LogPrintf("dbstateprocess", "Error while saving Fastboot %v", err)
+			alerts.Raise(list.State.FactomNodeName, alerts.SaveFailed, dbht, "Saving the fastboot state failed: %v", err)
 		}
 	}
 
@@ -1511,6 +1529,33 @@ func (list *DBStateList) SaveDBStateToDB(d *DBState) (progress bool) {
 		panic(err.Error())
 	}
 
+	// The entry blocks and entries we have of the block, for the business metrics
+	savedEBlocks := make(map[[32]byte]interfaces.IEntryBlock)
+	savedEntries := make(map[[32]byte]interfaces.IEBEntry)
+	for _, eb := range d.EntryBlocks {
+		if keymr, err := eb.KeyMR(); err == nil {
+			if _, ok := allowedEBlocks[keymr.Fixed()]; ok {
+				savedEBlocks[keymr.Fixed()] = eb
+			}
+		}
+	}
+	for _, e := range d.Entries {
+		savedEntries[e.GetHash().Fixed()] = e
+	}
+	if pl != nil {
+		for _, eb := range pl.NewEBlocks {
+			if keymr, err := eb.KeyMR(); err == nil {
+				if _, ok := allowedEBlocks[keymr.Fixed()]; ok {
+					savedEBlocks[keymr.Fixed()] = eb
+				}
+			}
+		}
+		for _, e := range pl.NewEntries {
+			savedEntries[e.GetHash().Fixed()] = e
+		}
+	}
+	list.exportBlockBusiness(d, savedEBlocks, savedEntries)
+
 	// Info from ProcessList
 	if pl != nil {
 		for _, eb := range pl.NewEBlocks {
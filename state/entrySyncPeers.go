@@ -0,0 +1,220 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+const (
+	maxInFlightPerPeer = 500  // Most entry requests we will have outstanding with any one peer
+	exploreOneIn       = 10   // One request in this many goes to a random peer so we keep learning about the network
+	latencyWeight      = 0.2  // Weight of a new sample in a peer's average latency
+	defaultLatency     = 1000 // Latency in milliseconds assumed for a peer we have not heard from yet
+)
+
+// EntrySyncPeer tracks how a peer has answered our entry requests
+type EntrySyncPeer struct {
+	Origin        int    // Index of the peer (or p2p proxy) in the node's peer list
+	NetworkOrigin string // p2p peer hash, empty in simulations
+	Requests      int
+	Responses     int
+	Failures      int
+	InFlight      int
+	Latency       float64 // Average response time in milliseconds
+	LastResponse  time.Time
+}
+
+func (p *EntrySyncPeer) key() string {
+	return peerKey(p.Origin, p.NetworkOrigin)
+}
+
+func peerKey(origin int, networkOrigin string) string {
+	return fmt.Sprintf("%d/%s", origin, networkOrigin)
+}
+
+// score ranks peers by how likely and how fast they answer.  The success rate
+// is smoothed so a new peer is neither trusted nor shunned.
+func (p *EntrySyncPeer) score() float64 {
+	success := float64(p.Responses+1) / float64(p.Requests+2)
+	latency := p.Latency
+	if latency <= 0 {
+		latency = defaultLatency
+	}
+	return success / latency
+}
+
+type entryRequest struct {
+	sent  time.Time
+	peer  string          // key of the peer asked, empty if it went to a random peer
+	tried map[string]bool // peers already asked for this entry
+}
+
+// EntrySyncScheduler decides which peer to ask for each missing entry.  Requests are
+// spread across the peers that answer fastest and most reliably, and an entry that
+// is not answered in time is asked of a different peer.
+type EntrySyncScheduler struct {
+	mutex    sync.Mutex
+	peers    map[string]*EntrySyncPeer
+	requests map[[32]byte]*entryRequest
+	second   time.Duration // a Factom second, the unit our timeouts scale with
+}
+
+func NewEntrySyncScheduler(second time.Duration) *EntrySyncScheduler {
+	es := new(EntrySyncScheduler)
+	es.peers = make(map[string]*EntrySyncPeer)
+	es.requests = make(map[[32]byte]*entryRequest)
+	es.second = second
+	return es
+}
+
+// timeout is how long we wait for a peer before asking someone else
+func (es *EntrySyncScheduler) timeout(peer *EntrySyncPeer) time.Duration {
+	t := es.second
+	if peer != nil && peer.Latency > 0 {
+		t = 4 * time.Duration(peer.Latency) * time.Millisecond
+	}
+	if t < es.second {
+		t = es.second
+	}
+	if t > 10*es.second {
+		t = 10 * es.second
+	}
+	return t
+}
+
+// choose returns the best peer not in exclude, or nil to ask a random peer
+func (es *EntrySyncScheduler) choose(exclude map[string]bool) *EntrySyncPeer {
+	if len(es.peers) == 0 || rand.Intn(exploreOneIn) == 0 {
+		return nil
+	}
+	var best *EntrySyncPeer
+	for k, p := range es.peers {
+		if exclude[k] || p.InFlight >= maxInFlightPerPeer {
+			continue
+		}
+		if best == nil || p.score() > best.score() {
+			best = p
+		}
+	}
+	return best
+}
+
+// Request asks the network for an entry unless a request for it is still pending.
+// Returns true if a request was sent.
+func (es *EntrySyncScheduler) Request(s *State, entryHash interfaces.IHash) bool {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	now := time.Now()
+	hash := entryHash.Fixed()
+	req, ok := es.requests[hash]
+	if ok {
+		peer := es.peers[req.peer]
+		if now.Sub(req.sent) < es.timeout(peer) {
+			return false
+		}
+		// Timed out, hold it against the peer and try someone else
+		if peer != nil {
+			peer.Failures++
+			peer.InFlight--
+		}
+	} else {
+		req = &entryRequest{tried: make(map[string]bool)}
+		es.requests[hash] = req
+	}
+
+	msg := messages.NewMissingData(s, entryHash).(*messages.MissingData)
+	peer := es.choose(req.tried)
+	req.sent = now
+	req.peer = ""
+	if peer != nil {
+		msg.SetOrigin(peer.Origin)
+		msg.SetNetworkOrigin(peer.NetworkOrigin)
+		peer.Requests++
+		peer.InFlight++
+		req.peer = peer.key()
+		req.tried[req.peer] = true
+	}
+	msg.SendOut(s, msg)
+	return true
+}
+
+// Responded records that a peer delivered an entry we may have asked for
+func (es *EntrySyncScheduler) Responded(msg interfaces.IMsg, entryHash interfaces.IHash) {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	key := peerKey(msg.GetOrigin(), msg.GetNetworkOrigin())
+	peer, ok := es.peers[key]
+	if !ok {
+		peer = &EntrySyncPeer{Origin: msg.GetOrigin(), NetworkOrigin: msg.GetNetworkOrigin()}
+		es.peers[key] = peer
+	}
+	now := time.Now()
+	peer.LastResponse = now
+
+	req, ok := es.requests[entryHash.Fixed()]
+	if !ok {
+		return // unsolicited, or we already have it
+	}
+	delete(es.requests, entryHash.Fixed())
+
+	if asked := es.peers[req.peer]; asked != nil {
+		asked.InFlight--
+	}
+	if req.peer == "" || req.peer == key {
+		if req.peer == "" {
+			peer.Requests++ // it went out to a random peer, which turned out to be this one
+		}
+		peer.Responses++
+		ms := float64(now.Sub(req.sent)) / float64(time.Millisecond)
+		if peer.Latency == 0 {
+			peer.Latency = ms
+		} else {
+			peer.Latency = (1-latencyWeight)*peer.Latency + latencyWeight*ms
+		}
+	}
+}
+
+// Found drops any pending request for an entry we now have, however it arrived
+func (es *EntrySyncScheduler) Found(entryHash interfaces.IHash) {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+	req, ok := es.requests[entryHash.Fixed()]
+	if !ok {
+		return
+	}
+	if peer := es.peers[req.peer]; peer != nil {
+		peer.InFlight--
+	}
+	delete(es.requests, entryHash.Fixed())
+}
+
+// Pending returns the number of entries we are waiting on
+func (es *EntrySyncScheduler) Pending() int {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+	return len(es.requests)
+}
+
+// Peers returns a copy of the peer statistics, best peers first
+func (es *EntrySyncScheduler) Peers() []EntrySyncPeer {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+	peers := make([]EntrySyncPeer, 0, len(es.peers))
+	for _, p := range es.peers {
+		peers = append(peers, *p)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].score() > peers[j].score() })
+	return peers
+}
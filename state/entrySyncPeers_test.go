@@ -0,0 +1,85 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func response(origin int) *messages.DataResponse {
+	m := new(messages.DataResponse)
+	m.SetOrigin(origin)
+	return m
+}
+
+func TestEntrySyncSchedulerPending(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	es := NewEntrySyncScheduler(time.Second)
+
+	h := primitives.RandomHash()
+	if !es.Request(s, h) {
+		t.Error("First request should be sent")
+	}
+	if es.Request(s, h) {
+		t.Error("Request should not be resent before it times out")
+	}
+	if es.Pending() != 1 {
+		t.Errorf("Expected 1 pending request, found %d", es.Pending())
+	}
+
+	es.Responded(response(1), h)
+	if es.Pending() != 0 {
+		t.Errorf("Expected no pending requests, found %d", es.Pending())
+	}
+	peers := es.Peers()
+	if len(peers) != 1 || peers[0].Origin != 1 || peers[0].Responses != 1 {
+		t.Errorf("Unexpected peers %+v", peers)
+	}
+}
+
+func TestEntrySyncSchedulerPrefersGoodPeers(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	es := NewEntrySyncScheduler(time.Second)
+
+	// Peer 1 answers right away, peer 2 only shows up with an unsolicited answer
+	h := primitives.RandomHash()
+	es.Request(s, h)
+	es.Responded(response(1), h)
+	es.Responded(response(2), primitives.RandomHash())
+
+	for i := 0; i < 200; i++ {
+		es.Request(s, primitives.RandomHash())
+	}
+	for _, p := range es.Peers() {
+		if p.Origin == 1 && p.Requests < 150 {
+			t.Errorf("Expected most requests to go to the fastest peer, it got %d", p.Requests)
+		}
+	}
+}
+
+func TestEntrySyncSchedulerRetries(t *testing.T) {
+	s := testHelper.CreateEmptyTestState()
+	es := NewEntrySyncScheduler(time.Millisecond)
+
+	h := primitives.RandomHash()
+	es.Request(s, h)
+	es.Responded(response(1), h)
+
+	h = primitives.RandomHash()
+	es.Request(s, h)
+	time.Sleep(20 * time.Millisecond)
+	if !es.Request(s, h) {
+		t.Error("Request should be resent once it times out")
+	}
+	if es.Pending() != 1 {
+		t.Errorf("A resend should not add a pending request, found %d", es.Pending())
+	}
+}
@@ -6,16 +6,15 @@ package state
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/FactomProject/factomd/common/interfaces"
-	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/database/databaseOverlay"
 )
 
 const (
-	pendingRequests    = 10000 // Lower bound on pending requests while syncing entries
-	purgeEveryXEntries = 1000  // Every 1000 entries or so, go through the written map and purge old entries
+	pendingRequests = 10000 // Lower bound on pending requests while syncing entries
 )
 
 type ReCheck struct {
@@ -26,30 +25,79 @@ type ReCheck struct {
 	Tries       int
 }
 type EntrySync struct {
-	MissingDBlockEntries     chan []*ReCheck            // We don't have these entries.  Each list is from a directory block.
-	DBHeightBase             int                        // This is the highest block with entries not yet checked or are missing
-	TotalEntries             int                        // Total Entries in the database
-	SyncingBlocks            map[int][]*ReCheck         // Map of Directory blocks by height
-	finishedDBlocks          chan int                   // Channel of finished Directory blocks
-	finishedEntries          chan int                   // We get a ping every time an entry is done
-	SendRequest              chan *messages.MissingData // Channel for messages being requested
-	Processing               int                        // Directory block we are processing
-	EntriesProcessing        int                        // Total of Entries being processed
-	EntryRequests            int                        // Requests made
-	EntriesFound             int                        // Entries found
-	DirectoryBlocksInProcess int                        // Number of Directory blocks we are processing
+	MissingDBlockEntries     chan []*ReCheck     // We don't have these entries.  Each list is from a directory block.
+	DBHeightBase             int                 // This is the highest block with entries not yet checked or are missing
+	TotalEntries             int                 // Total Entries in the database
+	SyncingBlocks            map[int][]*ReCheck  // Map of Directory blocks by height
+	finishedDBlocks          chan int            // Channel of finished Directory blocks
+	finishedEntries          chan int            // We get a ping every time an entry is done
+	Scheduler                *EntrySyncScheduler // Decides which peer to ask for each missing entry
+	Processing               int                 // Directory block we are processing
+	EntriesProcessing        int                 // Total of Entries being processed
+	EntryRequests            int                 // Requests made, under mutex as the request goroutines count them
+	EntriesFound             int                 // Entries found, under mutex
+	EntriesMissing           int                 // Entries found missing while scanning directory blocks
+	DirectoryBlocksInProcess int                 // Number of Directory blocks we are processing
+
+	// Progress, updated about once a second
+	mutex       sync.Mutex
+	Rate        float64   // Missing entries found per second
+	lastFound   int       // EntriesFound at the last rate update
+	lastUpdate  time.Time // Time of the last rate update
+	ProgressETA time.Duration
 }
 
 // Maintain queues of what we want to test, and what we are currently testing.
-func (es *EntrySync) Init() {
+func (es *EntrySync) Init(second time.Duration) {
 	es.MissingDBlockEntries = make(chan []*ReCheck, 1000) // Check 10 directory blocks at a time.
 	es.finishedEntries = make(chan int, 10000)
 	es.finishedDBlocks = make(chan int, 10000)
-	es.SendRequest = make(chan *messages.MissingData, 1000)
+	es.Scheduler = NewEntrySyncScheduler(second)
 
 	es.SyncingBlocks = make(map[int][]*ReCheck)
 } // we have to reprocess
 
+// updateProgress recomputes the rate entries are being found and the time left to
+// find the ones still missing
+func (es *EntrySync) updateProgress(now time.Time) {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+	if es.lastUpdate.IsZero() {
+		es.lastUpdate = now
+		es.lastFound = es.EntriesFound
+		return
+	}
+	elapsed := now.Sub(es.lastUpdate).Seconds()
+	if elapsed < 1 {
+		return
+	}
+	rate := float64(es.EntriesFound-es.lastFound) / elapsed
+	if es.Rate == 0 {
+		es.Rate = rate
+	} else {
+		es.Rate = 0.9*es.Rate + 0.1*rate // smooth out bursts
+	}
+	es.lastFound = es.EntriesFound
+	es.lastUpdate = now
+
+	es.ProgressETA = 0
+	if missing := es.EntriesMissing - es.EntriesFound; missing > 0 && es.Rate > 0 {
+		es.ProgressETA = time.Duration(float64(missing)/es.Rate) * time.Second
+	}
+}
+
+// Progress returns the entries still missing, the rate they are being found per second
+// and the estimated time to find them all
+func (es *EntrySync) Progress() (missing int, rate float64, eta time.Duration) {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+	missing = es.EntriesMissing - es.EntriesFound
+	if missing < 0 {
+		missing = 0
+	}
+	return missing, es.Rate, es.ProgressETA
+}
+
 // todo: likely benefit if we cache the hash's last 1K written
 func has(s *State, entry interfaces.IHash) bool {
 	exists, err := s.DB.DoesKeyExist(databaseOverlay.ENTRY, entry.Bytes())
@@ -81,43 +129,6 @@ func (s *State) WriteEntries() {
 	}
 }
 
-// SendManager keeps us from double sending entries on repeats.
-func (s *State) SendManager() {
-	es := s.EntrySyncState
-	var EntriesRequested map[[32]byte]time.Time     // Time we last sent a request for this entry
-	EntriesRequested = make(map[[32]byte]time.Time) // Make our map
-
-	purge := purgeEveryXEntries
-
-	for {
-		missingData := <-es.SendRequest
-		now := time.Now()
-		tenSeconds := s.FactomSecond() * 10
-
-		// Every 1000 messages or so, purge our hash map.
-		if purge <= 0 {
-			for k, v := range EntriesRequested {
-				delay := now.Sub(v)
-				if delay >= tenSeconds {
-					delete(EntriesRequested, k)
-				}
-			}
-			purge = purgeEveryXEntries
-		}
-		purge--
-
-		lastCall, ok := EntriesRequested[missingData.RequestHash.Fixed()]
-		if !ok || now.Sub(lastCall) > tenSeconds {
-			if !has(s, missingData.RequestHash) {
-				EntriesRequested[missingData.RequestHash.Fixed()] = now
-				missingData.SendOut(s, missingData)
-				s.EntrySyncState.EntryRequests++
-				continue
-			}
-		}
-	} // forever ...
-}
-
 // RequestAndCollectMissingEntries()
 // Manage go routines that are requesting and checking for missing entries
 func (s *State) RequestAndCollectMissingEntries() {
@@ -155,14 +166,24 @@ func (s *State) RequestAndCollectMissingEntries() {
 			}
 		}
 
-		s.LogPrintf("entrysyncing", "Processing dbht %6d %6d Entries processing %6d Requests %6d Found %6d queue %6d DBlocks %6d",
+		es.updateProgress(time.Now())
+		missing, rate, eta := es.Progress()
+		es.mutex.Lock()
+		requests, found := es.EntryRequests, es.EntriesFound
+		es.mutex.Unlock()
+
+		s.LogPrintf("entrysyncing", "Processing dbht %6d %6d Entries processing %6d Requests %6d Found %6d queue %6d DBlocks %6d Pending %6d Missing %6d Rate %8.2f/s ETA %v",
 			s.EntryDBHeightComplete,
 			es.Processing,
 			es.EntriesProcessing,
-			es.EntryRequests,
-			es.EntriesFound,
+			requests,
+			found,
 			len(es.MissingDBlockEntries),
-			es.DirectoryBlocksInProcess)
+			es.DirectoryBlocksInProcess,
+			es.Scheduler.Pending(),
+			missing,
+			rate,
+			eta)
 
 		for es.EntriesProcessing < pendingRequests && len(es.MissingDBlockEntries) > 0 {
 			dbrcs := <-es.MissingDBlockEntries
@@ -184,9 +205,13 @@ func (s *State) ProcessDBlock(finishedDBlocks chan int, finishedEntries chan int
 		return
 	}
 
+	es := s.EntrySyncState
+
 	// This function does one pass over our directory block's entries
 	// Returns true if the directory block is complete, and false if
-	// more entries need to be asked for.
+	// more entries need to be asked for.  All the missing entries of the
+	// block are requested at once, the scheduler only resends the ones
+	// whose request timed out, to a different peer.
 	LookForEntries := func() (Complete bool) {
 		Complete = true
 		for ipass, rc := range dbrcs {
@@ -197,15 +222,19 @@ func (s *State) ProcessDBlock(finishedDBlocks chan int, finishedEntries chan int
 				finishedEntries <- 0 // It isn't a real entry, but we have to account for it.
 			case has(s, rc.EntryHash):
 				dbrcs[ipass] = nil
-				s.EntrySyncState.EntriesFound++
+				es.Scheduler.Found(rc.EntryHash)
+				es.mutex.Lock()
+				es.EntriesFound++
+				es.mutex.Unlock()
 				finishedEntries <- 0
-			default: // For only the first pass do we ask for missing entries
-				//	s.LogPrintf("entrysyncing", "looking for %x [%6d] dbht %6d tries %6d",
-				//		rc.EntryHash.Bytes(), ipass, dbht, rc.Tries)
-				entryRequest := messages.NewMissingData(s, rc.EntryHash).(*messages.MissingData)
-				s.EntrySyncState.SendRequest <- entryRequest
+			default:
+				if es.Scheduler.Request(s, rc.EntryHash) {
+					es.mutex.Lock()
+					es.EntryRequests++
+					es.mutex.Unlock()
+					rc.Tries++
+				}
 				Complete = false
-				rc.Tries++
 			}
 		}
 		return
@@ -217,10 +246,14 @@ func (s *State) ProcessDBlock(finishedDBlocks chan int, finishedEntries chan int
 		if LookForEntries() {
 			break
 		}
+		time.Sleep(s.FactomSecond() / 4)
 	}
 	// We get here if there is nothing left to do.  Tell our parent process what directory block we finished
 	finishedDBlocks <- dbht
-	s.LogPrintf("entrysyncing", "Directory Block Complete %6d all Entries found %6d", dbht, s.EntrySyncState.EntriesFound)
+	es.mutex.Lock()
+	found := es.EntriesFound
+	es.mutex.Unlock()
+	s.LogPrintf("entrysyncing", "Directory Block Complete %6d all Entries found %6d", dbht, found)
 	return
 }
 
@@ -229,11 +262,8 @@ func (s *State) ProcessDBlock(finishedDBlocks chan int, finishedEntries chan int
 // all the entries they reference.
 func (s *State) GoSyncEntries() {
 	time.Sleep(5 * time.Second)
-	s.EntrySyncState = new(EntrySync)
-	s.EntrySyncState.Init() // Initialize our processes
 
 	go s.WriteEntries()
-	go s.SendManager()
 	go s.RequestAndCollectMissingEntries()
 
 	highestChecked := s.EntryDBHeightComplete
@@ -314,6 +344,9 @@ func (s *State) GoSyncEntries() {
 			}
 
 			lookingfor += len(entries)
+			s.EntrySyncState.mutex.Lock()
+			s.EntrySyncState.EntriesMissing += len(entries)
+			s.EntrySyncState.mutex.Unlock()
 
 			//	s.LogPrintf("entrysyncing", "Missing entries total %10d at height %10d directory entries: %10d QueueLen %10d",
 			//		lookingfor, scan, len(entries), len(s.EntrySyncState.MissingDBlockEntries))
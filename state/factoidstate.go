@@ -387,6 +387,37 @@ func (fs *FactoidState) ProcessEndOfBlock(state interfaces.IState) {
 // Returns an error message about what is wrong with the transaction if it is
 // invalid, otherwise you are good to go.
 func (fs *FactoidState) Validate(index int, trans interfaces.ITransaction) (err error, holdAddr [32]byte) {
+	for _, rcd := range trans.GetRCDs() {
+		if _, ok := rcd.(*factoid.RCD_2); ok && !fs.State.IsActive(activations.MULTISIG_RCD) {
+			return fmt.Errorf("Multisig (RCD type 2) inputs are not active at this height"), holdAddr
+		}
+		if locked, ok := rcd.(*factoid.RCD_3); ok {
+			if !fs.State.IsActive(activations.TIME_LOCKED_RCD) {
+				return fmt.Errorf("Time locked (RCD type 3) inputs are not active at this height"), holdAddr
+			}
+			if _, ok := locked.RCD.(*factoid.RCD_2); ok && !fs.State.IsActive(activations.MULTISIG_RCD) {
+				return fmt.Errorf("Multisig (RCD type 2) inputs are not active at this height"), holdAddr
+			}
+			// Judge the lock by the block the transaction goes in, so every node agrees.  The
+			// API validates too, and may catch a new block before its coinbase is added.
+			block := fs.GetCurrentBlock()
+			dbheight := block.GetDatabaseHeight()
+			coinbase := block.GetCoinbaseTimestamp()
+			if coinbase == nil {
+				address, err := locked.GetAddress()
+				if err != nil {
+					return err, holdAddr
+				}
+				return fmt.Errorf("%20s DBHT %d The block is not started, cannot judge the lock of an input",
+					fs.State.GetFactomNodeName(), dbheight), address.Fixed()
+			}
+			if locked.IsLocked(dbheight, coinbase.GetTimeMilli()) {
+				return fmt.Errorf("%20s DBHT %d Input address is locked until %s %d",
+					fs.State.GetFactomNodeName(), dbheight, locked.LockTypeName(), locked.Lock), holdAddr
+			}
+		}
+	}
+
 	var sums = make(map[[32]byte]uint64, 10)  // Look at the sum of an address's inputs
 	for _, input := range trans.GetInputs() { //    to a transaction.
 		bal, err := factoid.ValidateAmounts(sums[input.GetAddress().Fixed()], input.GetAmount())
@@ -0,0 +1,526 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FactomProject/factomd/common/constants"
+)
+
+// Fastboot v2 file layout
+//
+// Every fastboot file starts with a fixed size header followed by the body.
+// A full snapshot's body is the (optionally gzipped) DBStateList binary. A
+// delta's body is the (optionally gzipped) list of copy/insert operations that
+// rebuild the DBStateList binary from the full snapshot it was computed against.
+//
+//   magic        [4]byte  "FBS2"
+//   version      uint8    FastBootFormatVersion
+//   kind         uint8    FastBootFull | FastBootDelta
+//   flags        uint8    FastBootFlagGzip
+//   reserved     uint8
+//   dbheight     uint32   height of the saved state
+//   baseheight   uint32   height of the full snapshot a delta applies to
+//   payloadLen   uint64   length of the rebuilt DBStateList binary
+//   payloadHash  [32]byte sha256 of the rebuilt DBStateList binary
+//   baseHash     [32]byte payloadHash of the full snapshot a delta applies to
+//   bodyLen      uint64   length of the stored body
+//   bodyHash     [32]byte sha256 of the stored body
+//   headerHash   [32]byte sha256 of all the preceding header bytes
+
+const (
+	FastBootFormatVersion = 2
+
+	FastBootFull  = 0
+	FastBootDelta = 1
+
+	FastBootFlagGzip = 0x01
+
+	fastBootHeaderSize = 4 + 4 + 4 + 4 + 8 + 32 + 32 + 8 + 32 + 32
+
+	// Block size used to match regions of the base snapshot when building deltas
+	fastBootDeltaBlock = 1024
+
+	fastBootOpCopy   = 1
+	fastBootOpInsert = 2
+)
+
+var fastBootMagic = [4]byte{'F', 'B', 'S', '2'}
+
+// FastBootHeader is the decoded header of a v2 fastboot file
+type FastBootHeader struct {
+	Version     uint8
+	Kind        uint8
+	Flags       uint8
+	DBHeight    uint32
+	BaseHeight  uint32
+	PayloadLen  uint64
+	PayloadHash [32]byte
+	BaseHash    [32]byte
+	BodyLen     uint64
+	BodyHash    [32]byte
+}
+
+func (h *FastBootHeader) IsDelta() bool {
+	return h.Kind == FastBootDelta
+}
+
+func (h *FastBootHeader) IsCompressed() bool {
+	return h.Flags&FastBootFlagGzip != 0
+}
+
+func (h *FastBootHeader) MarshalBinary() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.Write(fastBootMagic[:])
+	buf.Write([]byte{h.Version, h.Kind, h.Flags, 0})
+	binary.Write(buf, binary.BigEndian, h.DBHeight)
+	binary.Write(buf, binary.BigEndian, h.BaseHeight)
+	binary.Write(buf, binary.BigEndian, h.PayloadLen)
+	buf.Write(h.PayloadHash[:])
+	buf.Write(h.BaseHash[:])
+	binary.Write(buf, binary.BigEndian, h.BodyLen)
+	buf.Write(h.BodyHash[:])
+	sum := sha256.Sum256(buf.Bytes())
+	buf.Write(sum[:])
+	return buf.Bytes(), nil
+}
+
+func (h *FastBootHeader) UnmarshalBinaryData(p []byte) ([]byte, error) {
+	if len(p) < fastBootHeaderSize {
+		return nil, fmt.Errorf("fastboot header too short: %d bytes", len(p))
+	}
+	if !bytes.Equal(p[:4], fastBootMagic[:]) {
+		return nil, errors.New("not a v2 fastboot file")
+	}
+	sum := sha256.Sum256(p[:fastBootHeaderSize-32])
+	if !bytes.Equal(sum[:], p[fastBootHeaderSize-32:fastBootHeaderSize]) {
+		return nil, errors.New("fastboot header checksum mismatch")
+	}
+	h.Version = p[4]
+	h.Kind = p[5]
+	h.Flags = p[6]
+	if h.Version != FastBootFormatVersion {
+		return nil, fmt.Errorf("unsupported fastboot format version %d", h.Version)
+	}
+	i := 8
+	h.DBHeight = binary.BigEndian.Uint32(p[i:])
+	i += 4
+	h.BaseHeight = binary.BigEndian.Uint32(p[i:])
+	i += 4
+	h.PayloadLen = binary.BigEndian.Uint64(p[i:])
+	i += 8
+	copy(h.PayloadHash[:], p[i:])
+	i += 32
+	copy(h.BaseHash[:], p[i:])
+	i += 32
+	h.BodyLen = binary.BigEndian.Uint64(p[i:])
+	i += 8
+	copy(h.BodyHash[:], p[i:])
+	return p[fastBootHeaderSize:], nil
+}
+
+// EncodeFastBootFull wraps a DBStateList binary into a full v2 fastboot file
+func EncodeFastBootFull(dbht uint32, payload []byte, compress bool) ([]byte, error) {
+	h := new(FastBootHeader)
+	h.Kind = FastBootFull
+	h.DBHeight = dbht
+	h.BaseHeight = dbht
+	return encodeFastBoot(h, payload, payload, compress)
+}
+
+// EncodeFastBootDelta builds a v2 fastboot delta that rebuilds payload from the
+// payload of the full snapshot at baseHeight
+func EncodeFastBootDelta(dbht uint32, baseHeight uint32, base []byte, payload []byte, compress bool) ([]byte, error) {
+	h := new(FastBootHeader)
+	h.Kind = FastBootDelta
+	h.DBHeight = dbht
+	h.BaseHeight = baseHeight
+	h.BaseHash = sha256.Sum256(base)
+	return encodeFastBoot(h, payload, ComputeFastBootDelta(base, payload), compress)
+}
+
+func encodeFastBoot(h *FastBootHeader, payload []byte, body []byte, compress bool) ([]byte, error) {
+	h.Version = FastBootFormatVersion
+	h.PayloadLen = uint64(len(payload))
+	h.PayloadHash = sha256.Sum256(payload)
+	if compress {
+		var err error
+		body, err = gzipBytes(body)
+		if err != nil {
+			return nil, err
+		}
+		h.Flags |= FastBootFlagGzip
+	}
+	h.BodyLen = uint64(len(body))
+	h.BodyHash = sha256.Sum256(body)
+
+	hb, err := h.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(hb, body...), nil
+}
+
+// DecodeFastBoot validates a v2 fastboot file and returns its header and
+// uncompressed body. For a full snapshot the body is the DBStateList binary,
+// for a delta it must still be applied with ApplyFastBootDelta.
+func DecodeFastBoot(b []byte) (*FastBootHeader, []byte, error) {
+	h := new(FastBootHeader)
+	body, err := h.UnmarshalBinaryData(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(body)) != h.BodyLen {
+		return nil, nil, fmt.Errorf("fastboot body length %d does not match header %d", len(body), h.BodyLen)
+	}
+	if sha256.Sum256(body) != h.BodyHash {
+		return nil, nil, errors.New("fastboot body does not match its hash")
+	}
+	if h.IsCompressed() {
+		body, err = gunzipBytes(body)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if !h.IsDelta() {
+		if err := h.checkPayload(body); err != nil {
+			return nil, nil, err
+		}
+	}
+	return h, body, nil
+}
+
+func (h *FastBootHeader) checkPayload(payload []byte) error {
+	if uint64(len(payload)) != h.PayloadLen {
+		return fmt.Errorf("fastboot payload length %d does not match header %d", len(payload), h.PayloadLen)
+	}
+	if sha256.Sum256(payload) != h.PayloadHash {
+		return errors.New("fastboot payload does not match its hash")
+	}
+	return nil
+}
+
+// ApplyFastBootDelta rebuilds the payload of a delta from the payload of its base snapshot
+func ApplyFastBootDelta(h *FastBootHeader, base []byte, delta []byte) ([]byte, error) {
+	if !h.IsDelta() {
+		return nil, errors.New("fastboot file is not a delta")
+	}
+	if sha256.Sum256(base) != h.BaseHash {
+		return nil, fmt.Errorf("fastboot delta for %d does not apply to this base", h.DBHeight)
+	}
+	out := make([]byte, 0, h.PayloadLen)
+	for len(delta) > 0 {
+		op := delta[0]
+		delta = delta[1:]
+		if len(delta) < 8 {
+			return nil, errors.New("fastboot delta truncated")
+		}
+		a := binary.BigEndian.Uint32(delta)
+		n := binary.BigEndian.Uint32(delta[4:])
+		delta = delta[8:]
+		switch op {
+		case fastBootOpCopy:
+			if uint64(a)+uint64(n) > uint64(len(base)) {
+				return nil, errors.New("fastboot delta copy out of range")
+			}
+			out = append(out, base[a:a+n]...)
+		case fastBootOpInsert:
+			if uint64(n) > uint64(len(delta)) {
+				return nil, errors.New("fastboot delta insert out of range")
+			}
+			out = append(out, delta[:n]...)
+			delta = delta[n:]
+		default:
+			return nil, fmt.Errorf("unknown fastboot delta op %d", op)
+		}
+	}
+	if err := h.checkPayload(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ComputeFastBootDelta returns copy/insert operations that rebuild target from base.
+// Blocks of base are indexed by a rolling checksum so that regions which moved
+// (e.g. because a balance map grew) are still found.
+func ComputeFastBootDelta(base []byte, target []byte) []byte {
+	const B = fastBootDeltaBlock
+	index := make(map[uint32][]int)
+	for i := 0; i+B <= len(base); i += B {
+		w := weakSum(base[i : i+B])
+		index[w] = append(index[w], i)
+	}
+
+	out := new(bytes.Buffer)
+	var pending []byte // literal bytes not yet emitted
+	copyStart, copyLen := -1, 0
+
+	flushCopy := func() {
+		if copyLen > 0 {
+			writeFastBootOp(out, fastBootOpCopy, uint32(copyStart), uint32(copyLen), nil)
+		}
+		copyStart, copyLen = -1, 0
+	}
+	flushInsert := func() {
+		if len(pending) > 0 {
+			writeFastBootOp(out, fastBootOpInsert, 0, uint32(len(pending)), pending)
+		}
+		pending = pending[:0]
+	}
+
+	p := 0
+	var r rollingSum
+	rolling := false
+	for p+B <= len(target) {
+		if !rolling {
+			r.init(target[p : p+B])
+			rolling = true
+		}
+		match := -1
+		for _, off := range index[r.sum()] {
+			if bytes.Equal(base[off:off+B], target[p:p+B]) {
+				match = off
+				break
+			}
+		}
+		if match >= 0 {
+			flushInsert()
+			if copyLen > 0 && copyStart+copyLen == match {
+				copyLen += B
+			} else {
+				flushCopy()
+				copyStart, copyLen = match, B
+			}
+			p += B
+			rolling = false
+			continue
+		}
+		flushCopy()
+		pending = append(pending, target[p])
+		if p+B < len(target) {
+			r.roll(target[p], target[p+B])
+		}
+		p++
+	}
+	flushCopy()
+	pending = append(pending, target[p:]...)
+	flushInsert()
+	return out.Bytes()
+}
+
+func writeFastBootOp(out *bytes.Buffer, op byte, a uint32, n uint32, data []byte) {
+	var hdr [9]byte
+	hdr[0] = op
+	binary.BigEndian.PutUint32(hdr[1:], a)
+	binary.BigEndian.PutUint32(hdr[5:], n)
+	out.Write(hdr[:])
+	out.Write(data)
+}
+
+// rollingSum is an adler style checksum that can slide one byte at a time
+type rollingSum struct {
+	a, b uint32
+	n    uint32
+}
+
+func (r *rollingSum) init(p []byte) {
+	r.a, r.b, r.n = 0, 0, uint32(len(p))
+	for i, c := range p {
+		r.a += uint32(c)
+		r.b += uint32(len(p)-i) * uint32(c)
+	}
+}
+
+func (r *rollingSum) roll(out byte, in byte) {
+	r.a = r.a - uint32(out) + uint32(in)
+	r.b = r.b - r.n*uint32(out) + r.a
+}
+
+func (r *rollingSum) sum() uint32 {
+	return (r.a & 0xffff) | (r.b << 16)
+}
+
+func weakSum(p []byte) uint32 {
+	var r rollingSum
+	r.init(p)
+	return r.sum()
+}
+
+func gzipBytes(b []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipBytes(b []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// WriteFileAtomic writes to a temporary file in the same directory, syncs it and
+// renames it over filename so a crash never leaves a partially written file behind
+func WriteFileAtomic(filename string, b []byte, perm os.FileMode) error {
+	dir := filepath.Dir(filename)
+	f, err := ioutil.TempFile(dir, filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	_, err = f.Write(b)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmp, filename)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	// Make the rename durable, not all platforms allow syncing a directory
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+	return nil
+}
+
+// FastBootFileInfo describes a v2 fastboot file found on disk
+type FastBootFileInfo struct {
+	Filename string
+	DBHeight uint32
+	Delta    bool
+}
+
+// FastBootFilename returns the name of a v2 fastboot snapshot or delta for the given height
+func FastBootFilename(networkName string, fileLocation string, dbht uint32, delta bool) string {
+	ext := "db"
+	if delta {
+		ext = "delta"
+	}
+	file := fmt.Sprintf("%s%010d.%s", fastBootPrefix(networkName), dbht, ext)
+	if fileLocation != "" {
+		return filepath.Join(fileLocation, file)
+	}
+	return file
+}
+
+func fastBootPrefix(networkName string) string {
+	return fmt.Sprintf("FastBoot_%s_v%v_f%d_", networkName, constants.SaveStateVersion, FastBootFormatVersion)
+}
+
+// ListFastBootFiles returns the v2 fastboot files of a network, highest height first.
+// A delta sorts before the full snapshot of the same height.
+func ListFastBootFiles(networkName string, fileLocation string) ([]FastBootFileInfo, error) {
+	dir := fileLocation
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	prefix := fastBootPrefix(networkName)
+	var files []FastBootFileInfo
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		rest := strings.TrimPrefix(name, prefix)
+		var delta bool
+		switch {
+		case strings.HasSuffix(rest, ".delta"):
+			delta = true
+			rest = strings.TrimSuffix(rest, ".delta")
+		case strings.HasSuffix(rest, ".db"):
+			rest = strings.TrimSuffix(rest, ".db")
+		default:
+			continue // temporary files from an interrupted save
+		}
+		ht, err := strconv.ParseUint(rest, 10, 32)
+		if err != nil {
+			continue
+		}
+		files = append(files, FastBootFileInfo{Filename: filepath.Join(fileLocation, name), DBHeight: uint32(ht), Delta: delta})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].DBHeight != files[j].DBHeight {
+			return files[i].DBHeight > files[j].DBHeight
+		}
+		return files[i].Delta && !files[j].Delta
+	})
+	return files, nil
+}
+
+// IsFastBootV2 reports whether b starts with a v2 fastboot header
+func IsFastBootV2(b []byte) bool {
+	return len(b) >= 4 && bytes.Equal(b[:4], fastBootMagic[:])
+}
+
+// ReadFastBootPayload reads a v2 fastboot file and returns the DBStateList binary it
+// holds, resolving deltas against their base snapshot in the same directory
+func ReadFastBootPayload(filename string) (*FastBootHeader, []byte, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	h, body, err := DecodeFastBoot(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !h.IsDelta() {
+		return h, body, nil
+	}
+	ext := filepath.Ext(filename)
+	if ext != ".delta" || len(filename) < len(ext)+10 {
+		return nil, nil, fmt.Errorf("fastboot delta %s is not named by its height", filename)
+	}
+	baseName := fmt.Sprintf("%s%010d.db", filename[:len(filename)-len(ext)-10], h.BaseHeight)
+	bb, err := ioutil.ReadFile(baseName)
+	if err != nil {
+		return nil, nil, err
+	}
+	bh, base, err := DecodeFastBoot(bb)
+	if err != nil {
+		return nil, nil, err
+	}
+	if bh.IsDelta() {
+		return nil, nil, errors.New("fastboot delta base is itself a delta")
+	}
+	payload, err := ApplyFastBootDelta(h, base, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h, payload, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func randomPayload(n int) []byte {
+	b := make([]byte, n)
+	rand.Read(b)
+	return b
+}
+
+func TestFastBootFullRoundTrip(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		payload := randomPayload(100000)
+		b, err := EncodeFastBootFull(1234, payload, compress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, body, err := DecodeFastBoot(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.DBHeight != 1234 || h.IsDelta() || h.IsCompressed() != compress {
+			t.Errorf("Header mismatch %+v", h)
+		}
+		if !bytes.Equal(body, payload) {
+			t.Error("Payload did not survive the round trip")
+		}
+	}
+}
+
+func TestFastBootDetectsCorruption(t *testing.T) {
+	payload := randomPayload(5000)
+	b, err := EncodeFastBootFull(10, payload, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Truncated, as a crash mid write would leave it
+	if _, _, err := DecodeFastBoot(b[:len(b)/2]); err == nil {
+		t.Error("Truncated file should fail to decode")
+	}
+	// Flipped bit in the body
+	c := append([]byte{}, b...)
+	c[len(c)-10] ^= 0x01
+	if _, _, err := DecodeFastBoot(c); err == nil {
+		t.Error("Corrupted body should fail to decode")
+	}
+	// Flipped bit in the header
+	c = append([]byte{}, b...)
+	c[9] ^= 0x01
+	if _, _, err := DecodeFastBoot(c); err == nil {
+		t.Error("Corrupted header should fail to decode")
+	}
+}
+
+func TestFastBootDelta(t *testing.T) {
+	base := randomPayload(200000)
+
+	// Modify, insert and remove a few regions so the data shifts around
+	target := append([]byte{}, base[:5000]...)
+	target = append(target, randomPayload(777)...)
+	target = append(target, base[5000:90000]...)
+	target = append(target, base[95000:]...)
+	target[150000] ^= 0xff
+	target = append(target, randomPayload(333)...)
+
+	for _, compress := range []bool{false, true} {
+		b, err := EncodeFastBootDelta(20, 10, base, target, compress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) > len(target)/10 {
+			t.Errorf("Delta is %d bytes for a %d byte payload", len(b), len(target))
+		}
+		h, body, err := DecodeFastBoot(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !h.IsDelta() || h.BaseHeight != 10 || h.DBHeight != 20 {
+			t.Errorf("Header mismatch %+v", h)
+		}
+		out, err := ApplyFastBootDelta(h, base, body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, target) {
+			t.Error("Delta did not rebuild the target")
+		}
+		if _, err := ApplyFastBootDelta(h, target, body); err == nil {
+			t.Error("Delta should not apply to the wrong base")
+		}
+	}
+}
+
+func TestFastBootFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastboot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	base := randomPayload(50000)
+	next := append(append([]byte{}, base...), randomPayload(100)...)
+
+	b, _ := EncodeFastBootFull(10, base, true)
+	if err := WriteFileAtomic(FastBootFilename("UNIT", dir, 10, false), b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	b, _ = EncodeFastBootDelta(12, 10, base, next, true)
+	if err := WriteFileAtomic(FastBootFilename("UNIT", dir, 12, true), b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Leftover from an interrupted save must be ignored
+	ioutil.WriteFile(FastBootFilename("UNIT", dir, 14, false)+".tmp123", []byte("junk"), 0644)
+
+	files, err := ListFastBootFiles("UNIT", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].DBHeight != 12 || !files[0].Delta || files[1].DBHeight != 10 {
+		t.Fatalf("Unexpected files %+v", files)
+	}
+
+	h, payload, err := ReadFastBootPayload(files[0].Filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.DBHeight != 12 || !bytes.Equal(payload, next) {
+		t.Error("Delta file did not rebuild the saved state")
+	}
+}
+
+func TestLoadDBStateListFallsBack(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastboot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := testHelper.CreateAndPopulateTestStateAndStartValidator()
+	last := s.DBStates.DBStates[len(s.DBStates.DBStates)-1]
+	last.SaveStruct = SaveFactomdState(s, last)
+	good, err := s.DBStates.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A valid snapshot, and a newer one that decodes but does not unmarshal
+	b, _ := EncodeFastBootFull(10, good, true)
+	if err := WriteFileAtomic(FastBootFilename("UNIT", dir, 10, false), b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	b, _ = EncodeFastBootFull(12, randomPayload(100), true)
+	if err := WriteFileAtomic(FastBootFilename("UNIT", dir, 12, false), b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sss := new(StateSaverStruct)
+	sss.FastBootLocation = dir
+	list := new(DBStateList)
+	list.State = s
+	if err := sss.LoadDBStateList(s, list, "UNIT"); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.DBStates) != len(s.DBStates.DBStates) {
+		t.Errorf("Expected %d DBStates from the older snapshot, got %d", len(s.DBStates.DBStates), len(list.DBStates))
+	}
+
+	files, _ := ListFastBootFiles("UNIT", dir)
+	if len(files) != 1 || files[0].DBHeight != 10 {
+		t.Errorf("Expected only the failed snapshot to be deleted, have %+v", files)
+	}
+}
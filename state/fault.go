@@ -92,8 +92,9 @@ func markFault(pl *ProcessList, vmIndex int, faultReason int) {
 
 	now := time.Now().Unix()
 	vm := pl.VMs[vmIndex]
+	newFault := vm.WhenFaulted == 0
 
-	if vm.WhenFaulted == 0 {
+	if newFault {
 		// if we did not previously consider this VM faulted
 		// we simply mark it as faulted (by assigning it a nonzero WhenFaulted time)
 		// and keep track of the ProcessList height it has faulted at
@@ -107,6 +108,9 @@ func markFault(pl *ProcessList, vmIndex int, faultReason int) {
 	}
 	index := pl.ServerMap[c][vmIndex]
 	if index < len(pl.FedServers) {
+		if newFault {
+			pl.State.ProcessListHistory.Fault(pl.DBHeight, pl.State.CurrentMinute, vmIndex, pl.FedServers[index].GetChainID(), faultReason)
+		}
 		pl.FedServers[index].SetOnline(false)
 	}
 }
@@ -0,0 +1,247 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/alerts"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// Number of messages and events kept by the flight recorder if not configured
+const DefaultFlightRecorderSize = 10000
+
+// Bytes of records kept by the flight recorder if not configured, the oldest records are
+// dropped to stay under it even if there is room for more of them
+const DefaultFlightRecorderBytes = 64 * 1024 * 1024
+
+// Automatic dumps are written at most this often, so a flapping alert does not fill the disk
+const flightRecorderDumpInterval = time.Minute
+
+// FlightRecorder keeps the last messages a node received and sent, and what happened to
+// its state, so a stall or a panic can be investigated without runtime logs.  Dumps are
+// in the journal format: received messages are "MsgHex:" lines that can be replayed
+// with the Journal option, everything else is on comment lines that replay ignores.
+type FlightRecorder struct {
+	mutex    sync.Mutex
+	node     string
+	dir      string
+	ring     []flightRecord
+	first    int // the oldest record
+	count    int // records in the ring
+	bytes    int // size of the records in the ring
+	maxBytes int
+	lastDump time.Time
+}
+
+// Messages are marshaled as they are recorded, the validator may change them later
+type flightRecord struct {
+	time  time.Time
+	kind  string // in, out or event
+	peer  string // who a message came from or went to
+	msg   string // what the message was, for the comment line
+	data  []byte // the marshaled message, nil if it is not kept
+	event string
+}
+
+func (r *flightRecord) size() int {
+	return len(r.kind) + len(r.peer) + len(r.msg) + len(r.data) + len(r.event)
+}
+
+// NewFlightRecorder keeps size records, and no more than maxBytes of them, for a node,
+// dumping them to files in dir
+func NewFlightRecorder(node string, dir string, size int, maxBytes int) *FlightRecorder {
+	if size <= 0 {
+		size = DefaultFlightRecorderSize
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultFlightRecorderBytes
+	}
+	fr := new(FlightRecorder)
+	fr.node = node
+	fr.dir = dir
+	fr.ring = make([]flightRecord, size)
+	fr.maxBytes = maxBytes
+	return fr
+}
+
+func (fr *FlightRecorder) add(r flightRecord) {
+	r.time = time.Now()
+	size := r.size()
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+	for fr.count > 0 && (fr.count == len(fr.ring) || fr.bytes+size > fr.maxBytes) {
+		fr.bytes -= fr.ring[fr.first].size()
+		fr.ring[fr.first] = flightRecord{}
+		fr.first = (fr.first + 1) % len(fr.ring)
+		fr.count--
+	}
+	fr.ring[(fr.first+fr.count)%len(fr.ring)] = r
+	fr.count++
+	fr.bytes += size
+}
+
+// addMsg marshals a message into a record.  DBStates are recorded without their blocks,
+// which can be megabytes each while syncing and are in the database anyway.
+func (fr *FlightRecorder) addMsg(kind string, peer string, msg interfaces.IMsg) {
+	if fr == nil || msg == nil {
+		return
+	}
+	r := flightRecord{kind: kind, peer: peer}
+	if dbstate, ok := msg.(*messages.DBStateMsg); ok {
+		// DBStateMsg.String() marshals the blocks, so it is not used either
+		r.msg = fmt.Sprintf("DBState: dbht:%d dblock %x, blocks not kept",
+			dbstate.DirectoryBlock.GetHeader().GetDBHeight(), dbstate.DirectoryBlock.GetKeyMR().Bytes())
+	} else if data, err := msg.MarshalBinary(); err != nil {
+		r.msg = fmt.Sprintf("%s, not marshaled: %v", msg.String(), err)
+	} else {
+		r.msg = msg.String()
+		r.data = data
+	}
+	fr.add(r)
+}
+
+// Received records a message from a peer, or from the API
+func (fr *FlightRecorder) Received(peer string, msg interfaces.IMsg) {
+	fr.addMsg("in", peer, msg)
+}
+
+// Sent records a message sent to a peer, or broadcast
+func (fr *FlightRecorder) Sent(peer string, msg interfaces.IMsg) {
+	fr.addMsg("out", peer, msg)
+}
+
+// Event records a state transition, such as moving to a new minute
+func (fr *FlightRecorder) Event(format string, args ...interface{}) {
+	if fr == nil {
+		return
+	}
+	fr.add(flightRecord{kind: "event", event: fmt.Sprintf(format, args...)})
+}
+
+// records returns a copy of the ring, oldest first
+func (fr *FlightRecorder) records() []flightRecord {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+	records := make([]flightRecord, 0, fr.count)
+	for i := 0; i < fr.count; i++ {
+		records = append(records, fr.ring[(fr.first+i)%len(fr.ring)])
+	}
+	return records
+}
+
+// WriteTo writes the records, oldest first
+func (fr *FlightRecorder) WriteTo(w io.Writer, reason string) error {
+	if fr == nil {
+		return fmt.Errorf("No flight recorder")
+	}
+	records := fr.records()
+	_, err := fmt.Fprintf(w, "# Flight recorder of %s, dumped %s because %s, %d records\n",
+		fr.node, time.Now().Format(time.RFC3339Nano), reason, len(records))
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		at := r.time.Format("15:04:05.000")
+		switch {
+		case r.kind == "event":
+			_, err = fmt.Fprintf(w, "# %s event %s\n", at, r.event)
+		case r.data == nil:
+			_, err = fmt.Fprintf(w, "# %s %s %s %s\n", at, r.kind, r.peer, r.msg)
+		case r.kind == "in":
+			_, err = fmt.Fprintf(w, "# %s in %s %s\nMsgHex: %x\n", at, r.peer, r.msg, r.data)
+		default:
+			// Sent messages are not replayed, they were made by the node itself
+			_, err = fmt.Fprintf(w, "# %s out %s %s\n# OutMsgHex: %x\n", at, r.peer, r.msg, r.data)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dump writes the records to a new file and returns its path
+func (fr *FlightRecorder) Dump(reason string) (string, error) {
+	if fr == nil {
+		return "", fmt.Errorf("No flight recorder")
+	}
+	if err := os.MkdirAll(fr.dir, 0755); err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("flightrecorder_%s_%s.log", fr.node, time.Now().Format("20060102_150405.000"))
+	path := filepath.Join(fr.dir, name)
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if err = fr.WriteTo(f, reason); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// AutoDump dumps the records unless that was done in the last minute
+func (fr *FlightRecorder) AutoDump(reason string) {
+	if fr == nil {
+		return
+	}
+	fr.mutex.Lock()
+	if time.Since(fr.lastDump) < flightRecorderDumpInterval {
+		fr.mutex.Unlock()
+		return
+	}
+	fr.lastDump = time.Now()
+	fr.mutex.Unlock()
+
+	path, err := fr.Dump(reason)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: flight recorder dump failed: %v\n", fr.node, err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "%s: flight recorder dumped to %s because %s\n", fr.node, path, reason)
+}
+
+// flightRecorderDir is where the state's flight recorder dumps go
+func (s *State) flightRecorderDir() string {
+	if s.LogPath == "" || s.LogPath == "stdout" {
+		return "."
+	}
+	return s.LogPath
+}
+
+// watchFlightRecorderAlerts records this node's alerts, and dumps the recorder when one
+// of them means the network is in trouble
+func (s *State) watchFlightRecorderAlerts() {
+	alerts.AddListener(func(a alerts.Alert) {
+		if a.Node != s.FactomNodeName {
+			return
+		}
+		s.FlightRecorder.Event("alert %s resolved %v at %d: %s", a.Name, a.Resolved, a.DBHeight, a.Message)
+		if a.Resolved {
+			return
+		}
+		switch a.Name {
+		case alerts.ElectionStarted, alerts.MinuteStalled, alerts.Faulted:
+			s.FlightRecorder.AutoDump("alert " + a.Name)
+		}
+	})
+}
+
+// DumpFlightRecorder writes the flight recorder to a file and returns its path
+func (s *State) DumpFlightRecorder(reason string) (string, error) {
+	if reason == "" {
+		reason = "requested"
+	}
+	return s.FlightRecorder.Dump(reason)
+}
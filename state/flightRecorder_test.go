@@ -0,0 +1,123 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/messages/msgsupport"
+	. "github.com/FactomProject/factomd/state"
+	"github.com/FactomProject/factomd/testHelper"
+)
+
+func TestFlightRecorder(t *testing.T) {
+	fr := NewFlightRecorder("FNode0", "", 3, 0)
+	for minute := byte(1); minute <= 3; minute++ {
+		_, eom := historyEOM(10, minute, 0, 0)
+		fr.Received("peer1", eom)
+	}
+	_, eom := historyEOM(10, 4, 0, 0)
+	fr.Sent("broadcast", eom)
+	fr.Event("move to %d-:-%d", 10, 4)
+	eom.Minute = 5 // changed after it was recorded
+
+	var out bytes.Buffer
+	if err := fr.WriteTo(&out, "testing"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the last three records are kept, and only received messages are replayed
+	var minutes []byte
+	events := 0
+	scanner := bufio.NewScanner(&out)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.Contains(line, "event move to 10-:-4") {
+			events++
+		}
+		if !strings.HasPrefix(line, "MsgHex: ") {
+			continue
+		}
+		data, err := hex.DecodeString(strings.TrimPrefix(line, "MsgHex: "))
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg, err := msgsupport.UnmarshalMessage(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		minutes = append(minutes, msg.(*messages.EOM).Minute)
+	}
+	if len(minutes) != 1 || minutes[0] != 3 || events != 1 {
+		t.Errorf("Expected to replay the EOM of minute 3 and see the event, got minutes %v and %d events\n%s", minutes, events, out.String())
+	}
+	if !strings.Contains(out.String(), "# OutMsgHex: ") || !strings.Contains(out.String(), "minute  4") || strings.Contains(out.String(), "minute  5") {
+		t.Errorf("Expected the sent EOM as it was recorded\n%s", out.String())
+	}
+}
+
+func TestFlightRecorderBytes(t *testing.T) {
+	_, eom := historyEOM(10, 1, 0, 0)
+	data, err := eom.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Room for a few EOMs, but far fewer than the ring holds
+	fr := NewFlightRecorder("FNode0", "", 100, 3*(len(data)+len(eom.String())+len("in")+len("peer1")))
+	for minute := byte(1); minute <= 10; minute++ {
+		_, eom := historyEOM(10, minute, 0, 0)
+		fr.Received("peer1", eom)
+	}
+	dbstate := testHelper.CreateTestDBStateList()[0]
+	fr.Received("peer1", dbstate)
+
+	var out bytes.Buffer
+	if err := fr.WriteTo(&out, "testing"); err != nil {
+		t.Fatal(err)
+	}
+	dump := out.String()
+	if !strings.Contains(dump, "3 records") || !strings.Contains(dump, "blocks not kept") {
+		t.Errorf("Expected the last two EOMs and the DBState without its blocks\n%s", dump)
+	}
+	if strings.Count(dump, "MsgHex: ") != 2 {
+		t.Errorf("Expected only the EOMs to be replayed\n%s", dump)
+	}
+}
+
+func TestFlightRecorderDump(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flightrecorder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fr := NewFlightRecorder("FNode0", dir, 0, 0)
+	fr.Event("started")
+	path, err := fr.Dump("requested")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "because requested, 1 records") {
+		t.Errorf("Wrong dump %s", data)
+	}
+
+	var nilRecorder *FlightRecorder
+	nilRecorder.Received("peer1", nil)
+	if _, err := nilRecorder.Dump("requested"); err == nil {
+		t.Error("Expected a nil recorder not to dump")
+	}
+}
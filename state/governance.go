@@ -0,0 +1,273 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/FactomProject/factomd/activations"
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/globals"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// GrantPayoutEntryType is the first external ID of a grant descriptor in the governance chain
+const GrantPayoutEntryType = "Grant Payout"
+
+// A grant descriptor is an entry in the governance chain with the external IDs
+//   [0] "Grant Payout"
+//   [1] the payout height, 4 bytes big endian
+//   [2:] pairs of a 32 byte governance public key and its 64 byte signature
+// and a JSON list of GovernanceGrants as its content.  Each signature covers the
+// first two external IDs followed by the content.
+
+// GovernanceGrant is one payout of a grant descriptor
+type GovernanceGrant struct {
+	Address string `json:"address"`
+	Amount  uint64 `json:"amount"`
+}
+
+// GrantDescriptor is a grant descriptor that was signed by enough governance keys
+type GrantDescriptor struct {
+	DBHeight uint32
+	Grants   []GovernanceGrant
+}
+
+// GrantDescriptorSigningData returns the data the governance keys sign for a grant descriptor
+func GrantDescriptorSigningData(dbheight uint32, content []byte) []byte {
+	data := []byte(GrantPayoutEntryType)
+	data = append(data, heightBytes(dbheight)...)
+	return append(data, content...)
+}
+
+func heightBytes(dbheight uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, dbheight)
+	return b
+}
+
+// ParseGrantDescriptor checks a governance chain entry is a grant descriptor signed by at
+// least threshold of the public keys, and returns the grants it pays
+func ParseGrantDescriptor(entry interfaces.IEBEntry, publicKeys []*primitives.PublicKey, threshold int) (*GrantDescriptor, error) {
+	extIDs := entry.ExternalIDs()
+	if len(extIDs) < 2 || string(extIDs[0]) != GrantPayoutEntryType {
+		return nil, fmt.Errorf("Not a grant descriptor")
+	}
+	if len(extIDs[1]) != 4 {
+		return nil, fmt.Errorf("Bad payout height")
+	}
+	gd := new(GrantDescriptor)
+	gd.DBHeight = binary.BigEndian.Uint32(extIDs[1])
+	if gd.DBHeight%constants.COINBASE_PAYOUT_FREQUENCY != 1 {
+		return nil, fmt.Errorf("Payout height %d is not a coinbase payout height", gd.DBHeight)
+	}
+
+	sigs := extIDs[2:]
+	if len(sigs)%2 != 0 {
+		return nil, fmt.Errorf("Signature is missing its public key")
+	}
+	data := GrantDescriptorSigningData(gd.DBHeight, entry.GetContent())
+	signed := make(map[[32]byte]bool)
+	for i := 0; i < len(sigs); i += 2 {
+		if len(sigs[i]) != 32 || len(sigs[i+1]) != 64 {
+			return nil, fmt.Errorf("Bad signature length")
+		}
+		for _, k := range publicKeys {
+			if primitives.AreBytesEqual(k[:], sigs[i]) && primitives.VerifySlice(sigs[i], data, sigs[i+1]) {
+				signed[k.Fixed()] = true
+			}
+		}
+	}
+	if threshold < 1 || len(signed) < threshold {
+		return nil, fmt.Errorf("Signed by %d governance keys, %d are required", len(signed), threshold)
+	}
+
+	err := json.Unmarshal(entry.GetContent(), &gd.Grants)
+	if err != nil {
+		return nil, err
+	}
+	if len(gd.Grants) == 0 {
+		return nil, fmt.Errorf("No grants")
+	}
+	for _, g := range gd.Grants {
+		if !primitives.ValidateFUserStr(g.Address) {
+			return nil, fmt.Errorf("Bad grant address %s", g.Address)
+		}
+		if g.Amount == 0 {
+			return nil, fmt.Errorf("Grant to %s has no amount", g.Address)
+		}
+	}
+	return gd, nil
+}
+
+// Governance is the chain grant descriptors are read from on a network, and the keys
+// that must sign them.  It is part of consensus, so it is fixed per network rather than
+// configured per node.
+type Governance struct {
+	ChainID    string
+	PublicKeys []string
+	Threshold  int
+}
+
+// GetGovernance returns the governance of the network.  Buried in a func like the hard
+// coded grants so other code cannot easily change it.
+func GetGovernance() Governance {
+	switch globals.Params.NetworkName {
+	case "LOCAL":
+		// Chain "Factom Governance", signed with the all zeroes private key
+		return Governance{
+			ChainID:    "957477d3dd8564cb15fcbed377b50f3ada7ef52c8ac7dc6be25155121f96cda6",
+			PublicKeys: []string{"3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29"},
+			Threshold:  1,
+		}
+	}
+	// No governance chain yet on the other networks, GOVERNANCE_GRANTS is not active there
+	return Governance{}
+}
+
+// keys parses the governance public keys
+func (g Governance) keys() ([]*primitives.PublicKey, error) {
+	var keys []*primitives.PublicKey
+	for _, v := range g.PublicKeys {
+		key := new(primitives.PublicKey)
+		err := key.UnmarshalText([]byte(v))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// GetGovernanceGrantPayoutsFor returns the payouts of the grant descriptors in the governance
+// chain for this height.  Only descriptors at least one payout period older than the payout
+// are used, so every leader has them saved when the coinbase descriptor is built.  Until this
+// node has the governance chain's entry blocks and entries of those blocks an error is
+// returned, and the payout must wait, as paying from part of the chain would build a
+// different admin block.
+func (s *State) GetGovernanceGrantPayoutsFor(g Governance, currentDBHeight uint32) ([]interfaces.ITransAddress, error) {
+	outputs := make([]interfaces.ITransAddress, 0)
+	if g.ChainID == "" || s.DB == nil || currentDBHeight < constants.COINBASE_PAYOUT_FREQUENCY {
+		return outputs, nil
+	}
+	last := currentDBHeight - constants.COINBASE_PAYOUT_FREQUENCY
+
+	chainID, err := primitives.HexToHash(g.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := g.keys()
+	if err != nil {
+		return nil, err
+	}
+
+	eblocks, err := s.governanceEBlocks(chainID, last)
+	if err != nil {
+		return nil, err
+	}
+
+	paid := make(map[[32]byte]bool)
+	for _, eb := range eblocks {
+		for _, h := range eb.GetEntryHashes() {
+			if h.IsMinuteMarker() || paid[h.Fixed()] {
+				continue
+			}
+			entry, err := s.DB.FetchEntry(h)
+			if err != nil {
+				return nil, err
+			}
+			gd, err := ParseGrantDescriptor(entry, keys, g.Threshold)
+			if err != nil {
+				s.LogPrintf("governance", "Skip entry %x: %v", h.Bytes()[:6], err)
+				continue
+			}
+			if gd.DBHeight != currentDBHeight {
+				continue
+			}
+			paid[h.Fixed()] = true
+			for _, g := range gd.Grants {
+				addr := factoid.NewAddress(primitives.ConvertUserStrToAddress(g.Address))
+				outputs = append(outputs, factoid.NewOutAddress(addr, g.Amount))
+			}
+		}
+	}
+	return outputs, nil
+}
+
+// governanceEBlocks returns the entry blocks of the governance chain to height last, once
+// this node has them and all their entries.  Whatever is missing is asked of the network,
+// rather than waiting for the entry sync to reach last, which can be far behind.
+func (s *State) governanceEBlocks(chainID interfaces.IHash, last uint32) ([]interfaces.IEntryBlock, error) {
+	if s.GetHighestSavedBlk() < last {
+		return nil, fmt.Errorf("Governance entry blocks to %d are needed, saved to %d", last, s.GetHighestSavedBlk())
+	}
+	all, err := s.DB.FetchAllEBlocksByChain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].GetHeader().GetEBSequence() < all[j].GetHeader().GetEBSequence()
+	})
+
+	var eblocks []interfaces.IEntryBlock
+	for i, eb := range all {
+		if eb.GetDatabaseHeight() > last {
+			break
+		}
+		// The blocks of a dblock are saved with it, but a gap in the sequence means one
+		// was not, so ask for the block before this one
+		if eb.GetHeader().GetEBSequence() != uint32(i) {
+			prev := eb.GetHeader().GetPrevKeyMR()
+			if !s.isMissingEntryBlock(prev) {
+				s.MissingEntryBlocks = append(s.MissingEntryBlocks, MissingEntryBlock{EBHash: prev, DBHeight: eb.GetDatabaseHeight()})
+			}
+			msg := messages.NewMissingData(s, prev)
+			msg.SendOut(s, msg)
+			return nil, fmt.Errorf("Governance entry block %d is missing", i)
+		}
+		eblocks = append(eblocks, eb)
+	}
+
+	missing := 0
+	for _, eb := range eblocks {
+		for _, h := range eb.GetEntryHashes() {
+			if h.IsMinuteMarker() || has(s, h) {
+				continue
+			}
+			if s.EntrySyncState != nil { // nil until the state is initialized
+				s.EntrySyncState.Scheduler.Request(s, h)
+			}
+			missing++
+		}
+	}
+	if missing > 0 {
+		return nil, fmt.Errorf("%d governance entries to %d are missing", missing, last)
+	}
+	return eblocks, nil
+}
+
+func (s *State) isMissingEntryBlock(keyMR interfaces.IHash) bool {
+	for _, m := range s.MissingEntryBlocks {
+		if m.EBHash.IsSameAs(keyMR) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetGrantPayouts returns the grants to pay at this height, from the hard coded list before
+// the GovernanceGrants activation and from the network's governance chain after it
+func (s *State) GetGrantPayouts(currentDBHeight uint32) ([]interfaces.ITransAddress, error) {
+	if !activations.IsActive(activations.GOVERNANCE_GRANTS, int(currentDBHeight)) {
+		return GetGrantPayoutsFor(currentDBHeight), nil
+	}
+	return s.GetGovernanceGrantPayoutsFor(GetGovernance(), currentDBHeight)
+}
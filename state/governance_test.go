@@ -0,0 +1,225 @@
+package state
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/globals"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/database/mapdb"
+)
+
+var governanceChainID = "888888a06cf9fd1e1ad5b5a0ac4fbe3e0f8d2a6b6ac70f4d0cd0f58c9c69db10"
+
+func newGrantDescriptor(t *testing.T, dbheight uint32, grants []GovernanceGrant, signers ...*primitives.PrivateKey) *entryBlock.Entry {
+	content, err := json.Marshal(grants)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := entryBlock.NewEntry()
+	e.ChainID, _ = primitives.HexToHash(governanceChainID)
+	e.ExtIDs = []primitives.ByteSlice{
+		{Bytes: []byte(GrantPayoutEntryType)},
+		{Bytes: heightBytes(dbheight)},
+	}
+	e.Content = primitives.ByteSlice{Bytes: content}
+	data := GrantDescriptorSigningData(dbheight, content)
+	for _, k := range signers {
+		sig := k.Sign(data)
+		e.ExtIDs = append(e.ExtIDs, primitives.ByteSlice{Bytes: k.Pub[:]}, primitives.ByteSlice{Bytes: sig.GetSignature()[:]})
+	}
+	return e
+}
+
+// setLocalNetwork switches to the LOCAL network's coinbase constants, and returns a
+// func that puts back the network and constants there were before
+func setLocalNetwork() func() {
+	name := globals.Params.NetworkName
+	declaration := constants.COINBASE_DECLARATION
+	frequency := constants.COINBASE_PAYOUT_FREQUENCY
+	activation := constants.COINBASE_ACTIVATION
+
+	globals.Params.NetworkName = "LOCAL"
+	constants.SetLocalCoinBaseConstants()
+	return func() {
+		globals.Params.NetworkName = name
+		constants.COINBASE_DECLARATION = declaration
+		constants.COINBASE_PAYOUT_FREQUENCY = frequency
+		constants.COINBASE_ACTIVATION = activation
+	}
+}
+
+func governanceTestKeys() ([]*primitives.PrivateKey, []*primitives.PublicKey) {
+	var privs []*primitives.PrivateKey
+	var pubs []*primitives.PublicKey
+	for i := 0; i < 3; i++ {
+		k := primitives.RandomPrivateKey()
+		privs = append(privs, k)
+		pubs = append(pubs, k.Pub)
+	}
+	return privs, pubs
+}
+
+func TestParseGrantDescriptor(t *testing.T) {
+	defer setLocalNetwork()()
+
+	privs, pubs := governanceTestKeys()
+	grants := []GovernanceGrant{{"FA3oajkmHMfqkNMMShmqpwDThzMCuVrSsBwiXM2kYFVRz3MzxNAJ", 5e8}}
+	height := 6*constants.COINBASE_PAYOUT_FREQUENCY + 1
+
+	gd, err := ParseGrantDescriptor(newGrantDescriptor(t, height, grants, privs[0], privs[2]), pubs, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gd.DBHeight != height || len(gd.Grants) != 1 || gd.Grants[0] != grants[0] {
+		t.Errorf("Wrong descriptor %v", gd)
+	}
+
+	bad := map[string]*entryBlock.Entry{
+		"too few signatures":  newGrantDescriptor(t, height, grants, privs[0]),
+		"same key twice":      newGrantDescriptor(t, height, grants, privs[1], privs[1]),
+		"unknown key":         newGrantDescriptor(t, height, grants, privs[0], primitives.RandomPrivateKey()),
+		"not a payout height": newGrantDescriptor(t, height+1, grants, privs[0], privs[1]),
+		"no grants":           newGrantDescriptor(t, height, nil, privs[0], privs[1]),
+		"bad address":         newGrantDescriptor(t, height, []GovernanceGrant{{"FA3oajkmHMfqkNMMShmqpwDThzMCuVrSsBwiXM2kYFVRz3MzxNAK", 1}}, privs[0], privs[1]),
+		"no amount":           newGrantDescriptor(t, height, []GovernanceGrant{{grants[0].Address, 0}}, privs[0], privs[1]),
+	}
+	changed := newGrantDescriptor(t, height, grants, privs[0], privs[1])
+	changed.Content.Bytes = []byte(`[{"address":"FA3oajkmHMfqkNMMShmqpwDThzMCuVrSsBwiXM2kYFVRz3MzxNAJ","amount":600000000}]`)
+	bad["changed content"] = changed
+
+	for name, e := range bad {
+		if _, err := ParseGrantDescriptor(e, pubs, 2); err == nil {
+			t.Errorf("Expected an error for %s", name)
+		}
+	}
+}
+
+func TestGetGovernanceGrantPayoutsFor(t *testing.T) {
+	defer setLocalNetwork()()
+
+	privs, pubs := governanceTestKeys()
+	s := new(State)
+	s.DB = databaseOverlay.NewOverlay(new(mapdb.MapDB))
+	s.DBStates = new(DBStateList)
+	g := Governance{ChainID: governanceChainID, Threshold: 2}
+	for _, k := range pubs {
+		g.PublicKeys = append(g.PublicKeys, k.String())
+	}
+
+	freq := constants.COINBASE_PAYOUT_FREQUENCY
+	payout := 6*freq + 1
+	paid := []GovernanceGrant{
+		{"FA3oajkmHMfqkNMMShmqpwDThzMCuVrSsBwiXM2kYFVRz3MzxNAJ", 5e8},
+		{"FA3Ga2XcaheS5NgQ3q22gBpLgE6tXmPu1GhjdU2FsdN2QPMzKJET", 7e8},
+	}
+	late := []GovernanceGrant{{"FA3Ga2XcaheS5NgQ3q22gBpLgE6tXmPu1GhjdU2FsdN2QPMzKJET", 1e8}}
+
+	// Entry blocks of the governance chain and the descriptors in them
+	blocks := map[uint32][]*entryBlock.Entry{
+		payout - 2*freq: {
+			newGrantDescriptor(t, payout, paid[:1], privs[0], privs[1]),
+			newGrantDescriptor(t, payout+freq, late, privs[0], privs[1]),
+			newGrantDescriptor(t, payout, late, privs[2]),
+		},
+		payout - freq: {
+			newGrantDescriptor(t, payout, paid[1:], privs[1], privs[2]),
+		},
+		payout - 1: {
+			newGrantDescriptor(t, payout, late, privs[0], privs[1]),
+		},
+	}
+	var seq uint32
+	var unsynced *entryBlock.Entry
+	for _, h := range []uint32{payout - 2*freq, payout - freq, payout - 1} {
+		eb := entryBlock.NewEBlock()
+		eb.Header.SetChainID(blocks[h][0].ChainID)
+		eb.Header.SetDBHeight(h)
+		eb.Header.SetEBSequence(seq)
+		seq++
+		for _, e := range blocks[h] {
+			if err := eb.AddEBEntry(e); err != nil {
+				t.Fatal(err)
+			}
+			if h == payout-freq {
+				unsynced = e
+				continue
+			}
+			if err := s.DB.InsertEntry(e); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := s.DB.ProcessEBlockBatch(eb, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The payout waits for the governance blocks to be saved and their entries synced,
+	// but not for the entries of other chains
+	s.DBStates.Base = payout - freq - 1
+	_, err := s.GetGovernanceGrantPayoutsFor(g, payout)
+	if err == nil {
+		t.Error("Expected an error before the governance blocks are saved")
+	}
+	s.DBStates.Base = payout - 1
+	_, err = s.GetGovernanceGrantPayoutsFor(g, payout)
+	if err == nil {
+		t.Error("Expected an error before the governance entries are synced")
+	}
+	if err := s.DB.InsertEntry(unsynced); err != nil {
+		t.Fatal(err)
+	}
+
+	outputs, err := s.GetGovernanceGrantPayoutsFor(g, payout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected []interfaces.ITransAddress
+	for _, g := range paid {
+		addr := factoid.NewAddress(primitives.ConvertUserStrToAddress(g.Address))
+		expected = append(expected, factoid.NewOutAddress(addr, g.Amount))
+	}
+	if len(outputs) != len(expected) {
+		t.Fatalf("Expected %d payouts but found %d", len(expected), len(outputs))
+	}
+	for i := range expected {
+		if !outputs[i].IsSameAs(expected[i]) {
+			t.Errorf("Expected payout %v but found %v", expected[i], outputs[i])
+		}
+	}
+
+	s.DBStates.Base = payout
+	outputs, err = s.GetGovernanceGrantPayoutsFor(g, payout+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs) != 0 {
+		t.Errorf("Expected no payouts but found %d", len(outputs))
+	}
+}
+
+func TestGetGovernance(t *testing.T) {
+	defer setLocalNetwork()()
+
+	g := GetGovernance()
+	if _, err := primitives.HexToHash(g.ChainID); err != nil {
+		t.Error(err)
+	}
+	keys, err := g.keys()
+	if err != nil {
+		t.Error(err)
+	}
+	if g.Threshold < 1 || g.Threshold > len(keys) {
+		t.Errorf("Threshold %d of %d keys", g.Threshold, len(keys))
+	}
+
+	globals.Params.NetworkName = "MAIN"
+	if GetGovernance().ChainID != "" {
+		t.Error("Expected no governance chain on MAIN")
+	}
+}
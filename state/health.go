@@ -0,0 +1,81 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+var healthCheckKey = []byte("HealthCheck")
+
+// How long the result of a database write check is reused for
+const dbHealthCacheTime = 5 * time.Second
+
+// dbHealth is the last database write check, so health probes, which anyone can make,
+// write to the database at most once every dbHealthCacheTime
+type dbHealth struct {
+	sync.Mutex
+	checked time.Time
+	err     error
+}
+
+func (s *State) GetHealthThresholds() interfaces.HealthThresholds {
+	return s.HealthThresholds
+}
+
+// GetNumberOfPeers returns the number of p2p connections, or -1 if the node is not on a p2p network
+func (s *State) GetNumberOfPeers() int {
+	if s.NetworkController == nil {
+		return -1
+	}
+	return s.NetworkController.GetNumberOfConnections()
+}
+
+// CheckDatabaseWritable returns the result of writing the current time to the key value
+// store and reading it back, checked again if the last check is dbHealthCacheTime old
+func (s *State) CheckDatabaseWritable() error {
+	h := &s.dbHealth
+	h.Lock()
+	defer h.Unlock()
+	if time.Since(h.checked) < dbHealthCacheTime {
+		return h.err
+	}
+	h.err = s.writeDatabaseCheck()
+	h.checked = time.Now()
+	return h.err
+}
+
+// writeDatabaseCheck writes the current time to the key value store and reads it back
+func (s *State) writeDatabaseCheck() error {
+	db := s.GetDB()
+	if db == nil {
+		return fmt.Errorf("No database")
+	}
+
+	buf := primitives.NewBuffer(nil)
+	buf.PushInt64(s.GetCurrentTime())
+	written := new(primitives.ByteSlice)
+	written.Bytes = buf.DeepCopyBytes()
+	err := db.SaveKeyValueStore(written, healthCheckKey)
+	if err != nil {
+		return err
+	}
+
+	read := new(primitives.ByteSlice)
+	_, err = db.FetchKeyValueStore(healthCheckKey, read)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(written.Bytes, read.Bytes) {
+		return fmt.Errorf("Read back %x, wrote %x", read.Bytes, written.Bytes)
+	}
+	return nil
+}
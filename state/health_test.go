@@ -0,0 +1,50 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/database/databaseOverlay"
+	"github.com/FactomProject/factomd/database/mapdb"
+)
+
+func TestCheckDatabaseWritable(t *testing.T) {
+	s := new(State)
+	if err := s.CheckDatabaseWritable(); err == nil {
+		t.Error("Expected an error without a database")
+	}
+
+	// The failure is reused until it is old
+	s.DB = databaseOverlay.NewOverlay(new(mapdb.MapDB))
+	if err := s.CheckDatabaseWritable(); err == nil {
+		t.Error("Expected the last result to be reused")
+	}
+	s.dbHealth.checked = time.Now().Add(-dbHealthCacheTime)
+	if err := s.CheckDatabaseWritable(); err != nil {
+		t.Error(err)
+	}
+
+	// Checks in between do not write
+	written := new(primitives.ByteSlice)
+	if _, err := s.DB.FetchKeyValueStore(healthCheckKey, written); err != nil {
+		t.Fatal(err)
+	}
+	s.DB.SaveKeyValueStore(&primitives.ByteSlice{Bytes: []byte("unchanged")}, healthCheckKey)
+	if err := s.CheckDatabaseWritable(); err != nil {
+		t.Error(err)
+	}
+	read := new(primitives.ByteSlice)
+	if _, err := s.DB.FetchKeyValueStore(healthCheckKey, read); err != nil {
+		t.Fatal(err)
+	}
+	if string(read.Bytes) != "unchanged" {
+		t.Errorf("Expected no write, found %x after writing %x", read.Bytes, written.Bytes)
+	}
+
+	s.dbHealth.err = fmt.Errorf("failed")
+	if err := s.CheckDatabaseWritable(); err == nil {
+		t.Error("Expected the last result to be reused")
+	}
+}
@@ -157,6 +157,11 @@ func (st *State) LoadIdentityByEntry(ent interfaces.IEBEntry, height uint32, dbl
 
 // Called by AddServer Message
 func ProcessIdentityToAdminBlock(st *State, chainID interfaces.IHash, servertype int) bool {
+	return addIdentityToAdminBlock(st, st.LeaderPL.AdminBlock, chainID, servertype)
+}
+
+// addIdentityToAdminBlock adds the server, and the keys of its identity, to the admin block
+func addIdentityToAdminBlock(st *State, ablock interfaces.IAdminBlock, chainID interfaces.IHash, servertype int) bool {
 	flog := identLogger.WithFields(st.Logger.Data).WithField("func", "ProcessIdentityToAdminBlock")
 
 	err := st.AddIdentityFromChainID(chainID)
@@ -185,22 +190,22 @@ func ProcessIdentityToAdminBlock(st *State, chainID interfaces.IHash, servertype
 	if servertype == 0 {
 		id.Status = constants.IDENTITY_PENDING_FEDERATED_SERVER
 		st.LogPrintf("executeMsg", "Add server 2 %x", chainID.Bytes()[3:6])
-		st.LeaderPL.AdminBlock.AddFedServer(chainID)
+		ablock.AddFedServer(chainID)
 	} else if servertype == 1 {
 		id.Status = constants.IDENTITY_PENDING_AUDIT_SERVER
-		st.LeaderPL.AdminBlock.AddAuditServer(chainID)
+		ablock.AddAuditServer(chainID)
 	}
 
 	st.IdentityControl.SetIdentity(chainID, id)
-	st.LeaderPL.AdminBlock.AddFederatedServerSigningKey(chainID, id.SigningKey.Fixed())
-	st.LeaderPL.AdminBlock.AddMatryoshkaHash(chainID, id.MatryoshkaHash)
+	ablock.AddFederatedServerSigningKey(chainID, id.SigningKey.Fixed())
+	ablock.AddMatryoshkaHash(chainID, id.MatryoshkaHash)
 	for _, a := range id.AnchorKeys {
-		st.LeaderPL.AdminBlock.AddFederatedServerBitcoinAnchorKey(chainID, a.KeyLevel, a.KeyType, a.SigningKey)
+		ablock.AddFederatedServerBitcoinAnchorKey(chainID, a.KeyLevel, a.KeyType, a.SigningKey)
 	}
 	if !id.CoinbaseAddress.IsZero() {
-		st.LeaderPL.AdminBlock.AddCoinbaseAddress(chainID, id.CoinbaseAddress)
+		ablock.AddCoinbaseAddress(chainID, id.CoinbaseAddress)
 	}
-	st.LeaderPL.AdminBlock.AddEfficiency(chainID, id.Efficiency)
+	ablock.AddEfficiency(chainID, id.Efficiency)
 
 	st.LogPrintf("executeMsg", "Added server %x", chainID.Bytes()[3:6])
 	return true
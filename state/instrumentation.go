@@ -222,6 +222,37 @@ var (
 		Help: "Tally of total messages executed via FollowerExecuteMissingMsg",
 	})
 
+	// Missing message requests
+	MMRAsksSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_mmr_asks_sent_total",
+		Help: "Tally of process list slots we asked the network for",
+	})
+	MMRReAsks = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_mmr_reasks_total",
+		Help: "Tally of asks repeated because the slot was still not filled",
+	})
+	MMRAnswers = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_mmr_answers_total",
+		Help: "Tally of missing message responses for slots we were waiting on",
+	})
+	MMRDuplicateAnswers = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_state_mmr_duplicate_answers_total",
+		Help: "Tally of missing message responses for slots already answered or filled",
+	})
+	MMRTimeToFill = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "factomd_state_mmr_time_to_fill_seconds",
+		Help:    "Time from first asking for a process list slot until it was filled",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+	})
+	MMRAskDelay = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "factomd_state_mmr_ask_delay_seconds",
+		Help: "Current delay before an unanswered ask is repeated",
+	})
+	MMRPending = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "factomd_state_mmr_pending_asks",
+		Help: "Number of process list slots asked for and not yet filled",
+	})
+
 	// ProcessList
 	TotalProcessListInputs = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "factomd_state_process_list_inputs",
@@ -261,6 +292,60 @@ var (
 		Name: "factomd_state_execute_msg_time",
 		Help: "Time spent in executeMsg",
 	})
+
+	// Business, counted as each block is saved.  Factoids are in factoshis.
+	BusinessBlocks = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_blocks_total",
+		Help: "Blocks counted in the business metrics",
+	})
+	BusinessDBHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "factomd_business_dbheight",
+		Help: "Height of the last block counted in the business metrics",
+	})
+	BusinessNewChains = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_new_chains_total",
+		Help: "Chains created",
+	})
+	BusinessEntries = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_entries_total",
+		Help: "Entries written, including the first entries of new chains",
+	})
+	BusinessEntryBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_entry_bytes_total",
+		Help: "Bytes of the entries written that the node had when it saved their block",
+	})
+	BusinessECSpent = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_ec_spent_total",
+		Help: "Entry credits spent on chain and entry commits",
+	})
+	BusinessECPurchased = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_ec_purchased_total",
+		Help: "Entry credits purchased with factoids",
+	})
+	BusinessFCTTransferred = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_fct_transferred_total",
+		Help: "Factoshis spent by transactions, not counting the coinbase",
+	})
+	BusinessFees = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_fees_total",
+		Help: "Factoshis of transaction fees collected",
+	})
+	BusinessActiveAddresses = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "factomd_business_active_addresses",
+		Help: "Distinct factoid and entry credit addresses in the transactions of the last block",
+	})
+	BusinessCoinbasePaid = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_coinbase_paid_total",
+		Help: "Factoshis paid to the authority servers by coinbase transactions",
+	})
+	BusinessGrantsPaid = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "factomd_business_grants_paid_total",
+		Help: "Factoshis paid as grants by coinbase transactions",
+	})
+	BusinessTopChainEntries = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "factomd_business_top_chain_entries",
+		Help: "Entries in the last block of the chains with the most, if MetricsTopChains is set",
+	}, []string{"chain"})
 )
 
 var registered bool = false
@@ -332,6 +417,15 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(FollowerEOMExecutions)
 	prometheus.MustRegister(FollowerMissingMsgExecutions)
 
+	// Missing message requests
+	prometheus.MustRegister(MMRAsksSent)
+	prometheus.MustRegister(MMRReAsks)
+	prometheus.MustRegister(MMRAnswers)
+	prometheus.MustRegister(MMRDuplicateAnswers)
+	prometheus.MustRegister(MMRTimeToFill)
+	prometheus.MustRegister(MMRAskDelay)
+	prometheus.MustRegister(MMRPending)
+
 	// ProcessList
 	prometheus.MustRegister(TotalProcessListInputs)
 	prometheus.MustRegister(TotalProcessListProcesses)
@@ -352,4 +446,19 @@ func RegisterPrometheus() {
 	prometheus.MustRegister(TotalEmptyLoopTime)
 	prometheus.MustRegister(TotalAckLoopTime)
 	prometheus.MustRegister(TotalExecuteMsgTime)
+
+	// Business
+	prometheus.MustRegister(BusinessBlocks)
+	prometheus.MustRegister(BusinessDBHeight)
+	prometheus.MustRegister(BusinessNewChains)
+	prometheus.MustRegister(BusinessEntries)
+	prometheus.MustRegister(BusinessEntryBytes)
+	prometheus.MustRegister(BusinessECSpent)
+	prometheus.MustRegister(BusinessECPurchased)
+	prometheus.MustRegister(BusinessFCTTransferred)
+	prometheus.MustRegister(BusinessFees)
+	prometheus.MustRegister(BusinessActiveAddresses)
+	prometheus.MustRegister(BusinessCoinbasePaid)
+	prometheus.MustRegister(BusinessGrantsPaid)
+	prometheus.MustRegister(BusinessTopChainEntries)
 }
@@ -0,0 +1,270 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	mmrMinAskDelay     = 500 * time.Millisecond // Never re-ask faster than this, that is just too much
+	mmrLatencyMultiple = 4                      // Re-ask after this many average response times
+)
+
+// MMRPeer tracks how a peer has answered our missing message requests
+type MMRPeer struct {
+	Origin        int    // Index of the peer (or p2p proxy) in the node's peer list
+	NetworkOrigin string // p2p peer hash, empty in simulations
+	Asks          int
+	Answers       int
+	Duplicates    int     // Answers for slots that were already filled
+	Latency       float64 // Average response time in milliseconds
+	LastAnswer    time.Time
+}
+
+func (p *MMRPeer) key() string {
+	return peerKey(p.Origin, p.NetworkOrigin)
+}
+
+// score ranks peers by how likely and how fast they answer, smoothed like EntrySyncPeer
+func (p *MMRPeer) score() float64 {
+	success := float64(p.Answers+1) / float64(p.Asks+2)
+	latency := p.Latency
+	if latency <= 0 {
+		latency = defaultLatency
+	}
+	return success / latency
+}
+
+type mmrAsk struct {
+	first    time.Time       // when we first asked the network for this slot
+	sent     time.Time       // when we last asked
+	peer     string          // key of the peer last asked, empty if it went to a random peer
+	tried    map[string]bool // peers already asked for this slot
+	answered bool
+}
+
+// MMRTracker follows our missing message requests so they can be aimed at the peers
+// that answer, and re-asked at a pace that matches the network rather than a fixed delay.
+// The MMR goroutine asks and fills, responses are reported from the consensus goroutine.
+type MMRTracker struct {
+	mutex   sync.Mutex
+	peers   map[string]*MMRPeer
+	asks    map[plRef]*mmrAsk
+	filled  map[plRef]bool // Slots we asked for that have since been filled, to spot duplicates
+	latency float64        // Average response time across all peers in milliseconds
+}
+
+func NewMMRTracker() *MMRTracker {
+	t := new(MMRTracker)
+	t.peers = make(map[string]*MMRPeer)
+	t.asks = make(map[plRef]*mmrAsk)
+	t.filled = make(map[plRef]bool)
+	return t
+}
+
+// best returns the best peer not in exclude, or nil if there is none
+func (t *MMRTracker) best(exclude map[string]bool) *MMRPeer {
+	var best *MMRPeer
+	for k, p := range t.peers {
+		if exclude[k] {
+			continue
+		}
+		if best == nil || p.score() > best.score() {
+			best = p
+		}
+	}
+	return best
+}
+
+// Asked records a request for the given slots, which go out in one message, and returns the
+// peer to send it to.  ok is false if it should go to a random peer.
+func (t *MMRTracker) Asked(refs []plRef) (origin int, networkOrigin string, ok bool) {
+	if t == nil {
+		return 0, "", false
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	exclude := make(map[string]bool)
+	for _, ref := range refs {
+		ask, ok := t.asks[ref]
+		if !ok {
+			ask = &mmrAsk{first: now, tried: make(map[string]bool)}
+			t.asks[ref] = ask
+		}
+		for k := range ask.tried {
+			exclude[k] = true
+		}
+	}
+
+	// Now and then ask a random peer so we keep learning about the network
+	var peer *MMRPeer
+	if rand.Intn(exploreOneIn) != 0 {
+		peer = t.best(exclude)
+		if peer == nil {
+			// Everyone we know of has had a go, start over with the best of them
+			peer = t.best(nil)
+		}
+	}
+	key := ""
+	if peer != nil {
+		peer.Asks++
+		key = peer.key()
+	}
+	for _, ref := range refs {
+		ask := t.asks[ref]
+		if !ask.sent.IsZero() {
+			MMRReAsks.Inc()
+		}
+		ask.answered = false
+		ask.sent = now
+		ask.peer = key
+		if key != "" {
+			ask.tried[key] = true
+		}
+	}
+	MMRAsksSent.Add(float64(len(refs)))
+	if peer == nil {
+		return 0, "", false
+	}
+	return peer.Origin, peer.NetworkOrigin, true
+}
+
+// Responded records a peer answering for a process list slot
+func (t *MMRTracker) Responded(origin int, networkOrigin string, ref plRef) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := peerKey(origin, networkOrigin)
+	peer, ok := t.peers[key]
+	if !ok {
+		peer = &MMRPeer{Origin: origin, NetworkOrigin: networkOrigin}
+		t.peers[key] = peer
+	}
+	now := time.Now()
+	peer.LastAnswer = now
+
+	ask, ok := t.asks[ref]
+	if !ok || ask.answered {
+		if ok || t.filled[ref] {
+			peer.Duplicates++
+			MMRDuplicateAnswers.Inc()
+		}
+		return // unsolicited, or already answered
+	}
+	ask.answered = true
+	MMRAnswers.Inc()
+
+	if ask.peer == "" {
+		peer.Asks++ // it went out to a random peer, which turned out to be this one
+	} else if ask.peer != key {
+		return // someone else's answer, don't credit the latency to the wrong peer
+	}
+	peer.Answers++
+	ms := float64(now.Sub(ask.sent)) / float64(time.Millisecond)
+	if peer.Latency == 0 {
+		peer.Latency = ms
+	} else {
+		peer.Latency = (1-latencyWeight)*peer.Latency + latencyWeight*ms
+	}
+	if t.latency == 0 {
+		t.latency = ms
+	} else {
+		t.latency = (1-latencyWeight)*t.latency + latencyWeight*ms
+	}
+}
+
+// Filled records a slot being added to the process list, however it got there
+func (t *MMRTracker) Filled(ref plRef) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	ask, ok := t.asks[ref]
+	if !ok {
+		return // we never asked for it
+	}
+	MMRTimeToFill.Observe(time.Since(ask.first).Seconds())
+	delete(t.asks, ref)
+	t.filled[ref] = true
+}
+
+// Expire drops everything we know about slots below dbheight
+func (t *MMRTracker) Expire(dbheight int) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for ref := range t.asks {
+		if ref.DBH < dbheight {
+			delete(t.asks, ref)
+		}
+	}
+	for ref := range t.filled {
+		if ref.DBH < dbheight {
+			delete(t.filled, ref)
+		}
+	}
+}
+
+// AskDelay is how long to wait for an answer before asking again.  Until we have heard
+// back from the network we use max, after that a few times the observed response time.
+func (t *MMRTracker) AskDelay(max time.Duration) time.Duration {
+	if t == nil {
+		return max
+	}
+	t.mutex.Lock()
+	latency := t.latency
+	t.mutex.Unlock()
+
+	if latency == 0 {
+		return max
+	}
+	d := mmrLatencyMultiple * time.Duration(latency*float64(time.Millisecond))
+	if d < mmrMinAskDelay {
+		d = mmrMinAskDelay
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+// Pending returns the number of slots we have asked for and not yet filled
+func (t *MMRTracker) Pending() int {
+	if t == nil {
+		return 0
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return len(t.asks)
+}
+
+// Peers returns a copy of the peer statistics, best peers first
+func (t *MMRTracker) Peers() []MMRPeer {
+	if t == nil {
+		return nil
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	peers := make([]MMRPeer, 0, len(t.peers))
+	for _, p := range t.peers {
+		peers = append(peers, *p)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].score() > peers[j].score() })
+	return peers
+}
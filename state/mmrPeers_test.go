@@ -0,0 +1,83 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMMRTrackerAnswers(t *testing.T) {
+	tr := NewMMRTracker()
+	ref := plRef{10, 1, 5}
+
+	tr.Asked([]plRef{ref})
+	if tr.Pending() != 1 {
+		t.Errorf("Expected 1 pending ask, found %d", tr.Pending())
+	}
+	tr.Responded(1, "", ref)
+	tr.Responded(2, "", ref) // a second answer for the same slot
+	tr.Filled(ref)
+	tr.Responded(3, "", ref) // an answer after the slot was filled
+	if tr.Pending() != 0 {
+		t.Errorf("Expected no pending asks, found %d", tr.Pending())
+	}
+
+	for _, p := range tr.Peers() {
+		switch p.Origin {
+		case 1:
+			if p.Answers != 1 || p.Duplicates != 0 {
+				t.Errorf("Unexpected stats for the first peer %+v", p)
+			}
+		case 2, 3:
+			if p.Answers != 0 || p.Duplicates != 1 {
+				t.Errorf("Unexpected stats for a duplicate peer %+v", p)
+			}
+		}
+	}
+
+	tr.Expire(11)
+	tr.Responded(4, "", ref)
+	for _, p := range tr.Peers() {
+		if p.Origin == 4 && p.Duplicates != 0 {
+			t.Error("Expired slots should not count as duplicates")
+		}
+	}
+}
+
+func TestMMRTrackerTargetsPeers(t *testing.T) {
+	tr := NewMMRTracker()
+	ref := plRef{10, 0, 0}
+	tr.Asked([]plRef{ref})
+	tr.Responded(7, "peer", ref)
+
+	targeted := 0
+	for i := 1; i <= 100; i++ {
+		if origin, networkOrigin, ok := tr.Asked([]plRef{{10, 0, i}}); ok {
+			if origin != 7 || networkOrigin != "peer" {
+				t.Fatalf("Expected the only known peer, got %d/%s", origin, networkOrigin)
+			}
+			targeted++
+		}
+	}
+	if targeted < 70 {
+		t.Errorf("Expected most asks to be targeted, only %d were", targeted)
+	}
+}
+
+func TestMMRTrackerAskDelay(t *testing.T) {
+	tr := NewMMRTracker()
+	max := 10 * time.Second
+	if tr.AskDelay(max) != max {
+		t.Error("Expected the maximum delay before any answers")
+	}
+
+	ref := plRef{1, 0, 0}
+	tr.Asked([]plRef{ref})
+	tr.Responded(1, "", ref)
+	if d := tr.AskDelay(max); d != mmrMinAskDelay {
+		t.Errorf("Expected a fast network to hit the minimum delay, got %s", d)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/common/tracing"
 	"github.com/FactomProject/factomd/util/atomic"
 
 	//"github.com/FactomProject/factomd/database/databaseOverlay"
@@ -847,6 +848,7 @@ func (p *ProcessList) processVM(vm *VM) (progress bool) {
 			vm.heartBeat = 0
 			vm.Height = j + 1 // Don't process it again if the process worked.
 			s.LogMessage("process", fmt.Sprintf("done %v/%v/%v", p.DBHeight, i, j), msg)
+			tracing.Stage(s.FactomNodeName, msg, tracing.StageBlock, tracing.Attribute{Key: "factomd.dbheight", Value: fmt.Sprint(p.DBHeight)})
 			//s.LogPrintf("process", "thisAck  %x", thisAck.SerialHash.Bytes())
 
 			progress = true
@@ -1086,6 +1088,8 @@ func (p *ProcessList) AddToProcessList(s *State, ack *messages.Ack, m interfaces
 	delete(s.Acks, msgHash.Fixed())
 	p.VMs[ack.VMIndex].List[ack.Height] = m
 	p.VMs[ack.VMIndex].ListAck[ack.Height] = ack
+	tracing.Stage(s.FactomNodeName, m, tracing.StageProcessList, tracing.Attribute{Key: "factomd.plref", Value: fmt.Sprintf("%d/%d/%d", ack.DBHeight, ack.VMIndex, ack.Height)})
+	s.ProcessListHistory.Added(ack, m)
 	p.AddOldMsgs(m)
 	p.OldAcks[msgHash.Fixed()] = ack
 
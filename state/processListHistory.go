@@ -0,0 +1,399 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
+)
+
+// Number of completed blocks kept by the process list history if not configured
+const DefaultProcessListHistoryLimit = 100
+
+// ProcessListHistory keeps what happened in the process lists of the last few blocks
+// after the process lists themselves are discarded, so stalls can be investigated
+// without runtime logs.  All times are unix milliseconds.  If given a file, the history
+// is saved to it in the background as blocks complete, and survives restarts.
+type ProcessListHistory struct {
+	mutex   sync.Mutex
+	limit   int
+	file    string                        // Where the history is saved, if anywhere
+	saves   chan struct{}                 // Asks the saver to write the latest history
+	blocks  []*ProcessListRecord          // Completed blocks, oldest first
+	pending map[uint32]*ProcessListRecord // Blocks still under construction
+}
+
+type ProcessListRecord struct {
+	DBHeight     uint32            `json:"dbheight"`
+	Complete     bool              `json:"complete"`
+	FedServers   []string          `json:"fedservers"`
+	AuditServers []string          `json:"auditservers"`
+	VMs          []*VMRecord       `json:"vms"`
+	EOMs         []*ServerArrival  `json:"eoms"`
+	DBSigs       []*ServerArrival  `json:"dbsigs"`
+	Faults       []*FaultRecord    `json:"faults"`
+	Elections    []*ElectionRecord `json:"elections"`
+
+	vmsByIndex map[int]*VMRecord          // VMs by VM index
+	elections  map[[2]int]*ElectionRecord // Elections by minute and VM index
+}
+
+// copy returns a snapshot of the record that is safe to use outside the history's lock
+func (r *ProcessListRecord) copy() *ProcessListRecord {
+	c := *r
+	c.vmsByIndex = nil
+	c.elections = nil
+	c.FedServers = append([]string{}, r.FedServers...)
+	c.AuditServers = append([]string{}, r.AuditServers...)
+	c.VMs = nil
+	for _, vm := range r.VMs {
+		c.VMs = append(c.VMs, &VMRecord{VMIndex: vm.VMIndex, Messages: append([]*PLMessageRecord{}, vm.Messages...)})
+	}
+	c.EOMs = append([]*ServerArrival{}, r.EOMs...)
+	c.DBSigs = append([]*ServerArrival{}, r.DBSigs...)
+	c.Faults = append([]*FaultRecord{}, r.Faults...)
+	c.Elections = nil
+	for _, e := range r.Elections {
+		ec := *e
+		c.Elections = append(c.Elections, &ec)
+	}
+	return &c
+}
+
+type VMRecord struct {
+	VMIndex  int                `json:"vmindex"`
+	Messages []*PLMessageRecord `json:"messages"`
+}
+
+type PLMessageRecord struct {
+	Height       uint32 `json:"height"`
+	Type         string `json:"type"`
+	Hash         string `json:"hash"`
+	Minute       int    `json:"minute"`
+	AckTimestamp int64  `json:"acktimestamp"` // Leader's timestamp on the ack
+	Added        int64  `json:"added"`        // When we added it to our process list
+}
+
+// ServerArrival records when a server's EOM or DBSig made it into our process list
+type ServerArrival struct {
+	ServerID     string `json:"serverid"`
+	VMIndex      int    `json:"vmindex"`
+	Minute       int    `json:"minute"`
+	AckTimestamp int64  `json:"acktimestamp"`
+	Added        int64  `json:"added"`
+}
+
+type FaultRecord struct {
+	ServerID string `json:"serverid"`
+	VMIndex  int    `json:"vmindex"`
+	Minute   int    `json:"minute"`
+	Reason   int    `json:"reason"` // 0 = EOM missing, 1 = negotiation issue
+	Time     int64  `json:"time"`
+}
+
+type ElectionRecord struct {
+	VMIndex  int    `json:"vmindex"`
+	Minute   int    `json:"minute"`
+	SigType  string `json:"sigtype"` // EOM or DBSig
+	Started  int64  `json:"started"`
+	Finished int64  `json:"finished,omitempty"`
+	Demoted  string `json:"demoted,omitempty"`
+	Promoted string `json:"promoted,omitempty"`
+}
+
+func NewProcessListHistory(limit int) *ProcessListHistory {
+	if limit <= 0 {
+		limit = DefaultProcessListHistoryLimit
+	}
+	h := new(ProcessListHistory)
+	h.limit = limit
+	h.pending = make(map[uint32]*ProcessListRecord)
+	return h
+}
+
+func nowMilli() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// record returns the record for a block under construction, creating it if need be
+func (h *ProcessListHistory) record(dbheight uint32) *ProcessListRecord {
+	r, ok := h.pending[dbheight]
+	if !ok {
+		r = &ProcessListRecord{DBHeight: dbheight}
+		r.vmsByIndex = make(map[int]*VMRecord)
+		r.elections = make(map[[2]int]*ElectionRecord)
+		h.pending[dbheight] = r
+	}
+	return r
+}
+
+// Added records a message and its ack being added to a process list
+func (h *ProcessListHistory) Added(ack *messages.Ack, m interfaces.IMsg) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	r := h.record(ack.DBHeight)
+	vm, ok := r.vmsByIndex[ack.VMIndex]
+	if !ok {
+		vm = &VMRecord{VMIndex: ack.VMIndex}
+		r.vmsByIndex[ack.VMIndex] = vm
+		r.VMs = append(r.VMs, vm)
+	}
+	now := nowMilli()
+	var ackTime int64
+	if ack.Timestamp != nil {
+		ackTime = ack.Timestamp.GetTimeMilli()
+	}
+	vm.Messages = append(vm.Messages, &PLMessageRecord{
+		Height:       ack.Height,
+		Type:         constants.MessageName(m.Type()),
+		Hash:         m.GetMsgHash().String(),
+		Minute:       int(ack.Minute),
+		AckTimestamp: ackTime,
+		Added:        now,
+	})
+
+	switch msg := m.(type) {
+	case *messages.EOM:
+		r.EOMs = append(r.EOMs, &ServerArrival{
+			ServerID:     msg.ChainID.String(),
+			VMIndex:      ack.VMIndex,
+			Minute:       int(msg.Minute),
+			AckTimestamp: ackTime,
+			Added:        now,
+		})
+	case *messages.DirectoryBlockSignature:
+		r.DBSigs = append(r.DBSigs, &ServerArrival{
+			ServerID:     msg.ServerIdentityChainID.String(),
+			VMIndex:      ack.VMIndex,
+			Minute:       int(ack.Minute),
+			AckTimestamp: ackTime,
+			Added:        now,
+		})
+	}
+}
+
+// Fault records a VM being marked faulted
+func (h *ProcessListHistory) Fault(dbheight uint32, minute int, vmIndex int, serverID interfaces.IHash, reason int) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	f := &FaultRecord{VMIndex: vmIndex, Minute: minute, Reason: reason, Time: nowMilli()}
+	if serverID != nil {
+		f.ServerID = serverID.String()
+	}
+	r := h.record(dbheight)
+	r.Faults = append(r.Faults, f)
+}
+
+func sigTypeName(sigType bool) string {
+	if sigType {
+		return "EOM"
+	}
+	return "DBSig"
+}
+
+// ElectionStarted records an election starting to replace the leader of a VM
+func (h *ProcessListHistory) ElectionStarted(dbheight uint32, minute int, vmIndex int, sigType bool) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	r := h.record(dbheight)
+	key := [2]int{minute, vmIndex}
+	if _, ok := r.elections[key]; ok {
+		return
+	}
+	e := &ElectionRecord{VMIndex: vmIndex, Minute: minute, SigType: sigTypeName(sigType), Started: nowMilli()}
+	r.elections[key] = e
+	r.Elections = append(r.Elections, e)
+}
+
+// ElectionFinished records the outcome of an election
+func (h *ProcessListHistory) ElectionFinished(dbheight uint32, minute int, vmIndex int, sigType bool, demoted interfaces.IHash, promoted interfaces.IHash) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	r := h.record(dbheight)
+	key := [2]int{minute, vmIndex}
+	e, ok := r.elections[key]
+	if !ok {
+		e = &ElectionRecord{VMIndex: vmIndex, Minute: minute, SigType: sigTypeName(sigType)}
+		r.elections[key] = e
+		r.Elections = append(r.Elections, e)
+	}
+	e.Finished = nowMilli()
+	if demoted != nil {
+		e.Demoted = demoted.String()
+	}
+	if promoted != nil {
+		e.Promoted = promoted.String()
+	}
+}
+
+// ProcessListHistoryFilename is the file a node's history is saved in
+func ProcessListHistoryFilename(networkName string, nodeName string, fileLocation string) string {
+	return filepath.Join(fileLocation, fmt.Sprintf("ProcessListHistory_%s_%s.json", networkName, nodeName))
+}
+
+// Persist saves the history to a file from now on, after loading the completed blocks
+// already saved in it
+func (h *ProcessListHistory) Persist(file string) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.file = file
+	if h.saves == nil {
+		h.saves = make(chan struct{}, 1)
+		go h.saver()
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var saved []*ProcessListRecord
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return err
+	}
+	h.blocks = nil
+	for _, r := range saved {
+		if r.Complete {
+			h.blocks = append(h.blocks, r)
+		}
+	}
+	if len(h.blocks) > h.limit {
+		h.blocks = h.blocks[len(h.blocks)-h.limit:]
+	}
+	return nil
+}
+
+// saver writes the history each time it is asked to.  Blocks completed while a save
+// is under way are all written by the next one.
+func (h *ProcessListHistory) saver() {
+	for range h.saves {
+		h.save()
+	}
+}
+
+// save writes the history to its file
+func (h *ProcessListHistory) save() {
+	h.mutex.Lock()
+	file := h.file
+	h.mutex.Unlock()
+
+	var buf bytes.Buffer
+	err := h.ExportJSON(&buf)
+	if err == nil {
+		err = WriteFileAtomic(file, buf.Bytes(), 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Saving the process list history to %s failed: %v\n", file, err)
+	}
+}
+
+// Complete moves the record of a finished process list into the history
+func (h *ProcessListHistory) Complete(pl *ProcessList) {
+	if h == nil || pl == nil {
+		return
+	}
+	if h.complete(pl) {
+		select {
+		case h.saves <- struct{}{}:
+		default: // a save is already pending, and will include this block
+		}
+	}
+}
+
+// complete moves the record into the history, and returns whether it should be saved
+func (h *ProcessListHistory) complete(pl *ProcessList) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	r := h.record(pl.DBHeight)
+	delete(h.pending, pl.DBHeight)
+	r.Complete = true
+	for _, s := range pl.FedServers {
+		r.FedServers = append(r.FedServers, s.GetChainID().String())
+	}
+	for _, s := range pl.AuditServers {
+		r.AuditServers = append(r.AuditServers, s.GetChainID().String())
+	}
+
+	h.blocks = append(h.blocks, r)
+	if len(h.blocks) > h.limit {
+		h.blocks = append(h.blocks[:0], h.blocks[len(h.blocks)-h.limit:]...)
+	}
+	// Drop anything left pending from blocks we will never complete
+	for ht := range h.pending {
+		if ht < pl.DBHeight {
+			delete(h.pending, ht)
+		}
+	}
+	return h.file != ""
+}
+
+// Get returns up to count records ending at dbheight, oldest first.  A dbheight
+// of 0 means the most recent blocks, including those still under construction.
+func (h *ProcessListHistory) Get(dbheight uint32, count int) []*ProcessListRecord {
+	if h == nil {
+		return nil
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var all []*ProcessListRecord
+	all = append(all, h.blocks...)
+	if dbheight == 0 {
+		for _, r := range h.pending {
+			all = append(all, r)
+		}
+	}
+
+	var list []*ProcessListRecord
+	for _, r := range all {
+		if dbheight == 0 || r.DBHeight <= dbheight {
+			list = append(list, r)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].DBHeight < list[j].DBHeight })
+	if count > 0 && len(list) > count {
+		list = list[len(list)-count:]
+	}
+	for i, r := range list {
+		list[i] = r.copy()
+	}
+	return list
+}
+
+// ExportJSON writes the whole history as JSON
+func (h *ProcessListHistory) ExportJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(h.Get(0, 0))
+}
@@ -0,0 +1,139 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factomd/common/messages"
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func historyEOM(dbheight uint32, minute byte, vm int, height uint32) (*messages.Ack, *messages.EOM) {
+	eom := new(messages.EOM)
+	eom.Timestamp = primitives.NewTimestampNow()
+	eom.ChainID = primitives.RandomHash()
+	eom.DBHeight = dbheight
+	eom.Minute = minute
+
+	ack := new(messages.Ack)
+	ack.Timestamp = primitives.NewTimestampNow()
+	ack.DBHeight = dbheight
+	ack.VMIndex = vm
+	ack.Minute = minute
+	ack.Height = height
+	return ack, eom
+}
+
+func TestProcessListHistory(t *testing.T) {
+	h := NewProcessListHistory(2)
+
+	for ht := uint32(1); ht <= 3; ht++ {
+		ack, eom := historyEOM(ht, 7, 1, 0)
+		h.Added(ack, eom)
+		h.Fault(ht, 7, 0, primitives.RandomHash(), 0)
+		h.ElectionStarted(ht, 7, 0, true)
+		h.ElectionFinished(ht, 7, 0, true, primitives.RandomHash(), primitives.RandomHash())
+	}
+	for ht := uint32(1); ht <= 2; ht++ {
+		pl := new(ProcessList)
+		pl.DBHeight = ht
+		h.Complete(pl)
+	}
+
+	// Block 3 is still under construction
+	list := h.Get(0, 0)
+	if len(list) != 3 {
+		t.Fatalf("Expected 3 blocks, found %d", len(list))
+	}
+	if !list[0].Complete || list[2].Complete {
+		t.Error("Only the completed blocks should be marked complete")
+	}
+	r := list[1]
+	if r.DBHeight != 2 || len(r.VMs) != 1 || len(r.VMs[0].Messages) != 1 || len(r.EOMs) != 1 {
+		t.Errorf("Unexpected record %+v", r)
+	}
+	if r.EOMs[0].Minute != 7 || r.EOMs[0].VMIndex != 1 {
+		t.Errorf("Unexpected EOM arrival %+v", r.EOMs[0])
+	}
+	if len(r.Faults) != 1 || len(r.Elections) != 1 || r.Elections[0].Finished == 0 || r.Elections[0].Promoted == "" {
+		t.Errorf("Unexpected faults %v or elections %v", r.Faults, r.Elections)
+	}
+
+	// The limit only applies to completed blocks
+	pl := new(ProcessList)
+	pl.DBHeight = 3
+	h.Complete(pl)
+	list = h.Get(0, 0)
+	if len(list) != 2 || list[0].DBHeight != 2 || list[1].DBHeight != 3 {
+		t.Errorf("Expected blocks 2 and 3, found %d", len(list))
+	}
+
+	list = h.Get(2, 1)
+	if len(list) != 1 || list[0].DBHeight != 2 {
+		t.Errorf("Expected block 2, found %d blocks", len(list))
+	}
+
+	var buf bytes.Buffer
+	if err := h.ExportJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var exported []*ProcessListRecord
+	if err := json.Unmarshal(buf.Bytes(), &exported); err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != 2 {
+		t.Errorf("Expected 2 exported blocks, found %d", len(exported))
+	}
+}
+
+func TestProcessListHistoryPersists(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plhistory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := ProcessListHistoryFilename("UNIT", "FNode0", dir)
+
+	h := NewProcessListHistory(10)
+	if err := h.Persist(file); err != nil {
+		t.Fatal(err)
+	}
+	for ht := uint32(1); ht <= 3; ht++ {
+		ack, eom := historyEOM(ht, 7, 1, 0)
+		h.Added(ack, eom)
+		h.Fault(ht, 7, 0, primitives.RandomHash(), 0)
+		pl := new(ProcessList)
+		pl.DBHeight = ht
+		h.Complete(pl)
+	}
+	ack, eom := historyEOM(4, 1, 1, 0)
+	h.Added(ack, eom) // still under construction, so not restored
+
+	// Saved in the background, so wait for the last block to be written
+	var list []*ProcessListRecord
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		restarted := NewProcessListHistory(2)
+		if err := restarted.Persist(file); err != nil {
+			t.Fatal(err)
+		}
+		list = restarted.Get(0, 0)
+		if len(list) == 2 && list[1].DBHeight == 3 {
+			break
+		}
+	}
+	if len(list) != 2 || list[0].DBHeight != 2 || list[1].DBHeight != 3 {
+		t.Fatalf("Expected blocks 2 and 3 after the restart, found %d", len(list))
+	}
+	if len(list[1].Faults) != 1 || len(list[1].EOMs) != 1 {
+		t.Errorf("Restored block lost its faults %v or EOMs %v", list[1].Faults, list[1].EOMs)
+	}
+}
@@ -37,6 +37,9 @@ func (lists *ProcessLists) UpdateState(dbheight uint32) (progress bool) {
 		diff = diff - 1
 		progress = true
 		lists.DBHeightBase += uint32(diff)
+		for _, pl := range lists.Lists[:diff] {
+			lists.State.ProcessListHistory.Complete(pl)
+		}
 
 		newlist := append([]*ProcessList{}, lists.Lists[diff:]...)
 		lists.Lists = newlist
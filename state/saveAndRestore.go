@@ -182,6 +182,10 @@ func (a *SaveState) IsSameAs(b *SaveState) bool {
 		return false
 	}
 
+	if !a.IdentityControl.ChangeManager.IsSameAs(b.IdentityControl.ChangeManager) {
+		return false
+	}
+
 	if a.AuthorityServerCount != b.AuthorityServerCount {
 		return false
 	}
@@ -783,6 +787,11 @@ func (ss *SaveState) MarshalBinary() (rval []byte, err error) {
 		return nil, err
 	}
 
+	err = buf.PushBinaryMarshallable(ss.IdentityControl.ChangeManager)
+	if err != nil {
+		return nil, err
+	}
+
 	err = buf.PushVarInt(uint64(ss.AuthorityServerCount))
 	if err != nil {
 		return nil, err
@@ -1027,6 +1036,11 @@ func (ss *SaveState) UnmarshalBinaryData(p []byte) (newData []byte, err error) {
 		return
 	}
 
+	err = buf.PopBinaryMarshallable(ss.IdentityControl.ChangeManager)
+	if err != nil {
+		return
+	}
+
 	l, err = buf.PopVarInt()
 	if err != nil {
 		return
@@ -0,0 +1,405 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// SaveStateReport is a flattened, printable view of every section of a SaveState.
+// It is used to inspect fastboot files and to diff them against each other or
+// against a running node.
+type SaveStateReport struct {
+	DBHeight      uint32 `json:"dbheight"`
+	LLeaderHeight uint32 `json:"leaderheight"`
+
+	Balances     SaveStateBalances      `json:"balances"`
+	Servers      SaveStateServers       `json:"servers"`
+	Authorities  []SaveStateIdentity    `json:"authorities"`
+	Identities   []SaveStateIdentity    `json:"identities"`
+	ReplayFilter SaveStateReplayFilter  `json:"replayfilter"`
+	FER          SaveStateFER           `json:"fer"`
+	Holding      []SaveStateMessage     `json:"holding"`
+	Acks         []SaveStateMessage     `json:"acks"`
+	XReview      []SaveStateMessage     `json:"xreview"`
+	Consensus    map[string]interface{} `json:"consensus"`
+	EntrySync    map[string]interface{} `json:"entrysync"`
+}
+
+type SaveStateBalances struct {
+	FCTHash string           `json:"fcthash"`
+	ECHash  string           `json:"echash"`
+	FCT     map[string]int64 `json:"fct"`
+	EC      map[string]int64 `json:"ec"`
+}
+
+type SaveStateServers struct {
+	AuthorityServerCount int      `json:"authorityservercount"`
+	Federated            []string `json:"federated"`
+	Audit                []string `json:"audit"`
+}
+
+type SaveStateIdentity struct {
+	ChainID         string `json:"chainid"`
+	Status          string `json:"status"`
+	SigningKey      string `json:"signingkey"`
+	Efficiency      uint16 `json:"efficiency"`
+	CoinbaseAddress string `json:"coinbaseaddress"`
+	AnchorKeys      int    `json:"anchorkeys"`
+}
+
+// SaveStateReplayFilter summarizes the duplicate filtering state. The replay filter
+// itself is not part of a fastboot file, it is rebuilt from the database at boot.
+type SaveStateReplayFilter struct {
+	Commits         int    `json:"commits"`
+	InvalidMessages int    `json:"invalidmessages"`
+	Note            string `json:"note"`
+}
+
+type SaveStateFER struct {
+	FactoshisPerEC                 uint64 `json:"factoshisperec"`
+	FERChainId                     string `json:"ferchainid"`
+	ExchangeRateAuthorityPublicKey string `json:"exchangerateauthoritypublickey"`
+	FERChangeHeight                uint32 `json:"ferchangeheight"`
+	FERChangePrice                 uint64 `json:"ferchangeprice"`
+	FERPriority                    uint32 `json:"ferpriority"`
+	FERPrioritySetHeight           uint32 `json:"ferprioritysetheight"`
+}
+
+type SaveStateMessage struct {
+	Hash string `json:"hash"`
+	Type string `json:"type"`
+	Msg  string `json:"msg"`
+}
+
+// SaveStateDifference is one value that differs between two reports
+type SaveStateDifference struct {
+	Section string `json:"section"`
+	Key     string `json:"key"`
+	A       string `json:"a"`
+	B       string `json:"b"`
+}
+
+func (d SaveStateDifference) String() string {
+	return fmt.Sprintf("%-12s %-66s %s != %s", d.Section, d.Key, d.A, d.B)
+}
+
+// LatestSaveState returns the newest SaveState held by a DBStateList, which is
+// the one a node restores from when it boots from a fastboot file
+func LatestSaveState(list *DBStateList) *SaveState {
+	for i := len(list.DBStates) - 1; i >= 0; i-- {
+		if list.DBStates[i] != nil && list.DBStates[i].SaveStruct != nil {
+			return list.DBStates[i].SaveStruct
+		}
+	}
+	return nil
+}
+
+func NewSaveStateReport(ss *SaveState) *SaveStateReport {
+	r := new(SaveStateReport)
+	r.DBHeight = ss.DBHeight
+	r.LLeaderHeight = ss.LLeaderHeight
+
+	r.Balances.FCTHash = GetMapHash(ss.FactoidBalancesP).String()
+	r.Balances.ECHash = GetMapHash(ss.ECBalancesP).String()
+	r.Balances.FCT = make(map[string]int64, len(ss.FactoidBalancesP))
+	for k, v := range ss.FactoidBalancesP {
+		r.Balances.FCT[primitives.ConvertFctAddressToUserStr(factoid.NewAddress(k[:]))] = v
+	}
+	r.Balances.EC = make(map[string]int64, len(ss.ECBalancesP))
+	for k, v := range ss.ECBalancesP {
+		r.Balances.EC[primitives.ConvertECAddressToUserStr(factoid.NewAddress(k[:]))] = v
+	}
+
+	r.Servers.AuthorityServerCount = ss.AuthorityServerCount
+	r.Servers.Federated = serverIDs(ss.FedServers)
+	r.Servers.Audit = serverIDs(ss.AuditServers)
+
+	if ss.IdentityControl != nil {
+		for _, a := range ss.IdentityControl.Authorities {
+			r.Authorities = append(r.Authorities, SaveStateIdentity{
+				ChainID:         a.AuthorityChainID.String(),
+				Status:          constants.IdentityStatusString(a.Status),
+				SigningKey:      a.SigningKey.String(),
+				Efficiency:      a.Efficiency,
+				CoinbaseAddress: coinbaseString(a.CoinbaseAddress),
+				AnchorKeys:      len(a.AnchorKeys),
+			})
+		}
+		for _, id := range ss.IdentityControl.Identities {
+			r.Identities = append(r.Identities, SaveStateIdentity{
+				ChainID:         id.IdentityChainID.String(),
+				Status:          constants.IdentityStatusString(id.Status),
+				SigningKey:      hashString(id.SigningKey),
+				Efficiency:      id.Efficiency,
+				CoinbaseAddress: coinbaseString(id.CoinbaseAddress),
+				AnchorKeys:      len(id.AnchorKeys),
+			})
+		}
+		sort.Slice(r.Authorities, func(i, j int) bool { return r.Authorities[i].ChainID < r.Authorities[j].ChainID })
+		sort.Slice(r.Identities, func(i, j int) bool { return r.Identities[i].ChainID < r.Identities[j].ChainID })
+	}
+
+	if ss.Commits != nil {
+		r.ReplayFilter.Commits = ss.Commits.Len()
+	}
+	r.ReplayFilter.InvalidMessages = len(ss.InvalidMessages)
+	r.ReplayFilter.Note = "replay filter is rebuilt from the database at boot and is not saved"
+
+	r.FER = SaveStateFER{
+		FactoshisPerEC:                 ss.FactoshisPerEC,
+		FERChainId:                     ss.FERChainId,
+		ExchangeRateAuthorityPublicKey: ss.ExchangeRateAuthorityPublicKey,
+		FERChangeHeight:                ss.FERChangeHeight,
+		FERChangePrice:                 ss.FERChangePrice,
+		FERPriority:                    ss.FERPriority,
+		FERPrioritySetHeight:           ss.FERPrioritySetHeight,
+	}
+
+	r.Holding = messageList(ss.Holding)
+	r.Acks = messageList(ss.Acks)
+	for _, m := range ss.XReview {
+		r.XReview = append(r.XReview, newSaveStateMessage(m))
+	}
+
+	r.Consensus = map[string]interface{}{
+		"leader":         ss.Leader,
+		"leadervmindex":  ss.LeaderVMIndex,
+		"currentminute":  ss.CurrentMinute,
+		"eomsyncing":     ss.EOMsyncing,
+		"eom":            ss.EOM,
+		"eomlimit":       ss.EOMLimit,
+		"eomprocessed":   ss.EOMProcessed,
+		"eomdone":        ss.EOMDone,
+		"eomminute":      ss.EOMMinute,
+		"eomsys":         ss.EOMSys,
+		"dbsig":          ss.DBSig,
+		"dbsiglimit":     ss.DBSigLimit,
+		"dbsigprocessed": ss.DBSigProcessed,
+		"dbsigdone":      ss.DBSigDone,
+		"dbsigsys":       ss.DBSigSys,
+		"newblk":         ss.Newblk,
+		"saving":         ss.Saving,
+		"syncing":        ss.Syncing,
+	}
+	if ss.LeaderTimestamp != nil {
+		r.Consensus["leadertimestamp"] = ss.LeaderTimestamp.GetTimeMilli()
+	}
+
+	r.EntrySync = map[string]interface{}{
+		"entryblockdbheightcomplete":   ss.EntryBlockDBHeightComplete,
+		"entryblockdbheightprocessing": ss.EntryBlockDBHeightProcessing,
+		"missingentryblocks":           len(ss.MissingEntryBlocks),
+		"entrydbheightcomplete":        ss.EntryDBHeightComplete,
+		"entryheightcomplete":          ss.EntryHeightComplete,
+		"entrydbheightprocessing":      ss.EntryDBHeightProcessing,
+		"missingentries":               len(ss.MissingEntries),
+	}
+	return r
+}
+
+func serverIDs(servers []interfaces.IServer) []string {
+	ids := make([]string, 0, len(servers))
+	for _, s := range servers {
+		ids = append(ids, s.GetChainID().String())
+	}
+	return ids
+}
+
+func hashString(h interface {
+	String() string
+}) string {
+	if h == nil {
+		return ""
+	}
+	return h.String()
+}
+
+// coinbaseString renders a coinbase address the way the authorities API does
+func coinbaseString(h interfaces.IHash) string {
+	if h == nil {
+		return ""
+	}
+	return primitives.ConvertFctAddressToUserStr(factoid.NewAddress(h.Bytes()))
+}
+
+func newSaveStateMessage(m interfaces.IMsg) SaveStateMessage {
+	return SaveStateMessage{
+		Hash: m.GetMsgHash().String(),
+		Type: constants.MessageName(m.Type()),
+		Msg:  m.String(),
+	}
+}
+
+func messageList(m map[[32]byte]interfaces.IMsg) []SaveStateMessage {
+	list := make([]SaveStateMessage, 0, len(m))
+	for _, msg := range m {
+		list = append(list, newSaveStateMessage(msg))
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Hash < list[j].Hash })
+	return list
+}
+
+// DiffSaveStateReports returns every value that differs between two reports.
+// Messages are compared by hash only.
+func DiffSaveStateReports(a, b *SaveStateReport) []SaveStateDifference {
+	var diffs []SaveStateDifference
+	add := func(section, key string, va, vb interface{}) {
+		sa, sb := fmt.Sprint(va), fmt.Sprint(vb)
+		if sa != sb {
+			diffs = append(diffs, SaveStateDifference{section, key, sa, sb})
+		}
+	}
+
+	add("height", "dbheight", a.DBHeight, b.DBHeight)
+	add("height", "leaderheight", a.LLeaderHeight, b.LLeaderHeight)
+
+	add("balances", "fcthash", a.Balances.FCTHash, b.Balances.FCTHash)
+	add("balances", "echash", a.Balances.ECHash, b.Balances.ECHash)
+	diffs = append(diffs, diffBalances("fct", a.Balances.FCT, b.Balances.FCT)...)
+	diffs = append(diffs, diffBalances("ec", a.Balances.EC, b.Balances.EC)...)
+
+	add("servers", "authorityservercount", a.Servers.AuthorityServerCount, b.Servers.AuthorityServerCount)
+	add("servers", "federated", a.Servers.Federated, b.Servers.Federated)
+	add("servers", "audit", a.Servers.Audit, b.Servers.Audit)
+
+	diffs = append(diffs, diffIdentities("authorities", a.Authorities, b.Authorities)...)
+	diffs = append(diffs, diffIdentities("identities", a.Identities, b.Identities)...)
+
+	add("replay", "commits", a.ReplayFilter.Commits, b.ReplayFilter.Commits)
+	add("replay", "invalidmessages", a.ReplayFilter.InvalidMessages, b.ReplayFilter.InvalidMessages)
+
+	add("fer", "factoshisperec", a.FER.FactoshisPerEC, b.FER.FactoshisPerEC)
+	add("fer", "ferchainid", a.FER.FERChainId, b.FER.FERChainId)
+	add("fer", "exchangerateauthoritypublickey", a.FER.ExchangeRateAuthorityPublicKey, b.FER.ExchangeRateAuthorityPublicKey)
+	add("fer", "ferchangeheight", a.FER.FERChangeHeight, b.FER.FERChangeHeight)
+	add("fer", "ferchangeprice", a.FER.FERChangePrice, b.FER.FERChangePrice)
+	add("fer", "ferpriority", a.FER.FERPriority, b.FER.FERPriority)
+	add("fer", "ferprioritysetheight", a.FER.FERPrioritySetHeight, b.FER.FERPrioritySetHeight)
+
+	diffs = append(diffs, diffMessages("holding", a.Holding, b.Holding)...)
+	diffs = append(diffs, diffMessages("acks", a.Acks, b.Acks)...)
+	diffs = append(diffs, diffMessages("xreview", a.XReview, b.XReview)...)
+
+	diffs = append(diffs, diffValues("consensus", a.Consensus, b.Consensus)...)
+	diffs = append(diffs, diffValues("entrysync", a.EntrySync, b.EntrySync)...)
+	return diffs
+}
+
+func diffBalances(section string, a, b map[string]int64) []SaveStateDifference {
+	var diffs []SaveStateDifference
+	for _, k := range unionKeys(a, b) {
+		va, oka := a[k]
+		vb, okb := b[k]
+		if va != vb || oka != okb {
+			diffs = append(diffs, SaveStateDifference{section, k, optionalInt(va, oka), optionalInt(vb, okb)})
+		}
+	}
+	return diffs
+}
+
+func diffIdentities(section string, a, b []SaveStateIdentity) []SaveStateDifference {
+	ma := make(map[string]SaveStateIdentity)
+	mb := make(map[string]SaveStateIdentity)
+	for _, id := range a {
+		ma[id.ChainID] = id
+	}
+	for _, id := range b {
+		mb[id.ChainID] = id
+	}
+	var diffs []SaveStateDifference
+	for _, k := range unionKeys(ma, mb) {
+		va, oka := ma[k]
+		vb, okb := mb[k]
+		if va != vb || oka != okb {
+			diffs = append(diffs, SaveStateDifference{section, k, optionalValue(va, oka), optionalValue(vb, okb)})
+		}
+	}
+	return diffs
+}
+
+func diffMessages(section string, a, b []SaveStateMessage) []SaveStateDifference {
+	ma := make(map[string]SaveStateMessage)
+	mb := make(map[string]SaveStateMessage)
+	for _, m := range a {
+		ma[m.Hash] = m
+	}
+	for _, m := range b {
+		mb[m.Hash] = m
+	}
+	var diffs []SaveStateDifference
+	for _, k := range unionKeys(ma, mb) {
+		va, oka := ma[k]
+		vb, okb := mb[k]
+		if oka != okb {
+			diffs = append(diffs, SaveStateDifference{section, k, optionalValue(va.Type, oka), optionalValue(vb.Type, okb)})
+		}
+	}
+	return diffs
+}
+
+func diffValues(section string, a, b map[string]interface{}) []SaveStateDifference {
+	var diffs []SaveStateDifference
+	for _, k := range unionKeys(a, b) {
+		va, oka := a[k]
+		vb, okb := b[k]
+		sa, sb := optionalValue(va, oka), optionalValue(vb, okb)
+		if sa != sb {
+			diffs = append(diffs, SaveStateDifference{section, k, sa, sb})
+		}
+	}
+	return diffs
+}
+
+func optionalInt(v int64, ok bool) string {
+	if !ok {
+		return "<missing>"
+	}
+	return fmt.Sprint(v)
+}
+
+func optionalValue(v interface{}, ok bool) string {
+	if !ok {
+		return "<missing>"
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+// unionKeys returns the sorted keys present in either of two maps keyed by string
+func unionKeys(a, b interface{}) []string {
+	seen := make(map[string]bool)
+	for _, m := range []interface{}{a, b} {
+		switch m := m.(type) {
+		case map[string]int64:
+			for k := range m {
+				seen[k] = true
+			}
+		case map[string]SaveStateIdentity:
+			for k := range m {
+				seen[k] = true
+			}
+		case map[string]SaveStateMessage:
+			for k := range m {
+				seen[k] = true
+			}
+		case map[string]interface{}:
+			for k := range m {
+				seen[k] = true
+			}
+		}
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package state_test
+
+import (
+	"testing"
+
+	"github.com/FactomProject/factomd/common/primitives"
+	. "github.com/FactomProject/factomd/state"
+)
+
+func TestDiffSaveStateReports(t *testing.T) {
+	ss := new(SaveState)
+	ss.LeaderTimestamp = primitives.NewTimestampNow()
+	ss.Init()
+	ss.DBHeight = 100
+	ss.FactoshisPerEC = 1000
+
+	a := primitives.RandomHash().Fixed()
+	b := primitives.RandomHash().Fixed()
+	ss.FactoidBalancesP[a] = 10
+	ss.ECBalancesP[b] = 20
+
+	r1 := NewSaveStateReport(ss)
+	if len(r1.Balances.FCT) != 1 || len(r1.Balances.EC) != 1 {
+		t.Errorf("Expected one FCT and one EC balance, found %d and %d", len(r1.Balances.FCT), len(r1.Balances.EC))
+	}
+	if diffs := DiffSaveStateReports(r1, NewSaveStateReport(ss)); len(diffs) != 0 {
+		t.Errorf("Identical states should not differ: %v", diffs)
+	}
+
+	ss.FactoidBalancesP[a] = 11
+	ss.FactoshisPerEC = 2000
+	r2 := NewSaveStateReport(ss)
+
+	found := map[string]bool{}
+	for _, d := range DiffSaveStateReports(r1, r2) {
+		found[d.Section] = true
+	}
+	for _, section := range []string{"balances", "fct", "fer"} {
+		if !found[section] {
+			t.Errorf("Expected a difference in section %s", section)
+		}
+	}
+	if found["ec"] {
+		t.Error("EC balances did not change")
+	}
+}
@@ -41,12 +41,13 @@ import (
 	"github.com/FactomProject/logrustash"
 
 	"github.com/FactomProject/factomd/Utilities/CorrectChainHeads/correctChainHeads"
+	flog "github.com/FactomProject/factomd/log"
 	log "github.com/sirupsen/logrus"
 )
 
 // packageLogger is the general logger for all package related logs. You can add additional fields,
 // or create more context loggers off of this
-var packageLogger = log.WithFields(log.Fields{"package": "state"})
+var packageLogger = flog.Subsystem("state")
 
 var _ = fmt.Print
 
@@ -90,8 +91,9 @@ type State struct {
 	DropRate                int
 	Delay                   int64 // Simulation delays sending messages this many milliseconds
 
-	ControlPanelPort    int
-	ControlPanelSetting int
+	ControlPanelPort     int
+	ControlPanelSetting  int
+	ControlPanelAccounts string // name:role:token entries, see controlPanel.ParseAccounts
 	// Keeping the last display state lets us know when to send over the new blocks
 	LastDisplayState        *DisplayState
 	ControlPanelChannel     chan DisplayState
@@ -121,6 +123,7 @@ type State struct {
 	// Authorities          []*Authority     // Identities of all servers in management chain
 	AuthorityServerCount int // number of federated or audit servers allowed
 	IdentityControl      *IdentityManager
+	AuthorityHistory     AuthorityHistory // The admin blocks that changed the authorities
 
 	// Just to print (so debugging doesn't drive functionality)
 	Status      int // Return a status (0 do nothing, 1 provide queues, 2 provide consensus data)
@@ -387,6 +390,14 @@ type State struct {
 	FERChainId                     string
 	ExchangeRateAuthorityPublicKey string
 
+	HealthThresholds interfaces.HealthThresholds
+	alertWatch       alertWatch // What the polled alert detectors remember
+	dbHealth         dbHealth   // The last database write check of the health probes
+	idleCalls        idleCalls  // Waiting for the validator thread to be idle
+	MetricsTopChains int        // Chains with the most entries labeled in the business metrics
+
+	FlightRecorderSize int // Messages and events kept by the flight recorder
+
 	FERChangeHeight      uint32
 	FERChangePrice       uint64
 	FERPriority          uint32
@@ -428,7 +439,8 @@ type State struct {
 	ValidatorLoopSleepCnt int64
 	processCnt            int64 // count of attempts to process .. so we can see if the thread is running
 	ProcessTime           interfaces.Timestamp
-	MMRInfo               // fields for MMR processing
+	MMRInfo                           // fields for MMR processing
+	MMRTracker            *MMRTracker // per-peer statistics for missing message requests
 
 	reportedActivations       [activations.ACTIVATION_TYPE_COUNT + 1]bool // flags about which activations we have reported (+1 because we don't use 0)
 	validatorLoopThreadID     string
@@ -446,6 +458,8 @@ type State struct {
 	// MissingMessageResponseHandler is a cache of the last 2 blocks of processed acks.
 	// It can handle and respond to missing message requests on it's own thread.
 	MissingMessageResponseHandler *MissingMessageResponseCache
+	ProcessListHistory            *ProcessListHistory // Record of the last blocks' process lists, for post-mortems
+	FlightRecorder                *FlightRecorder     // The last messages and state transitions, dumped on trouble
 	ChainCommits                  Last100
 	Reveals                       Last100
 }
@@ -528,12 +542,16 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 	newState.CustomNetworkID = s.CustomNetworkID
 	newState.CustomBootstrapIdentity = s.CustomBootstrapIdentity
 	newState.CustomBootstrapKey = s.CustomBootstrapKey
+	newState.HealthThresholds = s.HealthThresholds
+	newState.MetricsTopChains = s.MetricsTopChains
+	newState.FlightRecorderSize = s.FlightRecorderSize
 
 	newState.DirectoryBlockInSeconds = s.DirectoryBlockInSeconds
 	newState.PortNumber = s.PortNumber
 
 	newState.ControlPanelPort = s.ControlPanelPort
 	newState.ControlPanelSetting = s.ControlPanelSetting
+	newState.ControlPanelAccounts = s.ControlPanelAccounts
 
 	//newState.Identities = s.Identities
 	//newState.Authorities = s.Authorities
@@ -580,6 +598,9 @@ func (s *State) Clone(cloneNumber int) interfaces.IState {
 
 	newState.FastSaveRate = s.FastSaveRate
 	newState.CorsDomains = s.CorsDomains
+	newState.StateSaverStruct.Compress = s.StateSaverStruct.Compress
+	newState.StateSaverStruct.Retain = s.StateSaverStruct.Retain
+	newState.StateSaverStruct.DeltasPerFull = s.StateSaverStruct.DeltasPerFull
 	switch newState.DBType {
 	case "LDB":
 		newState.StateSaverStruct.FastBoot = s.StateSaverStruct.FastBoot
@@ -817,6 +838,9 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 
 		s.StateSaverStruct.FastBoot = cfg.App.FastBoot
 		s.StateSaverStruct.FastBootLocation = cfg.App.FastBootLocation
+		s.StateSaverStruct.Compress = cfg.App.FastBootCompress
+		s.StateSaverStruct.Retain = cfg.App.FastBootRetain
+		s.StateSaverStruct.DeltasPerFull = cfg.App.FastBootDeltas
 		s.FastBoot = cfg.App.FastBoot
 		s.FastBootLocation = cfg.App.FastBootLocation
 
@@ -881,8 +905,18 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		default:
 			s.ControlPanelSetting = 1
 		}
+		s.ControlPanelAccounts = cfg.App.ControlPanelAccounts
 		s.FERChainId = cfg.App.ExchangeRateChainId
 		s.ExchangeRateAuthorityPublicKey = cfg.App.ExchangeRateAuthorityPublicKey
+		s.HealthThresholds = interfaces.HealthThresholds{
+			MaxBlockLag:      cfg.App.HealthMaxBlockLag,
+			MaxEntryLag:      cfg.App.HealthMaxEntryLag,
+			MinPeers:         cfg.App.HealthMinPeers,
+			MaxMinuteSeconds: cfg.App.HealthMaxMinuteSeconds,
+			RequireAuthority: cfg.App.HealthRequireAuthority,
+		}
+		s.MetricsTopChains = cfg.App.MetricsTopChains
+		s.FlightRecorderSize = cfg.App.FlightRecorderSize
 		identity, err := primitives.HexToHash(cfg.App.IdentityChainID)
 		if err != nil {
 			s.IdentityChainID = primitives.Sha([]byte(s.FactomNodeName))
@@ -929,6 +963,7 @@ func (s *State) LoadConfig(filename string, networkFlag string) {
 		s.PortNumber = 8088
 		s.ControlPanelPort = 8090
 		s.ControlPanelSetting = 1
+		s.HealthThresholds = interfaces.HealthThresholds{MaxBlockLag: 1, MaxEntryLag: 10, MinPeers: 1}
 
 		// TODO:  Actually load the IdentityChainID from the config file
 		s.IdentityChainID = primitives.Sha([]byte(s.FactomNodeName))
@@ -980,14 +1015,14 @@ func (s *State) Init() {
 	s.ProcessTime = s.TimestampAtBoot
 
 	if s.LogPath == "stdout" {
-		wsapi.InitLogs(s.LogPath, s.LogLevel)
+		wsapi.InitLogs(s.LogPath)
 		//s.Logger = log.NewLogFromConfig(s.LogPath, s.LogLevel, "State")
 	} else {
 		er := os.MkdirAll(s.LogPath, 0775)
 		if er != nil {
 			// fmt.Println("Could not create " + s.LogPath + "\n error: " + er.Error())
 		}
-		wsapi.InitLogs(s.LogPath+s.FactomNodeName+".log", s.LogLevel)
+		wsapi.InitLogs(s.LogPath + s.FactomNodeName + ".log")
 		//s.Logger = log.NewLogFromConfig(s.LogPath, s.LogLevel, "State")
 	}
 
@@ -1012,6 +1047,8 @@ func (s *State) Init() {
 	s.MissingEntries = make(chan *MissingEntry, constants.INMSGQUEUE_HIGH)  //Entries I discover are missing from the database
 	s.UpdateEntryHash = make(chan *EntryUpdate, constants.INMSGQUEUE_HIGH)  //Handles entry hashes and updating Commit maps.
 	s.WriteEntry = make(chan interfaces.IEBEntry, constants.INMSGQUEUE_LOW) //Entries to be written to the database
+	s.EntrySyncState = new(EntrySync)                                       //Before the entry sync, and the APIs reporting on it, start
+	s.EntrySyncState.Init(s.FactomSecond())
 	s.RecentMessage.NewMsgs = make(chan interfaces.IMsg, 100)
 
 	if s.Journaling {
@@ -1172,6 +1209,7 @@ func (s *State) Init() {
 	// end of FER removal
 	s.Starttime = time.Now()
 	// Allocate the MMR queues
+	s.MMRTracker = NewMMRTracker()
 	s.asks = make(chan askRef, 50) // Should be > than the number of VMs so each VM can have at least one outstanding ask.
 	s.adds = make(chan plRef, 50)  // No good rule of thumb on the size of this
 	s.dbheights = make(chan int, 1)
@@ -1179,6 +1217,17 @@ func (s *State) Init() {
 
 	// Allocate the missing message handler
 	s.MissingMessageResponseHandler = NewMissingMessageReponseCache(s)
+	s.ProcessListHistory = NewProcessListHistory(DefaultProcessListHistoryLimit)
+	if s.DBType != "Map" {
+		file := ProcessListHistoryFilename(s.Network, s.FactomNodeName, s.StateSaverStruct.FastBootLocation)
+		if err := s.ProcessListHistory.Persist(file); err != nil {
+			s.LogPrintf("faulting", "Loading the process list history from %s failed %v", file, err)
+		}
+	}
+	if s.FlightRecorder == nil { // simulated nodes are initialized twice
+		s.FlightRecorder = NewFlightRecorder(s.FactomNodeName, s.flightRecorderDir(), s.FlightRecorderSize, 0)
+		s.watchFlightRecorderAlerts()
+	}
 
 	if s.StateSaverStruct.FastBoot {
 		d, err := s.DB.FetchDBlockHead()
@@ -1193,7 +1242,7 @@ func (s *State) Init() {
 		} else {
 			err = s.StateSaverStruct.LoadDBStateList(s, s.DBStates, s.Network)
 			if err != nil {
-				s.StateSaverStruct.DeleteSaveState(s.Network)
+				// The snapshots that failed to load are already deleted, older ones are kept
 				s.LogPrintf("faulting", "Database load failed %v", err)
 			}
 			if err == nil {
@@ -1272,6 +1321,21 @@ func (s *State) GetMissingEntryCount() uint32 {
 	return uint32(len(s.MissingEntries))
 }
 
+// GetEntrySyncProgress returns the number of entries still missing, the rate per second
+// they are being found and the estimated time until they all are
+func (s *State) GetEntrySyncProgress() (missing int, rate float64, eta time.Duration) {
+	if s.EntrySyncState == nil {
+		return 0, 0, 0
+	}
+	return s.EntrySyncState.Progress()
+}
+
+// GetProcessListHistory returns the recorded process lists of up to count blocks
+// ending at dbheight (0 for the latest)
+func (s *State) GetProcessListHistory(dbheight uint32, count int) interface{} {
+	return s.ProcessListHistory.Get(dbheight, count)
+}
+
 func (s *State) GetEBlockKeyMRFromEntryHash(entryHash interfaces.IHash) (rval interfaces.IHash) {
 	defer func() {
 		if rval != nil && reflect.ValueOf(rval).IsNil() {
@@ -2426,6 +2490,24 @@ func (s *State) GetAuthorityInterface(chainid interfaces.IHash) interfaces.IAuth
 	return rval
 }
 
+// GetIdentityInterface returns a copy of the identity with the given identity or
+// management chain id, or nil.  Returned as an interface for the same import issues
+func (s *State) GetIdentityInterface(chainid interfaces.IHash) interface{} {
+	id := s.IdentityControl.GetIdentity(chainid)
+	if id == nil {
+		return nil
+	}
+	s.IdentityControl.Mutex.RLock()
+	defer s.IdentityControl.Mutex.RUnlock()
+	return id.Clone()
+}
+
+// CheckIdentityEntry checks if an identity entry would be accepted if it were put
+// in the next block, without changing any identity
+func (s *State) CheckIdentityEntry(entry interfaces.IEBEntry) (bool, error) {
+	return s.IdentityControl.CheckIdentityEntry(entry, s.GetLLeaderHeight(), s.GetTimestamp())
+}
+
 // GetLeaderPL returns the leader process list from the state. this method is
 // for debugging and should not be called in normal production code.
 func (s *State) GetLeaderPL() interfaces.IProcessList {
@@ -13,6 +13,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/entryBlock"
 	"github.com/FactomProject/factomd/common/entryCreditBlock"
@@ -21,6 +22,7 @@ import (
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/common/tracing"
 	"github.com/FactomProject/factomd/util"
 	"github.com/FactomProject/factomd/util/atomic"
 
@@ -75,6 +77,7 @@ func (s *State) AddToHolding(hash [32]byte, msg interfaces.IMsg) {
 	if !ok {
 		s.Holding[hash] = msg
 		s.LogMessage("holding", "add", msg)
+		tracing.Stage(s.FactomNodeName, msg, tracing.StageHolding)
 		TotalHoldingQueueInputs.Inc()
 	}
 }
@@ -240,6 +243,7 @@ func (s *State) executeMsg(msg interfaces.IMsg) (ret bool) {
 	msg.ComputeVMIndex(s)
 
 	validToSend, validToExecute := s.Validate(msg)
+	tracing.Stage(s.FactomNodeName, msg, tracing.StageValidate, tracing.Attribute{Key: "factomd.validate", Value: fmt.Sprint(validToExecute)})
 
 	if validToSend == 1 {
 		msg.SendOut(s, msg)
@@ -760,6 +764,7 @@ processholdinglist:
 func (s *State) MoveStateToHeight(dbheight uint32, newMinute int) {
 	//	s.LogPrintf("dbstateprocess", "MoveStateToHeight(%d-:-%d) called from %s", dbheight, newMinute, atomic.WhereAmIString(1))
 	s.LogPrintf("dbstateprocess", "MoveStateToHeight(%d-:-%d)", dbheight, newMinute)
+	s.FlightRecorder.Event("move from %d-:-%d to %d-:-%d", s.LLeaderHeight, s.CurrentMinute, dbheight, newMinute)
 
 	if (s.LLeaderHeight+1 == dbheight && newMinute == 0) || (s.LLeaderHeight == dbheight && s.CurrentMinute+1 == newMinute) {
 		// these are the allowed cases; move to nextblock-:-0 or move to next minute
@@ -1064,6 +1069,7 @@ func (s *State) FollowerExecuteAck(msg interfaces.IMsg) {
 
 	// Add the ack to the list of acks
 	TotalAcksInputs.Inc()
+	tracing.StageHash(s.FactomNodeName, ack.MessageHash, tracing.StageAck, tracing.Attribute{Key: "factomd.leader", Value: ack.LeaderChainID.String()})
 	s.Acks[ack.GetHash().Fixed()] = ack // Add the ack to the ask list in case we can't execute the msg yet.
 
 	m := s.getMsgFromHolding(ack.GetHash().Fixed()) // check for a matching message
@@ -1236,6 +1242,12 @@ func (s *State) FollowerExecuteMMR(m interfaces.IMsg) {
 		s.LogMessage("executeMsg", "drop IgnoreMissing", m)
 		return
 	}
+	// Note who answered before dropping duplicates, so peers that send them can be told apart
+	if mmr, ok := m.(*messages.MissingMsgResponse); ok {
+		if ack, ok := mmr.AckResponse.(*messages.Ack); ok {
+			s.MMRTracker.Responded(m.GetOrigin(), m.GetNetworkOrigin(), plRef{int(ack.DBHeight), ack.VMIndex, int(ack.Height)})
+		}
+	}
 	// Drop the missing message response if it's already in the process list
 	_, valid := s.Replay.Valid(constants.INTERNAL_REPLAY, m.GetRepeatHash().Fixed(), m.GetTimestamp(), s.GetTimestamp())
 	if !valid {
@@ -1347,6 +1359,9 @@ func (s *State) FollowerExecuteDataResponse(m interfaces.IMsg) {
 		if !ok {
 			return
 		}
+		if s.EntrySyncState != nil {
+			s.EntrySyncState.Scheduler.Responded(msg, entry.GetHash())
+		}
 		s.WriteEntry <- entry // DataResponse
 	}
 }
@@ -2420,8 +2435,13 @@ func (s *State) ProcessDBSig(dbheight uint32, msg interfaces.IMsg) bool {
 		// TODO: check signatures here.  Count what match and what don't.  Then if a majority
 		// disagree with us, null our entry out.  Otherwise toss our DBState and ask for one from
 		// our neighbors.
-		if !s.KeepMismatch && !pl.CheckDiffSigTally() {
-			return false
+		if !pl.CheckDiffSigTally() {
+			if s.KeepMismatch {
+				alerts.Raise(s.FactomNodeName, alerts.DBSigMismatch, dbheight, "A majority of DBSigs do not match our block, kept as -keepmismatch is set")
+			} else {
+				alerts.Raise(s.FactomNodeName, alerts.DBSigMismatch, dbheight, "A majority of DBSigs do not match our block")
+				return false
+			}
 		}
 
 		s.ReviewHolding()
@@ -2772,6 +2792,7 @@ func (s *State) NewAck(msg interfaces.IMsg, balanceHash interfaces.IHash) interf
 
 	ack.Sign(s)
 	ack.SetLocal(true)
+	tracing.Stage(s.FactomNodeName, msg, tracing.StageAck, tracing.Attribute{Key: "factomd.leader", Value: ack.LeaderChainID.String()})
 
 	return ack
 }
@@ -18,11 +18,20 @@ import (
 type StateSaverStruct struct {
 	FastBoot         bool
 	FastBootLocation string
+	Compress         bool // gzip the fastboot files
+	Retain           int  // number of full snapshots to keep on disk
+	DeltasPerFull    int  // number of incremental saves between full snapshots, 0 to always save full snapshots
 
 	TmpDBHt  uint32
 	TmpState []byte
 	Mutex    sync.Mutex
 	Stop     bool
+
+	// The last full snapshot written, deltas are computed against it
+	baseDBHt   uint32
+	baseState  []byte
+	sinceFull  int
+	legacySeen bool
 }
 
 func (sss *StateSaverStruct) StopSaving() {
@@ -47,9 +56,7 @@ func (sss *StateSaverStruct) SaveDBStateList(s *State, ss *DBStateList, networkN
 	//Actually save data from previous cached state to prevent dealing with rollbacks
 	// Save the N block old state and then make a new savestate for the next save
 	if sss.TmpDBHt != ss.State.LLeaderHeight && len(sss.TmpState) > 0 {
-		filename := NetworkIDToFilename(networkName, sss.FastBootLocation)
-		s.LogPrintf("executeMsg", "%d-:-%d %20s Saving %s for dbht %d", s.LLeaderHeight, s.CurrentMinute, s.FactomNodeName, filename, sss.TmpDBHt)
-		err := SaveToFile(s, sss.TmpDBHt, sss.TmpState, filename)
+		err := sss.saveSnapshot(s, networkName)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, "SaveState SaveToFile Failed", err)
 			return err
@@ -63,9 +70,6 @@ func (sss *StateSaverStruct) SaveDBStateList(s *State, ss *DBStateList, networkN
 			fmt.Fprintln(os.Stderr, "SaveState MarshalBinary Failed", err)
 			return err
 		}
-		//adding an integrity check
-		h := primitives.Sha(b)
-		b = append(h.Bytes(), b...)
 		sss.TmpState = b
 		sss.TmpDBHt = ss.State.LLeaderHeight
 	}
@@ -73,43 +77,190 @@ func (sss *StateSaverStruct) SaveDBStateList(s *State, ss *DBStateList, networkN
 	return nil
 }
 
+// saveSnapshot writes the cached state as either a full snapshot or a delta against
+// the last full snapshot, then prunes files beyond the retention limit
+func (sss *StateSaverStruct) saveSnapshot(s *State, networkName string) error {
+	var b []byte
+	var err error
+	delta := sss.baseState != nil && sss.sinceFull < sss.DeltasPerFull
+	if delta {
+		b, err = EncodeFastBootDelta(sss.TmpDBHt, sss.baseDBHt, sss.baseState, sss.TmpState, sss.Compress)
+	} else {
+		b, err = EncodeFastBootFull(sss.TmpDBHt, sss.TmpState, sss.Compress)
+	}
+	if err != nil {
+		return err
+	}
+
+	filename := FastBootFilename(networkName, sss.FastBootLocation, sss.TmpDBHt, delta)
+	s.LogPrintf("executeMsg", "%d-:-%d %20s Saving %s for dbht %d", s.LLeaderHeight, s.CurrentMinute, s.FactomNodeName, filename, sss.TmpDBHt)
+	err = SaveToFile(s, sss.TmpDBHt, b, filename)
+	if err != nil {
+		return err
+	}
+
+	if delta {
+		sss.sinceFull++
+	} else {
+		sss.baseState = sss.TmpState
+		sss.baseDBHt = sss.TmpDBHt
+		sss.sinceFull = 0
+	}
+	sss.pruneSnapshots(networkName)
+	return nil
+}
+
+// pruneSnapshots keeps the newest Retain full snapshots plus the deltas taken against
+// the newest one. Once a v2 snapshot exists the legacy single file is removed.
+func (sss *StateSaverStruct) pruneSnapshots(networkName string) {
+	files, err := ListFastBootFiles(networkName, sss.FastBootLocation)
+	if err != nil {
+		return
+	}
+	retain := sss.Retain
+	if retain < 1 {
+		retain = 1
+	}
+	fulls := 0
+	for _, f := range files {
+		if f.Delta {
+			if f.DBHeight > sss.baseDBHt {
+				continue // taken against the current base
+			}
+			DeleteFile(f.Filename)
+			continue
+		}
+		fulls++
+		if fulls > retain {
+			DeleteFile(f.Filename)
+		}
+	}
+	if !sss.legacySeen {
+		DeleteFile(NetworkIDToFilename(networkName, sss.FastBootLocation))
+		sss.legacySeen = true
+	}
+}
+
 func (sss *StateSaverStruct) DeleteSaveState(networkName string) error {
-	return DeleteFile(NetworkIDToFilename(networkName, sss.FastBootLocation))
+	files, _ := ListFastBootFiles(networkName, sss.FastBootLocation)
+	for _, f := range files {
+		DeleteFile(f.Filename)
+	}
+	sss.baseState = nil
+	err := DeleteFile(NetworkIDToFilename(networkName, sss.FastBootLocation))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
 }
 
-func (sss *StateSaverStruct) LoadDBStateList(s *State, statelist *DBStateList, networkName string) error {
-	filename := NetworkIDToFilename(networkName, sss.FastBootLocation)
-	fmt.Println(statelist.State.FactomNodeName, "Loading from", filename)
+// loadSnapshot returns the DBStateList binary held in a v2 fastboot file, and makes it
+// (or the snapshot a delta was taken against) the base for the next deltas
+func (sss *StateSaverStruct) loadSnapshot(s *State, networkName string, f FastBootFileInfo) ([]byte, error) {
+	fmt.Println(s.FactomNodeName, "Loading from", f.Filename)
+	h, b, err := ReadFastBootPayload(f.Filename)
+	if err != nil {
+		return nil, err
+	}
+	if !h.IsDelta() {
+		sss.baseState = b
+		sss.baseDBHt = h.DBHeight
+	} else if _, base, err := ReadFastBootPayload(FastBootFilename(networkName, sss.FastBootLocation, h.BaseHeight, false)); err == nil {
+		sss.baseState = base
+		sss.baseDBHt = h.BaseHeight
+		sss.sinceFull = sss.DeltasPerFull // start the next round with a full snapshot
+	}
+	return b, nil
+}
+
+// LoadFastBootFile returns the DBStateList binary held in a fastboot file of either format
+func LoadFastBootFile(s *State, filename string) ([]byte, error) {
+	b, err := LoadFromFile(s, filename)
+	if err != nil {
+		return nil, err
+	}
+	if IsFastBootV2(b) {
+		_, payload, err := ReadFastBootPayload(filename)
+		return payload, err
+	}
+	return LoadLegacyFastBoot(s, filename)
+}
+
+// LoadLegacyFastBoot reads a fastboot file written before the v2 format, which is a
+// sha256 of the DBStateList binary followed by the binary itself
+func LoadLegacyFastBoot(s *State, filename string) ([]byte, error) {
+	fmt.Println(s.FactomNodeName, "Loading from", filename)
 	b, err := LoadFromFile(s, filename)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "LoadDBStateList error:", err)
-		return err
+		return nil, err
 	}
 	if b == nil {
 		fmt.Fprintln(os.Stderr, "LoadDBStateList LoadFromFile returned nil")
-		return errors.New("failed to load from file")
+		return nil, errors.New("failed to load from file")
 	}
 	h := primitives.NewZeroHash()
 	b, err = h.UnmarshalBinaryData(b)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	h2 := primitives.Sha(b)
 	if h.IsSameAs(h2) == false {
 		fmt.Fprintf(os.Stderr, "LoadDBStateList - Integrity hashes do not match!")
-		return errors.New("fastboot file does not match its hash")
-		//return fmt.Errorf("Integrity hashes do not match")
+		return nil, errors.New("fastboot file does not match its hash")
 	}
+	return b, nil
+}
 
-	statelist.UnmarshalBinary(b)
+// LoadDBStateList restores the newest fastboot snapshot that loads.  A snapshot that is
+// damaged or will not unmarshal is deleted, and the next older one is tried, ending with
+// the legacy fastboot file if there is one.
+func (sss *StateSaverStruct) LoadDBStateList(s *State, statelist *DBStateList, networkName string) error {
+	files, err := ListFastBootFiles(networkName, sss.FastBootLocation)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, f := range files {
+		b, err := sss.loadSnapshot(s, networkName, f)
+		if err == nil {
+			err = restoreDBStateList(statelist, b)
+		}
+		if err == nil {
+			return nil
+		}
+		fmt.Fprintf(os.Stderr, "%20s LoadDBStateList deleting %s: %v\n", s.FactomNodeName, f.Filename, err)
+		DeleteFile(f.Filename)
+		sss.baseState = nil
+	}
+
+	legacy := NetworkIDToFilename(networkName, sss.FastBootLocation)
+	b, err := LoadLegacyFastBoot(s, legacy)
+	if err == nil {
+		err = restoreDBStateList(statelist, b)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "%20s LoadDBStateList deleting %s: %v\n", s.FactomNodeName, legacy, err)
+		DeleteFile(legacy)
+	}
+	return err
+}
+
+// restoreDBStateList unmarshals a snapshot, and restores the state saved with its last DBState
+func restoreDBStateList(statelist *DBStateList, b []byte) error {
+	err := statelist.UnmarshalBinary(b)
+	if err != nil {
+		return err
+	}
 	var i int
 	for i = len(statelist.DBStates) - 1; i >= 0; i-- {
 		if statelist.DBStates[i].SaveStruct != nil {
 			break
 		}
 	}
+	if i < 0 {
+		return errors.New("no saved state in the fastboot snapshot")
+	}
 	statelist.DBStates[i].SaveStruct.RestoreFactomdState(statelist.State)
-
 	return nil
 }
 
@@ -128,7 +279,7 @@ func NetworkIDToFilename(networkName string, fileLocation string) string {
 
 func SaveToFile(s *State, dbht uint32, b []byte, filename string) error {
 	fmt.Fprintf(os.Stderr, "%20s Saving %s for dbht %d\n", s.FactomNodeName, filename, dbht)
-	err := ioutil.WriteFile(filename, b, 0644)
+	err := WriteFileAtomic(filename, b, 0644)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%20s Saving FailrueError: %v\n", s.FactomNodeName, err)
 		return err
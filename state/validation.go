@@ -6,6 +6,7 @@ package state
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/FactomProject/factomd/common/constants"
@@ -17,13 +18,45 @@ import (
 
 var ValidationDebug bool = false
 
+// Functions waiting to run on the validator thread, see RunWhenIdle
+type idleCalls struct {
+	sync.Mutex
+	calls []func()
+}
+
+// RunWhenIdle runs f on the validator thread once it has processed every message queued
+// for it, so f may read the state.  It is for debugging tools, f should be quick.
+func (s *State) RunWhenIdle(f func()) {
+	s.idleCalls.Lock()
+	defer s.idleCalls.Unlock()
+	s.idleCalls.calls = append(s.idleCalls.calls, f)
+}
+
+func (s *State) runIdleCalls() {
+	s.idleCalls.Lock()
+	calls := s.idleCalls.calls
+	s.idleCalls.calls = nil
+	s.idleCalls.Unlock()
+	for _, f := range calls {
+		f()
+	}
+}
+
 // This is the tread with access to state. It does process and update state
 func (s *State) DoProcessing() {
+	defer func() {
+		if r := recover(); r != nil {
+			s.FlightRecorder.Event("panic in DoProcessing: %v", r)
+			s.DumpFlightRecorder("panic")
+			panic(r)
+		}
+	}()
 	s.validatorLoopThreadID = atomic.Goid()
 	s.RunState = runstate.Running
 
 	slp := false
 	i3 := 0
+	idle := 0 // passes without work or queued messages
 
 	for s.GetRunState() == runstate.Running {
 
@@ -57,6 +90,17 @@ func (s *State) DoProcessing() {
 			s.ProcessTime = now
 		}
 
+		// Run what waits for the validator to be idle on the second pass without work or
+		// queued messages, as the ValidatorLoop may be between queues with a message
+		if !p1 && !p2 && !p3 && len(s.inMsgQueue)+len(s.inMsgQueue2)+len(s.ackQueue)+len(s.msgQueue) == 0 {
+			idle++
+		} else {
+			idle = 0
+		}
+		if idle > 1 {
+			s.runIdleCalls()
+		}
+
 		// if we were unable to accomplish any work sleep a bit.
 		if !p1 && !p2 && !p3 {
 			// No work? Sleep for a bit
@@ -84,6 +128,8 @@ func (s *State) ValidatorLoop() {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println("A panic state occurred in ValidatorLoop.", r)
+			s.FlightRecorder.Event("panic in ValidatorLoop: %v", r)
+			s.DumpFlightRecorder("panic")
 			shutdown(s)
 		}
 	}()
@@ -138,6 +138,7 @@ func CreateAndPopulateTestState() *state.State {
 	state.LoadDatabase(s)
 	s.Process()
 	s.UpdateState()
+	s.CatchUpAuthorityHistory()
 
 	return s
 }
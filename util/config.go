@@ -21,6 +21,7 @@ type FactomdConfig struct {
 		ControlPanelPort                       int
 		ControlPanelFilesPath                  string
 		ControlPanelSetting                    string
+		ControlPanelAccounts                   string
 		DBType                                 string
 		LdbPath                                string
 		BoltDBPath                             string
@@ -30,6 +31,9 @@ type FactomdConfig struct {
 		ExportDataSubpath                      string
 		FastBoot                               bool
 		FastBootLocation                       string
+		FastBootCompress                       bool
+		FastBootRetain                         int
+		FastBootDeltas                         int
 		NodeMode                               string
 		IdentityChainID                        string
 		LocalServerPrivKey                     string
@@ -42,6 +46,19 @@ type FactomdConfig struct {
 		ExchangeRateAuthorityPublicKeyLocalNet string
 		BitcoinAnchorRecordPublicKeys          []string
 		EthereumAnchorRecordPublicKeys         []string
+		ActivationHeights                      []string
+		ActivationHeightsFile                  string
+		HealthMaxBlockLag                      int
+		HealthMaxEntryLag                      int
+		HealthMinPeers                         int
+		HealthMaxMinuteSeconds                 int
+		HealthRequireAuthority                 bool
+		TracingEndpoint                        string
+		LogLevels                              string
+		AlertWebhook                           string
+		AlertBroadcast                         bool
+		MetricsTopChains                       int
+		FlightRecorderSize                     int
 
 		// Network Configuration
 		Network                 string
@@ -121,6 +138,8 @@ HomeDir                               = ""
 ; --------------- ControlPanel disabled | readonly | readwrite
 ControlPanelSetting                   = readonly
 ControlPanelPort                      = 8090
+; --------------- ControlPanelAccounts name:role:token, ... with roles viewer | operator | admin
+; ControlPanelAccounts                  = noc:viewer:changeme
 ; --------------- DBType: LDB | Bolt | Map
 DBType                                = "LDB"
 LdbPath                               = "database/ldb"
@@ -131,6 +150,12 @@ ExportData                            = false
 ExportDataSubpath                     = "database/export/"
 FastBoot                              = true
 FastBootLocation                      = ""
+; --------------- FastBootCompress gzips the fastboot files
+FastBootCompress                      = true
+; --------------- FastBootRetain is the number of full fastboot snapshots kept on disk
+FastBootRetain                        = 2
+; --------------- FastBootDeltas is the number of incremental saves written between full snapshots
+FastBootDeltas                        = 0
 ; --------------- Network: MAIN | TEST | LOCAL
 Network                               = MAIN
 PeersFile            = "peers.json"
@@ -162,6 +187,47 @@ ExchangeRateAuthorityPublicKeyTestNet   = 1d75de249c2fc0384fb6701b30dc86b39dc72e
 ; Private key all zeroes:
 ExchangeRateAuthorityPublicKeyLocalNet  = 3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29
 
+; Activation heights for LOCAL and CUSTOM networks, as Name:height.  Repeat ActivationHeights once for each
+; activation, or list them one per line in ActivationHeightsFile.  A relative file is found next to this file.
+; ActivationHeights                     = MultisigRCD:100
+; ActivationHeightsFile                 = activations.txt
+
+; Thresholds for the /health and /ready API endpoints.  A node is ready when it is within HealthMaxBlockLag
+; blocks of the network, has entries within HealthMaxEntryLag blocks of its saved height, and has at least
+; HealthMinPeers p2p connections.  A minute taking more than HealthMaxMinuteSeconds fails, 0 is three times the
+; expected minute.  With HealthRequireAuthority an authority node that is not in the authority set fails.
+HealthMaxBlockLag                       = 1
+HealthMaxEntryLag                       = 10
+HealthMinPeers                          = 1
+HealthMaxMinuteSeconds                  = 0
+HealthRequireAuthority                  = false
+
+; Trace the lifecycle of acknowledged messages, from the API or network to the block, and export the
+; spans to an OpenTelemetry collector's OTLP/HTTP traces endpoint.  Leave empty to not trace.
+; TracingEndpoint                       = http://localhost:4318/v1/traces
+
+; Log levels of subsystems that override -loglvl, as subsystem=level pairs.  The subsystems are general,
+; alerts, engine, messages, p2p, state and wsapi.  The levels can also be changed while the node runs,
+; through the set-log-level method of the /logging API.
+; LogLevels                             = p2p=warning,state=debug
+
+; Alerts from the built-in detectors, such as a stalled minute or this node being faulted, are logged and
+; shown in the control panel.  They are also posted as JSON to AlertWebhook if it is set, and sent to this
+; node's peers if AlertBroadcast is true.
+; AlertWebhook                          = http://localhost:9000/alerts
+AlertBroadcast                          = false
+
+; The business metrics, such as entries written and factoids transferred, are exported to Prometheus as each
+; block is saved.  MetricsTopChains also exports the entries of the chains with the most in the last block,
+; labeled by chain.  0 leaves the chains out.
+MetricsTopChains                        = 0
+
+; The flight recorder keeps the last FlightRecorderSize messages received and sent, and state transitions,
+; and dumps them in the journal format to the log path on a panic, an election or a stalled minute, or
+; when asked with the flight-recorder-dump debug API.  0 keeps 10000.  No more than 64MB is kept, and DBStates
+; are kept without their blocks.
+FlightRecorderSize                      = 0
+
 ; These define if the RPC and Control Panel connection to factomd should be encrypted, and if it is, what files
 ; are the secret key and the public certificate.  factom-cli and factom-walletd uses the certificate specified here if TLS is enabled.
 ; To use default files and paths leave /full/path/to/... in place.
@@ -228,6 +294,9 @@ WalletdLocation                       = "localhost:8089"
 WalletEncrypted                       = false
 `
 
+// Hides the tokens of the control panel accounts
+var accountTokens = regexp.MustCompile(`([^:,]+:[^:,]+):[^,]*`)
+
 func (s *FactomdConfig) String() string {
 	var out primitives.Buffer
 
@@ -238,6 +307,7 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    ControlPanelPort        %v", s.App.ControlPanelPort))
 	out.WriteString(fmt.Sprintf("\n    ControlPanelFilesPath   %v", s.App.ControlPanelFilesPath))
 	out.WriteString(fmt.Sprintf("\n    ControlPanelSetting     %v", s.App.ControlPanelSetting))
+	out.WriteString(fmt.Sprintf("\n    ControlPanelAccounts    %v", accountTokens.ReplaceAllString(s.App.ControlPanelAccounts, "$1:*")))
 	out.WriteString(fmt.Sprintf("\n    DBType                  %v", s.App.DBType))
 	out.WriteString(fmt.Sprintf("\n    LdbPath                 %v", s.App.LdbPath))
 	out.WriteString(fmt.Sprintf("\n    BoltDBPath              %v", s.App.BoltDBPath))
@@ -278,6 +348,19 @@ func (s *FactomdConfig) String() string {
 	out.WriteString(fmt.Sprintf("\n    ChangeAcksHeight         %v", s.App.ChangeAcksHeight))
 	out.WriteString(fmt.Sprintf("\n    BitcoinAnchorRecordPublicKeys    %v", s.App.BitcoinAnchorRecordPublicKeys))
 	out.WriteString(fmt.Sprintf("\n    EthereumAnchorRecordPublicKeys    %v", s.App.EthereumAnchorRecordPublicKeys))
+	out.WriteString(fmt.Sprintf("\n    ActivationHeights       %v", s.App.ActivationHeights))
+	out.WriteString(fmt.Sprintf("\n    ActivationHeightsFile   %v", s.App.ActivationHeightsFile))
+	out.WriteString(fmt.Sprintf("\n    HealthMaxBlockLag       %v", s.App.HealthMaxBlockLag))
+	out.WriteString(fmt.Sprintf("\n    HealthMaxEntryLag       %v", s.App.HealthMaxEntryLag))
+	out.WriteString(fmt.Sprintf("\n    HealthMinPeers          %v", s.App.HealthMinPeers))
+	out.WriteString(fmt.Sprintf("\n    HealthMaxMinuteSeconds  %v", s.App.HealthMaxMinuteSeconds))
+	out.WriteString(fmt.Sprintf("\n    HealthRequireAuthority  %v", s.App.HealthRequireAuthority))
+	out.WriteString(fmt.Sprintf("\n    TracingEndpoint         %v", s.App.TracingEndpoint))
+	out.WriteString(fmt.Sprintf("\n    LogLevels               %v", s.App.LogLevels))
+	out.WriteString(fmt.Sprintf("\n    AlertWebhook            %v", s.App.AlertWebhook))
+	out.WriteString(fmt.Sprintf("\n    AlertBroadcast          %v", s.App.AlertBroadcast))
+	out.WriteString(fmt.Sprintf("\n    MetricsTopChains        %v", s.App.MetricsTopChains))
+	out.WriteString(fmt.Sprintf("\n    FlightRecorderSize      %v", s.App.FlightRecorderSize))
 
 	out.WriteString(fmt.Sprintf("\n  Log"))
 	out.WriteString(fmt.Sprintf("\n    LogPath                 %v", s.Log.LogPath))
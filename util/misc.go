@@ -34,8 +34,13 @@ func Trace(params ...string) {
 
 // Calculate the entry credits needed for the entry
 func EntryCost(b []byte) (uint8, error) {
+	return EntrySizeCost(len(b))
+}
+
+// Calculate the entry credits needed for an entry that marshals to size bytes
+func EntrySizeCost(size int) (uint8, error) {
 	// caulculaate the length exluding the header size 35 for Milestone 1
-	l := len(b) - 35
+	l := size - 35
 
 	if l > 10240 {
 		return 10, fmt.Errorf("Entry cannot be larger than 10KB")
@@ -38,6 +38,31 @@ func TestEntryCost(t *testing.T) {
 	}
 }
 
+func TestEntrySizeCost(t *testing.T) {
+	cases := []struct {
+		size int
+		cost uint8
+		err  bool
+	}{
+		{0, 1, false},
+		{35, 1, false},
+		{35 + 1024, 1, false},
+		{35 + 1025, 2, false},
+		{35 + 2048, 2, false},
+		{35 + 10240, 10, false},
+		{35 + 10241, 10, true},
+	}
+	for _, c := range cases {
+		cost, err := EntrySizeCost(c.size)
+		if cost != c.cost {
+			t.Errorf("Size %d should cost %d, found %d", c.size, c.cost, cost)
+		}
+		if (err != nil) != c.err {
+			t.Errorf("Size %d: unexpected error result %v", c.size, err)
+		}
+	}
+}
+
 func randomIPendingEntry(nilSpot int) *interfaces.IPendingEntry {
 	entry := new(interfaces.IPendingEntry)
 	entry.ChainID = primitives.RandomHash()
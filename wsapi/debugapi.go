@@ -11,12 +11,15 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/FactomProject/factomd/common/alerts"
 	"github.com/FactomProject/factomd/common/globals"
 
 	"regexp"
 
 	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/log"
 )
 
 func HandleDebug(writer http.ResponseWriter, request *http.Request) {
@@ -105,9 +108,15 @@ func HandleDebugRequest(state interfaces.IState, j *primitives.JSON2Request) (*p
 	case "predictive-fer":
 		resp, jsonError = HandlePredictiveFER(state, params)
 		break
+	case "flight-recorder-dump":
+		resp, jsonError = HandleFlightRecorderDump(state, params)
+		break
 	case "process-list":
 		resp, jsonError = HandleProcessList(state, params)
 		break
+	case "process-list-history":
+		resp, jsonError = HandleProcessListHistory(state, params)
+		break
 	case "reload-configuration":
 		resp, jsonError = HandleReloadConfig(state, params)
 		break
@@ -115,6 +124,8 @@ func HandleDebugRequest(state interfaces.IState, j *primitives.JSON2Request) (*p
 		resp, jsonError = HandleSimControl(state, params)
 	case "message-filter":
 		resp, jsonError = HandleMessageFilter(state, params)
+	case "alerts":
+		resp, jsonError = HandleAlerts(state, params)
 	default:
 		jsonError = NewMethodNotFoundError()
 		break
@@ -295,6 +306,49 @@ func HandleProcessList(state interfaces.IState, params interface{}) (interface{}
 	return r, nil
 }
 
+func HandleProcessListHistory(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	type ret struct {
+		Blocks interface{} `json:"blocks"`
+	}
+	r := new(ret)
+
+	req := new(ProcessListHistoryRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+	if req.Count < 0 {
+		return nil, NewInvalidParamsError()
+	}
+
+	r.Blocks = state.GetProcessListHistory(req.DBHeight, req.Count)
+	return r, nil
+}
+
+func HandleFlightRecorderDump(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	type ret struct {
+		File string `json:"file"`
+	}
+	r := new(ret)
+
+	req := new(FlightRecorderDumpRequest)
+	if params != nil {
+		err := MapToObject(params, req)
+		if err != nil {
+			return nil, NewInvalidParamsError()
+		}
+	}
+
+	file, err := state.DumpFlightRecorder(req.Reason)
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+	r.File = file
+	return r, nil
+}
+
 func HandleReloadConfig(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	// LoacConfig with "" strings should load the default location
 	state.LoadConfig(state.GetConfigPath(), state.GetNetworkName())
@@ -334,10 +388,32 @@ type SetDelayRequest struct {
 	Delay int64 `json:"delay"`
 }
 
+type ProcessListHistoryRequest struct {
+	DBHeight uint32 `json:"dbheight"` // Last block to return, 0 for the most recent
+	Count    int    `json:"count"`    // Number of blocks to return, 0 for all we have
+}
+
+type FlightRecorderDumpRequest struct {
+	Reason string `json:"reason"` // Written at the top of the dump, "requested" if empty
+}
+
 type SetDropRateRequest struct {
 	DropRate int `json:"droprate"`
 }
 
+type SetLogLevelRequest struct {
+	Subsystem string `json:"subsystem"` // "all" for every subsystem
+	Level     string `json:"level"`
+}
+
+type MessageLogRequest struct {
+	Subsystem string `json:"subsystem"`
+}
+
+type SetMessageLogRegexRequest struct {
+	Regex string `json:"regex"` // "" turns the message logs off
+}
+
 type GetCommands struct {
 	Commands []string `json:"commands"`
 }
@@ -375,3 +451,138 @@ func HandleMessageFilter(state interfaces.IState, params interface{}) (interface
 
 	return h, nil
 }
+
+// HandleLogging is the logging API.  Unlike the debug API it is on every network, so it
+// is refused unless the node has an RPC login.
+func HandleLogging(writer http.ResponseWriter, request *http.Request) {
+	state, err := GetState(request)
+	if err != nil {
+		wsLog.Errorf("failed to extract port from request: %s", err)
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if state.GetRpcUser() == "" || checkAuthHeader(state, request) != nil {
+		handleUnauthorized(request, writer)
+		return
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		HandleV2Error(writer, nil, NewInvalidRequestError())
+		return
+	}
+
+	j, err := primitives.ParseJSON2Request(string(body))
+	if err != nil {
+		HandleV2Error(writer, nil, NewInvalidRequestError())
+		return
+	}
+
+	jsonResp, jsonError := HandleLoggingRequest(state, j)
+	if jsonError != nil {
+		HandleV2Error(writer, j, jsonError)
+		return
+	}
+
+	writer.Write([]byte(jsonResp.String()))
+}
+
+func HandleLoggingRequest(state interfaces.IState, j *primitives.JSON2Request) (*primitives.JSON2Response, *primitives.JSONError) {
+	var resp interface{}
+	var jsonError *primitives.JSONError
+	params := j.Params
+	wsLog.Infof("logging request %v", j.String())
+
+	switch j.Method {
+	case "log-levels":
+		resp, jsonError = HandleLogLevels(state, params)
+	case "set-log-level":
+		resp, jsonError = HandleSetLogLevel(state, params)
+	case "message-log":
+		resp, jsonError = HandleMessageLog(state, params)
+	case "set-message-log-regex":
+		resp, jsonError = HandleSetMessageLogRegex(state, params)
+	case "flight-recorder-dump":
+		resp, jsonError = HandleFlightRecorderDump(state, params)
+	default:
+		jsonError = NewMethodNotFoundError()
+	}
+	if jsonError != nil {
+		return nil, jsonError
+	}
+
+	jsonResp := primitives.NewJSON2Response()
+	jsonResp.ID = j.ID
+	jsonResp.Result = resp
+	return jsonResp, nil
+}
+
+func logLevels() *LogLevelsResponse {
+	r := new(LogLevelsResponse)
+	r.Subsystems = log.SubsystemLevels()
+	r.MessageLogRegex = messages.GetDebugLogRegEx()
+	return r
+}
+
+// HandleLogLevels returns the log level of each subsystem, and the regex picking the message logs
+func HandleLogLevels(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	return logLevels(), nil
+}
+
+func HandleSetLogLevel(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(SetLogLevelRequest)
+	err := MapToObject(params, req)
+	if err != nil || req.Subsystem == "" {
+		return nil, NewInvalidParamsError()
+	}
+
+	err = log.SetSubsystemLevel(req.Subsystem, req.Level)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	wsLog.Infof("Log level of %s set to %s", req.Subsystem, req.Level)
+	return logLevels(), nil
+}
+
+// HandleMessageLog turns on a subsystem's message logs, by adding them to the message log regex
+func HandleMessageLog(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(MessageLogRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	regex, err := log.AddMessageLogs(messages.GetDebugLogRegEx(), req.Subsystem)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	err = messages.SetDebugLogRegEx(regex)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	wsLog.Infof("Message logs of %s turned on", req.Subsystem)
+	return logLevels(), nil
+}
+
+func HandleSetMessageLogRegex(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(SetMessageLogRegexRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	err = messages.SetDebugLogRegEx(req.Regex)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(fmt.Sprintf("Bad regex: %v", err))
+	}
+	return logLevels(), nil
+}
+
+// HandleAlerts returns this node's active alerts, and the recent alerts of this node and its peers
+func HandleAlerts(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	r := new(AlertsResponse)
+	r.Active = alerts.Active(state.GetFactomNodeName())
+	r.Recent = alerts.Recent("")
+	return r, nil
+}
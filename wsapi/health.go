@@ -0,0 +1,203 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/FactomProject/factomd/common/interfaces"
+)
+
+// The health endpoints are for orchestration probes, so they do not need the RPC
+// login.  /health fails only if the node is stuck, and a restart may help.  /ready
+// fails until the node is synced with the network and able to do its job.
+
+const (
+	HealthPass = "pass"
+	HealthFail = "fail"
+	HealthSkip = "skip"
+)
+
+func HandleHealth(writer http.ResponseWriter, request *http.Request) {
+	handleHealthCheck(writer, request, false)
+}
+
+func HandleReady(writer http.ResponseWriter, request *http.Request) {
+	handleHealthCheck(writer, request, true)
+}
+
+func handleHealthCheck(writer http.ResponseWriter, request *http.Request, ready bool) {
+	state, err := GetState(request)
+	if err != nil {
+		wsLog.Errorf("failed to extract port from request: %s", err)
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := EvaluateHealth(state, ready)
+	data, err := json.Marshal(resp)
+	if err != nil {
+		wsLog.Error(err)
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if resp.Status != HealthPass {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, err = writer.Write(data)
+	if err != nil {
+		wsLog.Errorf("failed to write response: %v", err)
+	}
+}
+
+// EvaluateHealth runs the liveness checks, or all the checks if ready is set.  The
+// status is pass only if no check failed.
+func EvaluateHealth(state interfaces.IState, ready bool) *HealthResponse {
+	t := state.GetHealthThresholds()
+
+	resp := new(HealthResponse)
+	resp.Height = state.GetDBHeightComplete()
+	resp.Checks = append(resp.Checks, checkHealthDatabase(state))
+	resp.Checks = append(resp.Checks, checkHealthMinute(state, t))
+	if ready {
+		resp.Checks = append(resp.Checks, checkHealthBlocks(state, t))
+		resp.Checks = append(resp.Checks, checkHealthEntries(state, t))
+		resp.Checks = append(resp.Checks, checkHealthPeers(state, t))
+		resp.Checks = append(resp.Checks, checkHealthAuthority(state, t))
+	}
+
+	resp.Status = HealthPass
+	for _, c := range resp.Checks {
+		if c.Status == HealthFail {
+			resp.Status = HealthFail
+		}
+	}
+	return resp
+}
+
+func checkHealthDatabase(state interfaces.IState) HealthCheck {
+	c := HealthCheck{Name: "database", Status: HealthPass, Detail: "writable"}
+	if err := state.CheckDatabaseWritable(); err != nil {
+		c.Status = HealthFail
+		c.Detail = fmt.Sprintf("not writable: %v", err)
+	}
+	return c
+}
+
+// blockLag is how far the node is behind the highest block it has heard of
+func blockLag(state interfaces.IState) int64 {
+	return int64(state.GetHighestKnownBlock()) - int64(state.GetDBHeightComplete())
+}
+
+func checkHealthMinute(state interfaces.IState, t interfaces.HealthThresholds) HealthCheck {
+	c := HealthCheck{Name: "minute", Status: HealthPass}
+	c.Threshold = int64(t.MaxMinuteSeconds)
+	if c.Threshold <= 0 {
+		// A minute is a tenth of a block
+		c.Threshold = int64(3 * state.GetDirectoryBlockInSeconds() / 10)
+		if c.Threshold < 1 {
+			c.Threshold = 1
+		}
+	}
+
+	// Minutes only progress once the node has caught up
+	if !state.GetDBFinished() || blockLag(state) > int64(t.MaxBlockLag) {
+		c.Status = HealthSkip
+		c.Detail = "syncing"
+		return c
+	}
+
+	c.Value = int64(time.Duration(state.GetCurrentTime()-state.GetCurrentMinuteStartTime()) / time.Second)
+	c.Detail = fmt.Sprintf("minute %d started %d seconds ago", state.GetCurrentMinute(), c.Value)
+	if c.Value > c.Threshold {
+		c.Status = HealthFail
+	}
+	return c
+}
+
+func checkHealthBlocks(state interfaces.IState, t interfaces.HealthThresholds) HealthCheck {
+	c := HealthCheck{Name: "blocks", Status: HealthPass, Threshold: int64(t.MaxBlockLag)}
+	if !state.GetDBFinished() {
+		c.Status = HealthFail
+		c.Detail = "loading the database"
+		return c
+	}
+	c.Value = blockLag(state)
+	c.Detail = fmt.Sprintf("block %d complete, network at %d", state.GetDBHeightComplete(), state.GetHighestKnownBlock())
+	if c.Value > c.Threshold {
+		c.Status = HealthFail
+	}
+	return c
+}
+
+func checkHealthEntries(state interfaces.IState, t interfaces.HealthThresholds) HealthCheck {
+	c := HealthCheck{Name: "entries", Status: HealthPass, Threshold: int64(t.MaxEntryLag)}
+	c.Value = int64(state.GetHighestSavedBlk()) - int64(state.GetEntryDBHeightComplete())
+	c.Detail = fmt.Sprintf("entries complete to block %d, saved to %d", state.GetEntryDBHeightComplete(), state.GetHighestSavedBlk())
+	if c.Value > c.Threshold {
+		c.Status = HealthFail
+	}
+	return c
+}
+
+func checkHealthPeers(state interfaces.IState, t interfaces.HealthThresholds) HealthCheck {
+	c := HealthCheck{Name: "peers", Status: HealthPass, Threshold: int64(t.MinPeers)}
+	peers := state.GetNumberOfPeers()
+	if peers < 0 {
+		c.Status = HealthSkip
+		c.Detail = "no p2p network"
+		return c
+	}
+	c.Value = int64(peers)
+	c.Detail = fmt.Sprintf("%d connections", peers)
+	if c.Value < c.Threshold {
+		c.Status = HealthFail
+	}
+	return c
+}
+
+func checkHealthAuthority(state interfaces.IState, t interfaces.HealthThresholds) HealthCheck {
+	c := HealthCheck{Name: "authority", Status: HealthPass}
+	id := state.GetIdentityChainID()
+	height := state.GetLLeaderHeight()
+
+	for _, fed := range state.GetFedServers(height) {
+		if id.IsSameAs(fed.GetChainID()) {
+			c.Detail = "federated server"
+			return c
+		}
+	}
+	for _, aud := range state.GetAuditServers(height) {
+		if id.IsSameAs(aud.GetChainID()) {
+			c.Detail = "audit server"
+			if !aud.IsOnline() {
+				c.Status = HealthFail
+				c.Detail = "audit server is offline"
+			}
+			return c
+		}
+	}
+
+	// An identity the authority set knows about, that is not in the current process
+	// list, has been faulted out
+	if state.GetAuthorityInterface(id) != nil {
+		c.Status = HealthFail
+		c.Detail = "faulted, not in the current federated or audit servers"
+		return c
+	}
+	if t.RequireAuthority {
+		c.Status = HealthFail
+		c.Detail = "not in the authority set"
+		return c
+	}
+	c.Status = HealthSkip
+	c.Detail = "not an authority node"
+	return c
+}
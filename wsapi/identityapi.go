@@ -0,0 +1,165 @@
+// Copyright 2017 Factom Foundation
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package wsapi
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/FactomProject/factomd/common/constants"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/interfaces"
+	"github.com/FactomProject/factomd/common/primitives"
+)
+
+// The identity calls let an authority operator look up an identity and check the
+// entries they sign for it before committing them.  Only identities the node already
+// knows are handled, creating an identity's chains is left to the existing tooling.
+
+func HandleV2Identity(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(IdentityRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	chainID, err := primitives.HexToHash(req.ChainID)
+	if err != nil {
+		return nil, NewInvalidHashError()
+	}
+	id, ok := state.GetIdentityInterface(chainID).(*identity.Identity)
+	if !ok || id == nil {
+		return nil, NewObjectNotFoundError()
+	}
+
+	resp := new(IdentityResponse)
+	resp.IdentityChainID = id.IdentityChainID.String()
+	resp.ManagementChainID = id.ManagementChainID.String()
+	resp.IdentityCreated = id.IdentityCreated
+	resp.IdentityRegistered = id.IdentityRegistered
+	resp.ManagementCreated = id.ManagementCreated
+	resp.ManagementRegistered = id.ManagementRegistered
+	for _, k := range id.Keys {
+		resp.Keys = append(resp.Keys, k.String())
+	}
+	resp.SigningKey = id.SigningKey.String()
+	for _, a := range id.AnchorKeys {
+		resp.AnchorKeys = append(resp.AnchorKeys, IdentityAnchorKey{
+			BlockChain: a.BlockChain,
+			Level:      a.KeyLevel,
+			KeyType:    a.KeyType,
+			Key:        hex.EncodeToString(a.SigningKey[:]),
+		})
+	}
+	resp.Efficiency = id.Efficiency
+	if id.CoinbaseAddress != nil && !id.CoinbaseAddress.IsZero() {
+		resp.CoinbaseAddress = primitives.ConvertFctAddressToUserStr(factoid.NewAddress(id.CoinbaseAddress.Bytes()))
+	}
+	resp.Status = constants.IdentityStatusString(id.Status)
+	if auth := state.GetAuthorityInterface(id.IdentityChainID); auth != nil {
+		resp.Authority = auth
+	}
+
+	return resp, nil
+}
+
+// HandleV2IdentityCheckEntry checks a signed identity entry against the identity rules
+// the way it would be processed in the next block, without submitting it.  Entries are
+// signed by the identity's owner, so no identity or entry credit key is given to the node.
+func HandleV2IdentityCheckEntry(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(EntryRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	entry := entryBlock.NewEntry()
+	data, err := hex.DecodeString(req.Entry)
+	if err != nil {
+		return nil, NewInvalidEntryError()
+	}
+	_, err = entry.UnmarshalBinaryData(data)
+	if err != nil || !entry.IsValid() {
+		return nil, NewInvalidEntryError()
+	}
+
+	change, err := state.CheckIdentityEntry(entry)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(fmt.Sprintf("ERROR! Identity entry would be rejected: %v", err))
+	}
+
+	resp := new(IdentityEntryResponse)
+	resp.ChainID = entry.GetChainID().String()
+	resp.EntryHash = entry.GetHash().String()
+	resp.Change = change
+	return resp, nil
+}
+
+func HandleV2AuthorityHistory(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(AuthorityHistoryRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	height := state.GetHighestSavedBlk()
+	if req.Height != nil {
+		if *req.Height < 0 || *req.Height > int64(height) {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("ERROR! Invalid params passed in, 'height' must be from 0 to %d", height))
+		}
+		height = uint32(*req.Height)
+	}
+
+	var chainID interfaces.IHash
+	if req.ChainID != "" {
+		chainID, err = primitives.HexToHash(req.ChainID)
+		if err != nil {
+			return nil, NewInvalidHashError()
+		}
+	}
+
+	history, err := state.GetAuthorityHistory()
+	if err != nil {
+		return nil, NewCustomInternalError(err.Error())
+	}
+	changes, ok := history.([]identity.AuthorityChange)
+	if !ok {
+		return nil, NewInternalError()
+	}
+
+	resp := new(AuthorityHistoryResponse)
+	resp.Height = height
+	resp.Authorities = []*identity.AuthorityAt{}
+	for _, a := range identity.AuthoritySetAt(changes, height, state.GetNetworkBootStrapIdentity(), state.GetNetworkBootStrapKey()) {
+		if chainID != nil && !chainID.IsSameAs(a.Authority.AuthorityChainID) {
+			continue
+		}
+		// Management chains are not in the admin block, but never change
+		if id, ok := state.GetIdentityInterface(a.Authority.AuthorityChainID).(*identity.Identity); ok && id != nil {
+			a.Authority.ManagementChainID = id.ManagementChainID
+		}
+		resp.Authorities = append(resp.Authorities, a)
+	}
+
+	return resp, nil
+}
+
+// HandleV2AuthorityProposals returns the scheduled authority set changes and their votes
+func HandleV2AuthorityProposals(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	proposals, ok := state.GetAuthorityChangeProposals().([]*identity.AuthorityChangeProposal)
+	if !ok {
+		return nil, NewInternalError()
+	}
+
+	resp := new(AuthorityProposalsResponse)
+	resp.Height = state.GetLLeaderHeight()
+	resp.Proposals = proposals
+	if resp.Proposals == nil {
+		resp.Proposals = []*identity.AuthorityChangeProposal{}
+	}
+	return resp, nil
+}
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	flog "github.com/FactomProject/factomd/log"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -46,7 +47,18 @@ func NewLogFromConfig(logPath, logLevel, prefix string) *log.Entry {
 	}
 }
 
-func InitLogs(logPath, logLevel string) {
-	wsDebugLog = NewLogFromConfig(logPath, logLevel, "APIDEBUGLOG")
-	wsLog = NewLogFromConfig(logPath, logLevel, "WSAPI")
+// InitLogs sends the wsapi subsystem's logs to a file given by logpath.  Its level is left
+// as -loglvl and LogLevels set it, so it is not set here.
+func InitLogs(logPath string) {
+	if logPath == "stdout" {
+		flog.SetSubsystemOutput("wsapi", os.Stdout)
+	} else {
+		logFile, err := os.OpenFile(logPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0660)
+		if err == nil {
+			flog.SetSubsystemOutput("wsapi", logFile)
+		}
+	}
+
+	wsDebugLog = flog.Subsystem("wsapi").WithField("prefix", "APIDEBUGLOG")
+	wsLog = flog.Subsystem("wsapi").WithField("prefix", "WSAPI")
 }
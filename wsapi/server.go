@@ -132,10 +132,17 @@ func (server *Server) AddRootEndpoints() {
 	// for v2 and debug endpoints this isn't applicable as all methods accept both gets, and posts
 	server.router.MethodNotAllowedHandler = methodNotAllowedHandler()
 
+	// probes for orchestration, without the RPC login
+	server.addRoute("/health", HandleHealth).Methods("GET")
+	server.addRoute("/ready", HandleReady).Methods("GET")
+
 	// start the debugging api if we are not on the main network
 	if state.GetNetworkName() != "MAIN" {
 		server.addRoute("/debug", HandleDebug).Methods("GET", "POST")
 	}
+
+	// the logging api is on every network, but only with the RPC login
+	server.addRoute("/logging", HandleLogging).Methods("POST")
 }
 
 // methodNotAllowed replies to the request with an HTTP status code 404 instead of default 405.
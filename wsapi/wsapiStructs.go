@@ -5,8 +5,12 @@
 package wsapi
 
 import (
+	"github.com/FactomProject/factomd/activations"
+	"github.com/FactomProject/factomd/common/alerts"
+	"github.com/FactomProject/factomd/common/identity"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
+	"github.com/FactomProject/factomd/log"
 	"github.com/FactomProject/factomd/receipts"
 )
 
@@ -15,6 +19,37 @@ type FactoidSubmitResponse struct {
 	TxID    string `json:"txid"`
 }
 
+// TransactionBuildResponse is an unsigned transaction and what it takes to sign it
+type TransactionBuildResponse struct {
+	Transaction    string                `json:"transaction"` // hex, unsigned
+	TxID           string                `json:"txid"`
+	SigData        string                `json:"sigdata"` // hex, the data every signature signs
+	Inputs         []TransactionSignInfo `json:"inputs"`
+	InputTotal     uint64                `json:"inputtotal"`
+	OutputTotal    uint64                `json:"outputtotal"` // factoid and entry credit outputs
+	FactoshisPerEC uint64                `json:"factoshisperec"`
+	Fee            uint64                `json:"fee"`
+	PredictiveFER  uint64                `json:"predictivefer"`
+	PredictiveFee  uint64                `json:"predictivefee"`
+}
+
+// TransactionSignInfo says who has to sign for an input
+type TransactionSignInfo struct {
+	Input      int      `json:"input"`
+	Address    string   `json:"address"`
+	Signatures int      `json:"signatures"`        // how many signatures the input needs
+	Signers    []string `json:"signers,omitempty"` // for a multisig, the addresses that may sign, by index
+	LockType   string   `json:"locktype,omitempty"`
+	Lock       uint64   `json:"lock,omitempty"`
+}
+
+type TransactionAssembleResponse struct {
+	Transaction string `json:"transaction"` // hex, signed
+	TxID        string `json:"txid"`
+	Valid       bool   `json:"valid"`
+	Error       string `json:"error,omitempty"`
+}
+
 type CommitChainResponse struct {
 	Message     string `json:"message"`
 	TxID        string `json:"txid"`
@@ -63,6 +98,10 @@ type HeightsResponse struct {
 	MissingEntryCount            int64 `json:"-"`
 	EntryBlockDBHeightProcessing int64 `json:"-"`
 	EntryBlockDBHeightComplete   int64 `json:"-"`
+
+	EntrySyncMissing int64   `json:"entrysyncmissing"`
+	EntrySyncRate    float64 `json:"entrysyncrate"`
+	EntrySyncETA     int64   `json:"entrysynceta"` // seconds
 }
 
 type CurrentMinuteResponse struct {
@@ -150,18 +189,65 @@ type EntryCreditBalanceResponse struct {
 }
 
 type FactoidBalanceResponse struct {
-	Balance int64 `json:"balance"`
+	Balance int64        `json:"balance"`
+	Lock    *BalanceLock `json:"lock,omitempty"`
+}
+
+// BalanceLock describes the lock on a time or height locked (RCD type 3) address
+type BalanceLock struct {
+	Locked        bool   `json:"locked"`
+	LockType      string `json:"locktype"` // "height" or "time"
+	Lock          uint64 `json:"lock"`     // height or unix time in seconds the funds unlock at
+	Spendable     int64  `json:"spendable"`
+	LockedBalance int64  `json:"lockedbalance"`
 }
 
 type EntryCreditRateResponse struct {
 	Rate int64 `json:"rate"`
 }
 
+type EstimateCostResponse struct {
+	EntryCredits        int64              `json:"entrycredits"`
+	FactoshisPerEC      uint64             `json:"factoshisperec"`
+	Factoshis           uint64             `json:"factoshis"` // to buy the entry credits, not counting the transaction fee
+	PredictiveFER       uint64             `json:"predictivefer"`
+	PredictiveFactoshis uint64             `json:"predictivefactoshis"`
+	Balance             *ECBalanceEstimate `json:"balance,omitempty"`
+}
+
+// ECBalanceEstimate says if an entry credit address can pay for a commit
+type ECBalanceEstimate struct {
+	Confirmed  int64  `json:"confirmed"`
+	Pending    int64  `json:"pending"` // including commits and purchases in the process list
+	Sufficient bool   `json:"sufficient"`
+	Error      string `json:"error,omitempty"`
+}
+
 type PropertiesResponse struct {
 	FactomdVersion string `json:"factomdversion"`
 	ApiVersion     string `json:"factomdapiversion"`
 }
 
+// HealthResponse is returned by /health and /ready.  Status is fail if any check failed.
+type HealthResponse struct {
+	Status string        `json:"status"`
+	Height uint32        `json:"height"`
+	Checks []HealthCheck `json:"checks"`
+}
+
+type HealthCheck struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"` // pass, fail or skip
+	Detail    string `json:"detail"`
+	Value     int64  `json:"value"`
+	Threshold int64  `json:"threshold"`
+}
+
+type ActivationsResponse struct {
+	Height      uint32                         `json:"height"`
+	Activations []activations.ActivationStatus `json:"activations"`
+}
+
 type SendRawMessageResponse struct {
 	Message string `json:"message"`
 }
@@ -263,6 +349,7 @@ type BlockHeightResponse struct {
 
 type AddressRequest struct {
 	Address string `json:"address"`
+	RCD     string `json:"rcd,omitempty"` // hex RCD behind the address, to report a lock on the balance
 }
 
 type HeightRequest struct {
@@ -282,6 +369,26 @@ type EntryRequest struct {
 	Entry string `json:"entry"`
 }
 
+type PendingBalancesRequest struct {
+	Addresses []string `json:"addresses"` // FA and EC addresses
+}
+
+type EstimateCostRequest struct {
+	Entry     string `json:"entry,omitempty"`     // hex entry as it will be revealed
+	Size      int    `json:"size,omitempty"`      // or the size in bytes of the entry as it will be revealed
+	Chain     bool   `json:"chain"`               // the entry is the first entry of a new chain
+	ECAddress string `json:"ecaddress,omitempty"` // entry credit address to check can pay
+}
+
+type IdentityRequest struct {
+	ChainID string `json:"chainid"` // identity or management chain id
+}
+
+type AuthorityHistoryRequest struct {
+	Height  *int64 `json:"height,omitempty"`  // the highest saved block if not given
+	ChainID string `json:"chainid,omitempty"` // only this authority
+}
+
 type HashRequest struct {
 	Hash string `json:"hash"`
 }
@@ -313,6 +420,36 @@ type TransactionRequest struct {
 	Transaction string `json:"transaction"`
 }
 
+type TransactionBuildRequest struct {
+	Inputs    []TransactionBuildInput  `json:"inputs"`
+	Outputs   []TransactionBuildOutput `json:"outputs"`
+	ECOutputs []TransactionBuildOutput `json:"ecoutputs"`
+	Timestamp uint64                   `json:"timestamp"` // milliseconds, now if zero
+}
+
+type TransactionBuildInput struct {
+	RCD    string `json:"rcd"` // hex RCD of the input address
+	Amount uint64 `json:"amount"`
+}
+
+type TransactionBuildOutput struct {
+	Address string `json:"address"` // FA or EC address, or hex
+	Amount  uint64 `json:"amount"`
+}
+
+type TransactionAssembleRequest struct {
+	Transaction string              `json:"transaction"` // hex, as returned by transaction-build
+	Signatures  []DetachedSignature `json:"signatures"`
+}
+
+// DetachedSignature is a signature made elsewhere of a transaction's sigdata
+type DetachedSignature struct {
+	Input     int    `json:"input"`
+	Index     int    `json:"index"`         // for a multisig input, which of its addresses signed
+	RCD       string `json:"rcd,omitempty"` // for a multisig input, the hex RCD of the address that signed
+	Signature string `json:"signature"`
+}
+
 type SendRawMessageRequest struct {
 	Message string `json:"message"`
 }
@@ -336,6 +473,77 @@ type MultipleFTBalances struct {
 	Balances        []interface{} `json:"balances"`
 }
 
+type PendingBalancesResponse struct {
+	CurrentHeight   uint32            `json:"currentheight"`
+	LastSavedHeight uint32            `json:"lastsavedheight"`
+	Balances        []*PendingBalance `json:"balances"`
+}
+
+// PendingBalance is an address's balance with what is in flight
+type PendingBalance struct {
+	Address       string                           `json:"address"`
+	Confirmed     int64                            `json:"confirmed"`     // as of the last directory block
+	Acknowledged  int64                            `json:"acknowledged"`  // including acknowledged transactions not yet in a directory block
+	HoldingSpends int64                            `json:"holdingspends"` // spent by transactions and commits waiting in holding
+	Available     int64                            `json:"available"`     // acknowledged less holding spends
+	Transactions  []interfaces.IPendingTransaction `json:"transactions,omitempty"`
+	Commits       []PendingCommit                  `json:"commits,omitempty"`
+	Error         string                           `json:"error,omitempty"`
+}
+
+// PendingCommit is a commit paid for by an entry credit address that is waiting in holding
+type PendingCommit struct {
+	EntryHash string `json:"entryhash"`
+	Credits   int64  `json:"credits"`
+	Chain     bool   `json:"chain"`
+}
+
+// IdentityResponse is an identity as it was parsed from its chains
+type IdentityResponse struct {
+	IdentityChainID      string                `json:"identitychainid"`
+	ManagementChainID    string                `json:"managementchainid"`
+	IdentityCreated      uint32                `json:"identitycreated"`
+	IdentityRegistered   uint32                `json:"identityregistered"`
+	ManagementCreated    uint32                `json:"managementcreated"`
+	ManagementRegistered uint32                `json:"managementregistered"`
+	Keys                 []string              `json:"keys"`
+	SigningKey           string                `json:"signingkey"`
+	AnchorKeys           []IdentityAnchorKey   `json:"anchorkeys"`
+	Efficiency           uint16                `json:"efficiency"`
+	CoinbaseAddress      string                `json:"coinbaseaddress"`
+	Status               string                `json:"status"`
+	Authority            interfaces.IAuthority `json:"authority,omitempty"`
+}
+
+// AuthorityHistoryResponse is the authority set at the end of a directory block, with
+// the admin block changes that made each authority what it was
+type AuthorityHistoryResponse struct {
+	Height      uint32                  `json:"height"`
+	Authorities []*identity.AuthorityAt `json:"authorities"`
+}
+
+// AuthorityProposalsResponse is the scheduled authority set changes that have not
+// reached their height, with the votes they have so far
+type AuthorityProposalsResponse struct {
+	Height    uint32                              `json:"height"`
+	Proposals []*identity.AuthorityChangeProposal `json:"proposals"`
+}
+
+type IdentityAnchorKey struct {
+	BlockChain string `json:"blockchain"`
+	Level      byte   `json:"level"`
+	KeyType    byte   `json:"keytype"`
+	Key        string `json:"key"`
+}
+
+// IdentityEntryResponse is a signed identity entry that has been checked against
+// the identity rules
+type IdentityEntryResponse struct {
+	ChainID   string `json:"chainid"` // the chain the entry goes in
+	EntryHash string `json:"entryhash"`
+	Change    bool   `json:"change"` // the entry changes the identity
+}
+
 type MultipleECBalances struct {
 	CurrentHeight   uint32        `json:"currentheight"`
 	LastSavedHeight uint32        `json:"lastsavedheight"`
@@ -397,3 +605,13 @@ type AuditStatus struct {
 type MessageFilter struct {
 	Params string `json:"params"`
 }
+
+type AlertsResponse struct {
+	Active []alerts.Alert `json:"active"`
+	Recent []alerts.Alert `json:"recent"`
+}
+
+type LogLevelsResponse struct {
+	Subsystems      []log.SubsystemLevel `json:"subsystems"`
+	MessageLogRegex string               `json:"messagelogregex"`
+}
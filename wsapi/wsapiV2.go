@@ -15,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/FactomProject/factomd/activations"
 	"github.com/FactomProject/factomd/anchor"
 	"github.com/FactomProject/factomd/common/constants"
 	"github.com/FactomProject/factomd/common/directoryBlock/dbInfo"
@@ -26,6 +27,7 @@ import (
 	"github.com/FactomProject/factomd/common/messages"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/receipts"
+	"github.com/FactomProject/factomd/util"
 )
 
 const API_VERSION string = "2.0"
@@ -119,14 +121,22 @@ func HandleV2JSONRequest(state interfaces.IState, j *primitives.JSON2Request) (*
 		resp, jsonError = HandleV2EntryCreditBalance(state, params)
 	case "entry-credit-rate":
 		resp, jsonError = HandleV2EntryCreditRate(state, params)
+	case "estimate-cost":
+		resp, jsonError = HandleV2EstimateCost(state, params)
 	case "factoid-balance":
 		resp, jsonError = HandleV2FactoidBalance(state, params)
 	case "factoid-submit":
 		resp, jsonError = HandleV2FactoidSubmit(state, params)
+	case "transaction-build":
+		resp, jsonError = HandleV2TransactionBuild(state, params)
+	case "transaction-assemble":
+		resp, jsonError = HandleV2TransactionAssemble(state, params)
 	case "heights":
 		resp, jsonError = HandleV2Heights(state, params)
 	case "properties":
 		resp, jsonError = HandleV2Properties(state, params)
+	case "activations":
+		resp, jsonError = HandleV2Activations(state, params)
 	case "raw-data":
 		resp, jsonError = HandleV2RawData(state, params)
 	case "receipt":
@@ -165,8 +175,18 @@ func HandleV2JSONRequest(state interfaces.IState, j *primitives.JSON2Request) (*
 		resp, jsonError = HandleV2MultipleFCTBalances(state, params)
 	case "multiple-ec-balances":
 		resp, jsonError = HandleV2MultipleECBalances(state, params)
+	case "pending-balances":
+		resp, jsonError = HandleV2PendingBalances(state, params)
 	case "diagnostics":
 		resp, jsonError = HandleV2Diagnostics(state, params)
+	case "authority-history":
+		resp, jsonError = HandleV2AuthorityHistory(state, params)
+	case "authority-proposals":
+		resp, jsonError = HandleV2AuthorityProposals(state, params)
+	case "identity":
+		resp, jsonError = HandleV2Identity(state, params)
+	case "identity-check-entry":
+		resp, jsonError = HandleV2IdentityCheckEntry(state, params)
 		//case "factoid-accounts":
 		// resp, jsonError = HandleV2Accounts(state, params)
 	default:
@@ -1155,6 +1175,65 @@ func HandleV2EntryCreditRate(state interfaces.IState, params interface{}) (inter
 	return resp, nil
 }
 
+// HandleV2EstimateCost returns what it costs to commit an entry or chain, and if an entry
+// credit address can pay for it
+func HandleV2EstimateCost(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(EstimateCostRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	size := req.Size
+	if req.Entry != "" {
+		data, err := hex.DecodeString(req.Entry)
+		if err != nil {
+			return nil, NewInvalidEntryError()
+		}
+		e := entryBlock.NewEntry()
+		rest, err := e.UnmarshalBinaryData(data)
+		if err != nil || len(rest) != 0 {
+			return nil, NewInvalidEntryError()
+		}
+		size = len(data)
+	}
+	if size <= 0 {
+		return nil, NewCustomInvalidParamsError("Expected an entry or its size")
+	}
+
+	cost, err := util.EntrySizeCost(size)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	resp := new(EstimateCostResponse)
+	resp.EntryCredits = int64(cost)
+	if req.Chain {
+		resp.EntryCredits += 10 // a new chain costs 10 more than its first entry
+	}
+	resp.FactoshisPerEC = state.GetFactoshisPerEC()
+	resp.Factoshis = uint64(resp.EntryCredits) * resp.FactoshisPerEC
+	resp.PredictiveFER = state.GetPredictiveFER()
+	resp.PredictiveFactoshis = uint64(resp.EntryCredits) * resp.PredictiveFER
+
+	if req.ECAddress != "" {
+		adr, ok := decodeUserAddress(req.ECAddress, primitives.ValidateECUserStr)
+		if !ok {
+			return nil, NewInvalidAddressError()
+		}
+		var key [32]byte
+		copy(key[:], adr)
+		_, _, temp, perm, errStr := state.GetFactoidState().GetMultipleECBalances(key)
+
+		bal := new(ECBalanceEstimate)
+		bal.Confirmed = perm
+		bal.Pending = temp
+		bal.Sufficient = temp >= resp.EntryCredits
+		bal.Error = errStr
+		resp.Balance = bal
+	}
+	return resp, nil
+}
+
 func HandleV2FactoidSubmit(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallFctTx.Observe(float64(time.Since(n).Nanoseconds()))
@@ -1177,6 +1256,22 @@ func HandleV2FactoidSubmit(state interfaces.IState, params interface{}) (interfa
 		return nil, NewUnableToDecodeTransactionError()
 	}
 
+	// Multisig inputs would only be dropped later, so say why now
+	if !state.IsActive(activations.MULTISIG_RCD) {
+		for _, rcd := range msg.Transaction.GetRCDs() {
+			if _, ok := rcd.(*factoid.RCD_2); ok {
+				return nil, NewCustomInvalidParamsError("Multisig (RCD type 2) inputs are not active yet")
+			}
+		}
+	}
+	if !state.IsActive(activations.TIME_LOCKED_RCD) {
+		for _, rcd := range msg.Transaction.GetRCDs() {
+			if _, ok := rcd.(*factoid.RCD_3); ok {
+				return nil, NewCustomInvalidParamsError("Time locked (RCD type 3) inputs are not active yet")
+			}
+		}
+	}
+
 	state.IncFCTSubmits()
 
 	state.APIQueue().Enqueue(msg)
@@ -1188,6 +1283,232 @@ func HandleV2FactoidSubmit(state interfaces.IState, params interface{}) (interfa
 	return resp, nil
 }
 
+// HandleV2TransactionBuild builds an unsigned factoid transaction, and returns it with the
+// data to sign and the fee it needs, so it can be signed somewhere that can't run a wallet
+func HandleV2TransactionBuild(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(TransactionBuildRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if len(req.Inputs) == 0 {
+		return nil, NewCustomInvalidParamsError("A transaction needs at least one input")
+	}
+
+	tx := new(factoid.Transaction)
+	if req.Timestamp == 0 {
+		tx.SetTimestamp(primitives.NewTimestampNow())
+	} else {
+		tx.SetTimestamp(primitives.NewTimestampFromMilliseconds(req.Timestamp))
+	}
+	for i, in := range req.Inputs {
+		data, err := hex.DecodeString(in.RCD)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("RCD of input %d is not valid hex", i))
+		}
+		rcd, rest, err := factoid.UnmarshalBinaryAuth(data)
+		if err != nil || len(rest) != 0 {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("Unable to decode the RCD of input %d", i))
+		}
+		adr, err := rcd.GetAddress()
+		if err != nil {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("Unable to decode the RCD of input %d", i))
+		}
+		tx.AddInput(adr, in.Amount)
+		tx.AddAuthorization(rcd)
+	}
+	for _, out := range req.Outputs {
+		adr, ok := decodeUserAddress(out.Address, primitives.ValidateFUserStr)
+		if !ok {
+			return nil, NewInvalidAddressError()
+		}
+		tx.AddOutput(factoid.NewAddress(adr), out.Amount)
+	}
+	for _, out := range req.ECOutputs {
+		adr, ok := decodeUserAddress(out.Address, primitives.ValidateECUserStr)
+		if !ok {
+			return nil, NewInvalidAddressError()
+		}
+		tx.AddECOutput(factoid.NewAddress(adr), out.Amount)
+	}
+	if err := tx.Validate(1); err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	unsigned, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	sigdata, err := tx.MarshalBinarySig()
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	// The fee depends on the size of the signed transaction, so price a copy with room for the signatures
+	priced := new(factoid.Transaction)
+	_, err = priced.UnmarshalBinaryData(unsigned)
+	if err != nil {
+		return nil, NewInternalError()
+	}
+	for i, rcd := range priced.GetRCDs() {
+		priced.SetSignatureBlock(i, factoid.NewPlaceholderSignatureBlock(rcd))
+	}
+
+	resp := new(TransactionBuildResponse)
+	resp.Transaction = hex.EncodeToString(unsigned)
+	resp.TxID = tx.GetSigHash().String()
+	resp.SigData = hex.EncodeToString(sigdata)
+	resp.FactoshisPerEC = state.GetFactoshisPerEC()
+	resp.Fee, err = priced.CalculateFee(resp.FactoshisPerEC)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	resp.PredictiveFER = state.GetPredictiveFER()
+	resp.PredictiveFee, err = priced.CalculateFee(resp.PredictiveFER)
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+	resp.InputTotal, _ = tx.TotalInputs()
+	outs, _ := tx.TotalOutputs()
+	ecs, _ := tx.TotalECs()
+	resp.OutputTotal = outs + ecs
+
+	for i, rcd := range tx.GetRCDs() {
+		info := TransactionSignInfo{Input: i, Signatures: rcd.NumberOfSignatures()}
+		info.Address = primitives.ConvertFctAddressToUserStr(tx.GetInputs()[i].GetAddress())
+		if locked, ok := rcd.(*factoid.RCD_3); ok {
+			info.LockType = locked.LockTypeName()
+			info.Lock = locked.Lock
+			rcd = locked.RCD
+		}
+		if multisig, ok := rcd.(*factoid.RCD_2); ok {
+			for _, a := range multisig.N_Addresses {
+				info.Signers = append(info.Signers, primitives.ConvertFctAddressToUserStr(a))
+			}
+		}
+		resp.Inputs = append(resp.Inputs, info)
+	}
+	return resp, nil
+}
+
+// HandleV2TransactionAssemble adds detached signatures to a transaction from transaction-build,
+// and checks it would be accepted.  It does not submit the transaction, use factoid-submit for that.
+func HandleV2TransactionAssemble(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(TransactionAssembleRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+
+	data, err := hex.DecodeString(req.Transaction)
+	if err != nil {
+		return nil, NewUnableToDecodeTransactionError()
+	}
+	tx := new(factoid.Transaction)
+	rest, err := tx.UnmarshalBinaryData(data)
+	if err != nil || len(rest) != 0 {
+		return nil, NewUnableToDecodeTransactionError()
+	}
+
+	rcds := tx.GetRCDs()
+	for _, ds := range req.Signatures {
+		if ds.Input < 0 || ds.Input >= len(rcds) {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("The transaction has no input %d", ds.Input))
+		}
+		sig, err := hex.DecodeString(ds.Signature)
+		if err != nil || len(sig) != constants.SIGNATURE_LENGTH {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("Invalid signature for input %d", ds.Input))
+		}
+		fsig := new(factoid.FactoidSignature)
+		copy(fsig.Signature[:], sig)
+		sigblk := new(factoid.SignatureBlock)
+		sigblk.AddSignature(fsig)
+
+		rcd := rcds[ds.Input]
+		if locked, ok := rcd.(*factoid.RCD_3); ok {
+			rcd = locked.RCD
+		}
+		multisig, ok := rcd.(*factoid.RCD_2)
+		if !ok {
+			tx.SetSignatureBlock(ds.Input, sigblk)
+			continue
+		}
+
+		if ds.Index < 0 || ds.Index >= len(multisig.N_Addresses) {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("Input %d has no signer %d", ds.Input, ds.Index))
+		}
+		signerData, err := hex.DecodeString(ds.RCD)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("RCD of signer %d of input %d is not valid hex", ds.Index, ds.Input))
+		}
+		signer, rest, err := factoid.UnmarshalBinaryAuth(signerData)
+		if err != nil || len(rest) != 0 {
+			return nil, NewCustomInvalidParamsError(fmt.Sprintf("Unable to decode the RCD of signer %d of input %d", ds.Index, ds.Input))
+		}
+		if _, ok := signer.(*factoid.RCD_1); !ok {
+			return nil, NewCustomInvalidParamsError("Detached multisig signatures must come from simple (RCD type 1) addresses")
+		}
+		sigs, ok := tx.GetSignatureBlock(ds.Input).(*factoid.MultisigSignatureBlock)
+		if !ok {
+			sigs = new(factoid.MultisigSignatureBlock)
+			tx.SetSignatureBlock(ds.Input, sigs)
+		}
+		sigs.AddMultisigSignature(ds.Index, signer, sigblk)
+	}
+
+	signed, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, NewCustomInvalidParamsError(err.Error())
+	}
+
+	resp := new(TransactionAssembleResponse)
+	resp.Transaction = hex.EncodeToString(signed)
+	resp.TxID = tx.GetSigHash().String()
+	if err := validateAssembledTransaction(state, tx); err != nil {
+		resp.Error = err.Error()
+	} else {
+		resp.Valid = true
+	}
+	return resp, nil
+}
+
+// validateAssembledTransaction makes the checks a leader would make before adding the transaction to a block
+func validateAssembledTransaction(state interfaces.IState, tx interfaces.ITransaction) error {
+	if err := tx.Validate(1); err != nil {
+		return err
+	}
+	if err := tx.ValidateSignatures(); err != nil {
+		return err
+	}
+	fee, err := tx.CalculateFee(state.GetFactoshisPerEC())
+	if err != nil {
+		return err
+	}
+	tin, _ := tx.TotalInputs()
+	tout, _ := tx.TotalOutputs()
+	tec, _ := tx.TotalECs()
+	if tin < tout+tec+fee {
+		return fmt.Errorf("The inputs %d do not cover the outputs %d, the entry credit outputs %d, and the fee %d", tin, tout, tec, fee)
+	}
+	if err := state.GetFactoidState().ValidateTransactionAge(tx); err != nil {
+		return err
+	}
+	err, _ = state.GetFactoidState().Validate(1, tx)
+	return err
+}
+
+// decodeUserAddress decodes a human readable address accepted by valid, or a hex address
+func decodeUserAddress(adr string, valid func(string) bool) ([]byte, bool) {
+	if valid(adr) {
+		return primitives.ConvertUserStrToAddress(adr), true
+	}
+	data, err := hex.DecodeString(adr)
+	if err != nil || len(data) != constants.HASH_LENGTH {
+		return nil, false
+	}
+	return data, true
+}
+
 func HandleV2FactoidBalance(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallFABal.Observe(float64(time.Since(n).Nanoseconds()))
@@ -1218,6 +1539,34 @@ func HandleV2FactoidBalance(state interfaces.IState, params interface{}) (interf
 
 	resp := new(FactoidBalanceResponse)
 	resp.Balance = state.GetFactoidState().GetFactoidBalance(factoid.NewAddress(adr).Fixed())
+
+	// A locked address only looks like any other until we are shown its RCD
+	if fadr.RCD != "" {
+		data, err := hex.DecodeString(fadr.RCD)
+		if err != nil {
+			return nil, NewCustomInvalidParamsError("RCD is not valid hex")
+		}
+		rcd, rest, err := factoid.UnmarshalBinaryAuth(data)
+		if err != nil || len(rest) != 0 {
+			return nil, NewCustomInvalidParamsError("Unable to decode the RCD")
+		}
+		rcdAdr, err := rcd.GetAddress()
+		if err != nil || !primitives.AreBytesEqual(rcdAdr.Bytes(), adr) {
+			return nil, NewCustomInvalidParamsError("RCD does not match the address")
+		}
+		if locked, ok := rcd.(*factoid.RCD_3); ok {
+			lock := new(BalanceLock)
+			lock.LockType = locked.LockTypeName()
+			lock.Lock = locked.Lock
+			lock.Locked = locked.IsLocked(state.GetLLeaderHeight(), state.GetTimestamp().GetTimeMilli())
+			if lock.Locked {
+				lock.LockedBalance = resp.Balance
+			} else {
+				lock.Spendable = resp.Balance
+			}
+			resp.Lock = lock
+		}
+	}
 	return resp, nil
 }
 
@@ -1236,6 +1585,11 @@ func HandleV2Heights(state interfaces.IState, params interface{}) (interface{},
 	h.EntryBlockDBHeightProcessing = int64(state.GetEntryBlockDBHeightProcessing())
 	h.EntryBlockDBHeightComplete = int64(state.GetEntryBlockDBHeightComplete())
 
+	missing, rate, eta := state.GetEntrySyncProgress()
+	h.EntrySyncMissing = int64(missing)
+	h.EntrySyncRate = rate
+	h.EntrySyncETA = int64(eta / time.Second)
+
 	return h, nil
 }
 
@@ -1278,6 +1632,15 @@ func HandleV2Properties(state interfaces.IState, params interface{}) (interface{
 	return p, nil
 }
 
+// HandleV2Activations returns the activations with their heights on this network, and which
+// are active at the highest completed block
+func HandleV2Activations(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	a := new(ActivationsResponse)
+	a.Height = state.GetHighestCompletedBlk()
+	a.Activations = activations.GetActivationStatus(int(a.Height))
+	return a, nil
+}
+
 func HandleV2SendRawMessage(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	n := time.Now()
 	defer HandleV2APICallSendRaw.Observe(float64(time.Since(n).Nanoseconds()))
@@ -1518,6 +1881,79 @@ func HandleV2MultipleFCTBalances(state interfaces.IState, params interface{}) (i
 	return h, nil
 }
 
+// HandleV2PendingBalances returns the balances of factoid and entry credit addresses with the
+// effect of transactions and commits that are not yet in a directory block
+func HandleV2PendingBalances(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
+	req := new(PendingBalancesRequest)
+	err := MapToObject(params, req)
+	if err != nil {
+		return nil, NewInvalidParamsError()
+	}
+	if len(req.Addresses) == 0 {
+		return nil, NewCustomInvalidParamsError("ERROR! Invalid params passed in, expected 'addresses'")
+	}
+
+	// Commits waiting in holding, by the entry credit key that pays for them
+	commits := make(map[[32]byte][]PendingCommit)
+	for _, msg := range state.LoadHoldingMap() {
+		var key [32]byte
+		var commit PendingCommit
+		switch m := msg.(type) {
+		case *messages.CommitEntryMsg:
+			key = *m.CommitEntry.ECPubKey
+			commit = PendingCommit{EntryHash: m.CommitEntry.EntryHash.String(), Credits: int64(m.CommitEntry.Credits)}
+		case *messages.CommitChainMsg:
+			key = *m.CommitChain.ECPubKey
+			commit = PendingCommit{EntryHash: m.CommitChain.EntryHash.String(), Credits: int64(m.CommitChain.Credits), Chain: true}
+		default:
+			continue
+		}
+		commits[key] = append(commits[key], commit)
+	}
+
+	resp := new(PendingBalancesResponse)
+	for _, a := range req.Addresses {
+		bal := &PendingBalance{Address: a}
+		resp.Balances = append(resp.Balances, bal)
+
+		ec := primitives.ValidateECUserStr(a)
+		if !ec && !primitives.ValidateFUserStr(a) {
+			bal.Error = "Error decoding address"
+			continue
+		}
+		var key [32]byte
+		copy(key[:], primitives.ConvertUserStrToAddress(a))
+
+		var cHeight, sHeight uint32
+		if ec {
+			cHeight, sHeight, bal.Acknowledged, bal.Confirmed, bal.Error = state.GetFactoidState().GetMultipleECBalances(key)
+			for _, c := range commits[key] {
+				bal.HoldingSpends += c.Credits
+			}
+			bal.Commits = commits[key]
+		} else {
+			cHeight, sHeight, bal.Acknowledged, bal.Confirmed, bal.Error = state.GetFactoidState().GetMultipleFactoidBalances(key)
+		}
+		resp.CurrentHeight = cHeight
+		resp.LastSavedHeight = sHeight
+
+		// Purchases for an EC address, or spends and receipts of an FA address
+		bal.Transactions = state.GetPendingTransactions(a)
+		for _, tx := range bal.Transactions {
+			if ec || tx.Status != constants.AckStatusNotConfirmedString {
+				continue
+			}
+			for _, in := range tx.Inputs {
+				if in.GetAddress().Fixed() == key {
+					bal.HoldingSpends += int64(in.GetAmount())
+				}
+			}
+		}
+		bal.Available = bal.Acknowledged - bal.HoldingSpends
+	}
+	return resp, nil
+}
+
 func HandleV2Diagnostics(state interfaces.IState, params interface{}) (interface{}, *primitives.JSONError) {
 	// General state information
 	resp := new(DiagnosticsResponse)
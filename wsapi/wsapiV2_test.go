@@ -2,6 +2,7 @@ package wsapi_test
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"reflect"
@@ -12,6 +13,11 @@ import (
 
 	"time"
 
+	"github.com/FactomProject/factomd/activations"
+	"github.com/FactomProject/factomd/common/entryBlock"
+	"github.com/FactomProject/factomd/common/factoid"
+	"github.com/FactomProject/factomd/common/identity"
+	"github.com/FactomProject/factomd/common/identityEntries"
 	"github.com/FactomProject/factomd/common/interfaces"
 	"github.com/FactomProject/factomd/common/primitives"
 	"github.com/FactomProject/factomd/receipts"
@@ -488,6 +494,197 @@ func Test_ecBlockToResp(t *testing.T) {
 	}
 }
 
+func TestHandleV2TransactionBuildAndAssemble(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	state.PutF(true, testHelper.NewFactoidAddress(1).Fixed(), 10000000) // enough to pay for it
+
+	rcd, _ := testHelper.NewFactoidRCDAddress(1).MarshalBinary()
+	build := new(TransactionBuildRequest)
+	build.Inputs = []TransactionBuildInput{{RCD: hex.EncodeToString(rcd), Amount: 1000000}}
+	build.Outputs = []TransactionBuildOutput{{Address: primitives.ConvertFctAddressToUserStr(testHelper.NewFactoidAddress(2)), Amount: 500000}}
+	build.ECOutputs = []TransactionBuildOutput{{Address: testHelper.NewECAddressString(3), Amount: 100000}}
+
+	resp, jErr := HandleV2TransactionBuild(state, build)
+	assert.Nil(t, jErr)
+	built := resp.(*TransactionBuildResponse)
+	assert.Equal(t, uint64(1000000), built.InputTotal)
+	assert.Equal(t, uint64(600000), built.OutputTotal)
+	assert.Equal(t, 1, len(built.Inputs))
+	assert.Equal(t, 1, built.Inputs[0].Signatures)
+	assert.True(t, built.Fee > 0, "Fee should not be zero")
+
+	// Sign somewhere else
+	sigdata, _ := hex.DecodeString(built.SigData)
+	sig := primitives.Sign(testHelper.NewPrivKey(1), sigdata)
+
+	assemble := new(TransactionAssembleRequest)
+	assemble.Transaction = built.Transaction
+	assemble.Signatures = []DetachedSignature{{Input: 0, Signature: hex.EncodeToString(sig[:64])}}
+	resp, jErr = HandleV2TransactionAssemble(state, assemble)
+	assert.Nil(t, jErr)
+	assembled := resp.(*TransactionAssembleResponse)
+	assert.Equal(t, built.TxID, assembled.TxID)
+	assert.True(t, assembled.Valid, "Transaction signed with the right key should be valid")
+
+	data, _ := hex.DecodeString(assembled.Transaction)
+	tx := new(factoid.Transaction)
+	_, err := tx.UnmarshalBinaryData(data)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.ValidateSignatures())
+
+	// The wrong key
+	sig = primitives.Sign(testHelper.NewPrivKey(2), sigdata)
+	assemble.Signatures = []DetachedSignature{{Input: 0, Signature: hex.EncodeToString(sig[:64])}}
+	resp, jErr = HandleV2TransactionAssemble(state, assemble)
+	assert.Nil(t, jErr)
+	assembled = resp.(*TransactionAssembleResponse)
+	assert.False(t, assembled.Valid)
+
+	assemble.Signatures = []DetachedSignature{{Input: 1, Signature: hex.EncodeToString(sig[:64])}}
+	_, jErr = HandleV2TransactionAssemble(state, assemble)
+	assert.NotNil(t, jErr)
+}
+
+func TestHandleV2EstimateCost(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	req := new(EstimateCostRequest)
+	req.Size = 35 + 1025
+	resp, jErr := HandleV2EstimateCost(state, req)
+	assert.Nil(t, jErr)
+	r := resp.(*EstimateCostResponse)
+	assert.Equal(t, int64(2), r.EntryCredits)
+	assert.Equal(t, 2*state.GetFactoshisPerEC(), r.Factoshis)
+	assert.Nil(t, r.Balance)
+
+	req.Chain = true
+	req.ECAddress = testHelper.NewECAddressString(0)
+	resp, jErr = HandleV2EstimateCost(state, req)
+	assert.Nil(t, jErr)
+	r = resp.(*EstimateCostResponse)
+	assert.Equal(t, int64(12), r.EntryCredits)
+	assert.NotNil(t, r.Balance)
+
+	req.Size = 35 + 10241
+	_, jErr = HandleV2EstimateCost(state, req)
+	assert.NotNil(t, jErr)
+}
+
+func TestHandleV2PendingBalances(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	req := new(PendingBalancesRequest)
+	req.Addresses = []string{
+		primitives.ConvertFctAddressToUserStr(testHelper.NewFactoidAddress(0)),
+		testHelper.NewECAddressString(0),
+		"FA1234",
+	}
+	resp, jErr := HandleV2PendingBalances(state, req)
+	assert.Nil(t, jErr)
+	r := resp.(*PendingBalancesResponse)
+	assert.Equal(t, 3, len(r.Balances))
+	for _, b := range r.Balances[:2] {
+		assert.Equal(t, b.Acknowledged-b.HoldingSpends, b.Available)
+	}
+	assert.Equal(t, "Error decoding address", r.Balances[2].Error)
+
+	_, jErr = HandleV2PendingBalances(state, new(PendingBalancesRequest))
+	assert.NotNil(t, jErr)
+}
+
+func TestHandleV2IdentityCheckEntry(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	priv := primitives.RandomPrivateKey()
+
+	id := identity.NewIdentity()
+	b := primitives.RandomHash().Bytes()
+	copy(b, []byte{0x88, 0x88, 0x88})
+	id.IdentityChainID = primitives.NewHash(b).(*primitives.Hash)
+	b = primitives.RandomHash().Bytes()
+	copy(b, []byte{0x88, 0x88, 0x88})
+	id.ManagementChainID = primitives.NewHash(b).(*primitives.Hash)
+	id.Keys[0] = primitives.Shad(identityEntries.IdentityKeyPreimage(priv))
+
+	signed := func(chain interfaces.IHash, extIDs [][]byte) *EntryRequest {
+		entry := entryBlock.NewEntry()
+		entry.ChainID = chain
+		for _, ext := range extIDs {
+			entry.ExtIDs = append(entry.ExtIDs, primitives.ByteSlice{Bytes: ext})
+		}
+		data, err := entry.MarshalBinary()
+		assert.Nil(t, err)
+		return &EntryRequest{Entry: hex.EncodeToString(data)}
+	}
+
+	efficiency := signed(id.ManagementChainID, identityEntries.NewSignedServerEfficiency(id.IdentityChainID, 4000, state.GetTimestamp(), priv).ToExternalIDs())
+	_, jErr := HandleV2IdentityCheckEntry(state, efficiency)
+	assert.NotNil(t, jErr, "identity does not exist yet")
+
+	state.IdentityControl.SetIdentity(id.IdentityChainID, id)
+
+	resp, jErr := HandleV2IdentityCheckEntry(state, efficiency)
+	assert.Nil(t, jErr)
+	r := resp.(*IdentityEntryResponse)
+	assert.True(t, r.Change)
+	assert.Equal(t, id.ManagementChainID.String(), r.ChainID)
+
+	address := primitives.NewHash(testHelper.NewFactoidAddress(0).Bytes())
+	coinbase := signed(id.IdentityChainID, identityEntries.NewSignedCoinbaseAddress(id.IdentityChainID, address, state.GetTimestamp(), priv).ToExternalIDs())
+	resp, jErr = HandleV2IdentityCheckEntry(state, coinbase)
+	assert.Nil(t, jErr)
+	assert.Equal(t, id.IdentityChainID.String(), resp.(*IdentityEntryResponse).ChainID)
+
+	vote := signed(id.ManagementChainID, identityEntries.NewSignedAuthorityChange(id.IdentityChainID, primitives.RandomHash(), identityEntries.AuthorityChangeAddAudit, state.GetLLeaderHeight()+10, priv).ToExternalIDs())
+	resp, jErr = HandleV2IdentityCheckEntry(state, vote)
+	assert.Nil(t, jErr)
+	assert.Equal(t, id.ManagementChainID.String(), resp.(*IdentityEntryResponse).ChainID)
+
+	// Nothing is changed by checking
+	resp, jErr = HandleV2AuthorityProposals(state, nil)
+	assert.Nil(t, jErr)
+	assert.Equal(t, 0, len(resp.(*AuthorityProposalsResponse).Proposals))
+	resp, jErr = HandleV2Identity(state, &IdentityRequest{ChainID: id.ManagementChainID.String()})
+	assert.Nil(t, jErr)
+	assert.Equal(t, uint16(10000), resp.(*IdentityResponse).Efficiency)
+	assert.Equal(t, "", resp.(*IdentityResponse).CoinbaseAddress)
+
+	other := primitives.RandomPrivateKey()
+	wrongKey := signed(id.ManagementChainID, identityEntries.NewSignedServerEfficiency(id.IdentityChainID, 4000, state.GetTimestamp(), other).ToExternalIDs())
+	_, jErr = HandleV2IdentityCheckEntry(state, wrongKey)
+	assert.NotNil(t, jErr, "not the identity's key")
+
+	_, jErr = HandleV2IdentityCheckEntry(state, &EntryRequest{Entry: "zz"})
+	assert.NotNil(t, jErr, "not a hex entry")
+}
+
+func TestHandleV2AuthorityHistory(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	resp, jErr := HandleV2AuthorityHistory(state, new(AuthorityHistoryRequest))
+	assert.Nil(t, jErr)
+	r := resp.(*AuthorityHistoryResponse)
+	assert.Equal(t, state.GetHighestSavedBlk(), r.Height)
+	assert.NotEqual(t, 0, len(r.Authorities))
+
+	height := int64(state.GetHighestSavedBlk()) + 1
+	_, jErr = HandleV2AuthorityHistory(state, &AuthorityHistoryRequest{Height: &height})
+	assert.NotNil(t, jErr)
+}
+
+func TestHandleV2Activations(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+
+	resp, jErr := HandleV2Activations(state, nil)
+	assert.Nil(t, jErr)
+	r := resp.(*ActivationsResponse)
+	assert.Equal(t, state.GetHighestCompletedBlk(), r.Height)
+	assert.Equal(t, activations.ACTIVATION_TYPE_COUNT, len(r.Activations))
+	for i, a := range r.Activations {
+		assert.Equal(t, activations.ActivationType(i+1), a.Id)
+		assert.Equal(t, a.Id.String(), a.Name)
+	}
+}
+
 func v2Request(req *primitives.JSON2Request) (*primitives.JSON2Response, error) {
 	j, err := json.Marshal(req)
 	if err != nil {
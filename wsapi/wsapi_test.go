@@ -32,6 +32,7 @@ func TestGetEndpoints(t *testing.T) {
 		"basePostUrl":      {"POST", "http://localhost:8088", http.StatusNotFound, body("")},
 		"trailing-slashes": {"GET", "http://localhost:8088/v2/", http.StatusNotFound, nil},
 		"wrong-method":     {"GET", "http://localhost:8088/v1/factoid-submit/", http.StatusNotFound, nil},
+		"logging-no-login": {"POST", "http://localhost:8088/logging", http.StatusUnauthorized, body(primitives.NewJSON2Request("log-levels", 0, nil))},
 	}
 	client := &http.Client{}
 	for name, testCase := range cases {
@@ -69,10 +70,12 @@ func TestAuthenticatedUnauthorizedRequest(t *testing.T) {
 		Expected     int
 		Body         io.Reader
 	}{
-		"v1Authorized":   {"GET", "http://localhost:18088/v1/properties/", true, http.StatusOK, nil},
-		"v1Unauthorized": {"GET", "http://localhost:18088/v1/properties/", false, http.StatusUnauthorized, nil},
-		"v2Authorized":   {"POST", "http://localhost:18088/v2", true, http.StatusOK, propertiesV2Body},
-		"v2Unauthorized": {"POST", "http://localhost:18088/v2", false, http.StatusUnauthorized, propertiesV2Body},
+		"v1Authorized":        {"GET", "http://localhost:18088/v1/properties/", true, http.StatusOK, nil},
+		"v1Unauthorized":      {"GET", "http://localhost:18088/v1/properties/", false, http.StatusUnauthorized, nil},
+		"v2Authorized":        {"POST", "http://localhost:18088/v2", true, http.StatusOK, propertiesV2Body},
+		"v2Unauthorized":      {"POST", "http://localhost:18088/v2", false, http.StatusUnauthorized, propertiesV2Body},
+		"loggingAuthorized":   {"POST", "http://localhost:18088/logging", true, http.StatusOK, body(primitives.NewJSON2Request("log-levels", 0, nil))},
+		"loggingUnauthorized": {"POST", "http://localhost:18088/logging", false, http.StatusUnauthorized, body(primitives.NewJSON2Request("log-levels", 0, nil))},
 	}
 
 	client := &http.Client{}
@@ -215,3 +218,32 @@ func testTempFile(t *testing.T, prefix string, content string) (string, func())
 
 	return tmpFile.Name(), cleanFile
 }
+
+func TestEvaluateHealth(t *testing.T) {
+	state := testHelper.CreateAndPopulateTestState()
+	state.HealthThresholds.MaxEntryLag = 1000
+
+	checks := func(resp *HealthResponse) map[string]HealthCheck {
+		m := make(map[string]HealthCheck)
+		for _, c := range resp.Checks {
+			m[c.Name] = c
+		}
+		return m
+	}
+
+	live := checks(EvaluateHealth(state, false))
+	assert.Equal(t, 2, len(live))
+	assert.Equal(t, HealthPass, live["database"].Status)
+
+	resp := EvaluateHealth(state, true)
+	ready := checks(resp)
+	assert.Equal(t, 6, len(ready))
+	assert.Equal(t, HealthSkip, ready["peers"].Status, "the test state has no p2p network")
+	assert.Equal(t, HealthPass, ready["entries"].Status)
+	assert.Equal(t, state.GetDBHeightComplete(), resp.Height)
+
+	state.HealthThresholds.MaxEntryLag = -1
+	resp = EvaluateHealth(state, true)
+	assert.Equal(t, HealthFail, checks(resp)["entries"].Status)
+	assert.Equal(t, HealthFail, resp.Status)
+}